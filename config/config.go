@@ -1,20 +1,77 @@
 package config
 
-import "github.com/kelseyhightower/envconfig"
+import (
+	"github.com/kelseyhightower/envconfig"
+	"time"
+)
 
 type Config struct {
-	GRPCPort                      string `envconfig:"GRPC_PORT" default:"9090"`
-	RESTPort                      string `envconfig:"REST_PORT" default:"80"`
-	PostgresHost                  string `envconfig:"POSTGRES_HOST" default:"localhost"`
-	PostgresUsername              string `envconfig:"POSTGRES_USERNAME" default:"5432"`
-	PostgresPassword              string `envconfig:"POSTGRES_PASSWORD" default:"postgres"`
-	PostgresDatabase              string `envconfig:"POSTGRES_DATABASE" default:"admin"`
-	PostgresPort                  string `envconfig:"POSTGRES_PORT" default:"postgres"`
-	PostgresSslmode               string `envconfig:"POSTGRES_SSLMODE" default:"disable"`
-	PostgresTimeZone              string `envconfig:"POSTGRES_TIMEZONE" default:"100"`
-	PostgresMaxConnections        int    `envconfig:"POSTGRES_MAX_CONNECTIONS" default:"100"`
-	PostgresMaxIdleConnection     int    `envconfig:"POSTGRES_MAX_IDLE_CONNECTIONS" default:"10"`
-	PostgresConnectionMaxIdleTime int    `envconfig:"POSTGRES_CONNECTIONS_MAX_IDLE_TIME" default:"3600"`
+	GRPCPort                      string           `envconfig:"GRPC_PORT" default:"9090"`
+	RESTPort                      string           `envconfig:"REST_PORT" default:"80"`
+	PostgresHost                  string           `envconfig:"POSTGRES_HOST" default:"localhost"`
+	PostgresUsername              string           `envconfig:"POSTGRES_USERNAME" default:"5432"`
+	PostgresPassword              string           `envconfig:"POSTGRES_PASSWORD" default:"postgres"`
+	PostgresDatabase              string           `envconfig:"POSTGRES_DATABASE" default:"admin"`
+	PostgresPort                  string           `envconfig:"POSTGRES_PORT" default:"postgres"`
+	PostgresSslmode               string           `envconfig:"POSTGRES_SSLMODE" default:"disable"`
+	PostgresTimeZone              string           `envconfig:"POSTGRES_TIMEZONE" default:"100"`
+	PostgresMaxConnections        int              `envconfig:"POSTGRES_MAX_CONNECTIONS" default:"100"`
+	PostgresMaxIdleConnection     int              `envconfig:"POSTGRES_MAX_IDLE_CONNECTIONS" default:"10"`
+	PostgresConnectionMaxIdleTime int              `envconfig:"POSTGRES_CONNECTIONS_MAX_IDLE_TIME" default:"3600"`
+	LoanMaxExposure               float64          `envconfig:"LOAN_MAX_EXPOSURE" default:"15000000"`
+	LoanCurrency                  string           `envconfig:"LOAN_CURRENCY" default:"IDR"`
+	WriteOffDaysPastDue           int              `envconfig:"WRITE_OFF_DAYS_PAST_DUE" default:"180"`
+	AuthHS256Secret               string           `envconfig:"AUTH_HS256_SECRET"`
+	AuthJWKSFile                  string           `envconfig:"AUTH_JWKS_FILE"`
+	AuthIssuer                    string           `envconfig:"AUTH_ISSUER"`
+	AuthAudience                  string           `envconfig:"AUTH_AUDIENCE"`
+	EventPublisher                string           `envconfig:"EVENT_PUBLISHER" default:"stdout"`
+	EventFilePath                 string           `envconfig:"EVENT_FILE_PATH" default:"events.jsonl"`
+	EventWebhookURL               string           `envconfig:"EVENT_WEBHOOK_URL"`
+	EventWebhookSecret            string           `envconfig:"EVENT_WEBHOOK_SECRET"`
+	EventWebhookTimeout           time.Duration    `envconfig:"EVENT_WEBHOOK_TIMEOUT" default:"10s"`
+	EventNotifyChannel            string           `envconfig:"EVENT_NOTIFY_CHANNEL" default:"billing_events"`
+	OutboxRelayInterval           time.Duration    `envconfig:"OUTBOX_RELAY_INTERVAL" default:"5s"`
+	OutboxBatchSize               int              `envconfig:"OUTBOX_BATCH_SIZE" default:"100"`
+	OutboxLease                   time.Duration    `envconfig:"OUTBOX_LEASE" default:"20m"`
+	OutboxMaxAttempts             int              `envconfig:"OUTBOX_MAX_ATTEMPTS" default:"10"`
+	OutboxBackoffBase             time.Duration    `envconfig:"OUTBOX_BACKOFF_BASE" default:"5s"`
+	OutboxBackoffMax              time.Duration    `envconfig:"OUTBOX_BACKOFF_MAX" default:"10m"`
+	SchedulerTimezone             string           `envconfig:"SCHEDULER_TIMEZONE" default:"UTC"`
+	DelinquencySweepSchedule      string           `envconfig:"DELINQUENCY_SWEEP_SCHEDULE" default:"0 1 * * *"`
+	LateFeeSchedule               string           `envconfig:"LATE_FEE_SCHEDULE" default:"30 1 * * *"`
+	LateFeeAmount                 float64          `envconfig:"LATE_FEE_AMOUNT" default:"25000"`
+	LateFeeGraceDays              int              `envconfig:"LATE_FEE_GRACE_DAYS" default:"3"`
+	PaymentReminderSchedule       string           `envconfig:"PAYMENT_REMINDER_SCHEDULE" default:"0 8 * * *"`
+	PaymentReminderDays           int              `envconfig:"PAYMENT_REMINDER_DAYS" default:"3"`
+	NotificationChannels          []string         `envconfig:"NOTIFICATION_CHANNELS" default:"log"`
+	NotificationDefaultLocale     string           `envconfig:"NOTIFICATION_DEFAULT_LOCALE" default:"en"`
+	NotificationTemplatesDir      string           `envconfig:"NOTIFICATION_TEMPLATES_DIR"`
+	NotificationOverdueDays       int              `envconfig:"NOTIFICATION_OVERDUE_DAYS" default:"1"`
+	NotificationMaxAttempts       int              `envconfig:"NOTIFICATION_MAX_ATTEMPTS" default:"3"`
+	SMTPHost                      string           `envconfig:"SMTP_HOST"`
+	SMTPPort                      string           `envconfig:"SMTP_PORT" default:"587"`
+	SMTPUsername                  string           `envconfig:"SMTP_USERNAME"`
+	SMTPPassword                  string           `envconfig:"SMTP_PASSWORD"`
+	SMTPFrom                      string           `envconfig:"SMTP_FROM"`
+	SMTPTimeout                   time.Duration    `envconfig:"SMTP_TIMEOUT" default:"10s"`
+	SMSGatewayURL                 string           `envconfig:"SMS_GATEWAY_URL"`
+	SMSGatewayToken               string           `envconfig:"SMS_GATEWAY_TOKEN"`
+	SMSGatewayTimeout             time.Duration    `envconfig:"SMS_GATEWAY_TIMEOUT" default:"10s"`
+	WebhookMaxAttempts            int              `envconfig:"WEBHOOK_MAX_ATTEMPTS" default:"8"`
+	WebhookBackoffBase            time.Duration    `envconfig:"WEBHOOK_BACKOFF_BASE" default:"30s"`
+	WebhookBackoffMax             time.Duration    `envconfig:"WEBHOOK_BACKOFF_MAX" default:"6h"`
+	WebhookTimeout                time.Duration    `envconfig:"WEBHOOK_TIMEOUT" default:"10s"`
+	WebhookDispatchInterval       time.Duration    `envconfig:"WEBHOOK_DISPATCH_INTERVAL" default:"5s"`
+	WebhookBatchSize              int              `envconfig:"WEBHOOK_BATCH_SIZE" default:"100"`
+	WebhookLease                  time.Duration    `envconfig:"WEBHOOK_LEASE" default:"20m"`
+	PaymentReferencePrefix        string           `envconfig:"PAYMENT_REFERENCE_PREFIX" default:"8808"`
+	PaymentReferenceLength        int              `envconfig:"PAYMENT_REFERENCE_LENGTH" default:"16"`
+	PaymentReferenceCheckDigit    string           `envconfig:"PAYMENT_REFERENCE_CHECK_DIGIT" default:"luhn"`
+	ProviderGenericSecret         string           `envconfig:"PROVIDER_GENERIC_SECRET"`
+	ProviderXenditCallbackToken   string           `envconfig:"PROVIDER_XENDIT_CALLBACK_TOKEN"`
+	ProviderSignatureTolerance    time.Duration    `envconfig:"PROVIDER_SIGNATURE_TOLERANCE" default:"5m"`
+	DeferralPolicies              DeferralPolicies `envconfig:"DEFERRAL_POLICIES" default:"{\"default\":{\"maxDeferrals\":2,\"maxInstallments\":4,\"allowWhileDelinquent\":false,\"fee\":50000,\"interestRate\":0}}"`
 }
 
 func New() Config {
@@ -0,0 +1,23 @@
+package config
+
+import "encoding/json"
+
+type DeferralPolicy struct {
+	MaxDeferrals         int     `json:"maxDeferrals"`
+	MaxInstallments      int     `json:"maxInstallments"`
+	AllowWhileDelinquent bool    `json:"allowWhileDelinquent"`
+	Fee                  float64 `json:"fee"`
+	InterestRate         float64 `json:"interestRate"`
+}
+
+// DeferralPolicies maps a loan product to its deferral policy, decoded from a JSON object
+type DeferralPolicies map[string]DeferralPolicy
+
+func (p *DeferralPolicies) Decode(value string) error {
+	policies := map[string]DeferralPolicy{}
+	if err := json.Unmarshal([]byte(value), &policies); err != nil {
+		return err
+	}
+	*p = policies
+	return nil
+}
@@ -4,25 +4,97 @@ cd ./contracts
 # clone the googleapis repo in this project we just use the api/anootations.proto for the HTTP gateway
 git clone https://github.com/googleapis/googleapis.git
 
+# clone protovalidate for the buf/validate/validate.proto request validation rules, pinned to the version of the go runtime
+git clone https://github.com/bufbuild/protovalidate.git
+git -C protovalidate checkout 8976f5be98c1
+
+# clone grpc-gateway for the protoc-gen-openapiv2/options annotations describing the openapi spec, pinned to the version of the go runtime
+git clone https://github.com/grpc-ecosystem/grpc-gateway.git
+git -C grpc-gateway checkout v2.26.3
+
 # prepare directory for the pb files
 mkdir -p pb
 
-# generate the pb files
-mkdir -p pb/loan
-protoc -I . -I googleapis\
-  --go_out ./pb/loan --go_opt paths=source_relative \
-  --go-grpc_out ./pb/loan --go-grpc_opt paths=source_relative \
-  --grpc-gateway_out ./pb/loan --grpc-gateway_opt paths=source_relative \
-  --grpc-gateway_opt generate_unbound_methods=true \
-  ./loan.proto;
-
-  mkdir -p pb/payment
-  protoc -I . -I googleapis\
-    --go_out ./pb/payment --go_opt paths=source_relative \
-    --go-grpc_out ./pb/payment --go-grpc_opt paths=source_relative \
-    --grpc-gateway_out ./pb/payment --grpc-gateway_opt paths=source_relative \
-    --grpc-gateway_opt generate_unbound_methods=true \
-    ./payment.proto;
+# generate the pb files, the versioned package directories are kept under pb by paths=source_relative
+protoc -I . -I googleapis -I protovalidate/proto/protovalidate -I grpc-gateway \
+  --go_out ./pb --go_opt paths=source_relative \
+  --go-grpc_out ./pb --go-grpc_opt paths=source_relative \
+  --grpc-gateway_out ./pb --grpc-gateway_opt paths=source_relative \
+  --grpc-gateway_opt generate_unbound_methods=true \
+  ./billing/loan/v1/loan.proto;
+
+protoc -I . -I googleapis -I protovalidate/proto/protovalidate -I grpc-gateway \
+  --go_out ./pb --go_opt paths=source_relative \
+  --go-grpc_out ./pb --go-grpc_opt paths=source_relative \
+  --grpc-gateway_out ./pb --grpc-gateway_opt paths=source_relative \
+  --grpc-gateway_opt generate_unbound_methods=true \
+  ./billing/payment/v1/payment.proto;
+
+protoc -I . -I googleapis -I protovalidate/proto/protovalidate -I grpc-gateway \
+  --go_out ./pb --go_opt paths=source_relative \
+  --go-grpc_out ./pb --go-grpc_opt paths=source_relative \
+  --grpc-gateway_out ./pb --grpc-gateway_opt paths=source_relative \
+  --grpc-gateway_opt generate_unbound_methods=true \
+  ./billing/writeoff/v1/writeoff.proto;
+
+protoc -I . -I googleapis -I protovalidate/proto/protovalidate -I grpc-gateway \
+  --go_out ./pb --go_opt paths=source_relative \
+  --go-grpc_out ./pb --go-grpc_opt paths=source_relative \
+  --grpc-gateway_out ./pb --grpc-gateway_opt paths=source_relative \
+  --grpc-gateway_opt generate_unbound_methods=true \
+  ./billing/statement/v1/statement.proto;
+
+protoc -I . -I googleapis -I protovalidate/proto/protovalidate -I grpc-gateway \
+  --go_out ./pb --go_opt paths=source_relative \
+  --go-grpc_out ./pb --go-grpc_opt paths=source_relative \
+  --grpc-gateway_out ./pb --grpc-gateway_opt paths=source_relative \
+  --grpc-gateway_opt generate_unbound_methods=true \
+  ./billing/audit/v1/audit.proto;
+
+protoc -I . -I googleapis -I protovalidate/proto/protovalidate -I grpc-gateway \
+  --go_out ./pb --go_opt paths=source_relative \
+  --go-grpc_out ./pb --go-grpc_opt paths=source_relative \
+  --grpc-gateway_out ./pb --grpc-gateway_opt paths=source_relative \
+  --grpc-gateway_opt generate_unbound_methods=true \
+  ./billing/webhook/v1/webhook.proto;
+
+protoc -I . -I googleapis -I protovalidate/proto/protovalidate -I grpc-gateway \
+  --go_out ./pb --go_opt paths=source_relative \
+  --go-grpc_out ./pb --go-grpc_opt paths=source_relative \
+  --grpc-gateway_out ./pb --grpc-gateway_opt paths=source_relative \
+  --grpc-gateway_opt generate_unbound_methods=true \
+  ./billing/reconciliation/v1/reconciliation.proto;
+
+protoc -I . -I googleapis -I protovalidate/proto/protovalidate -I grpc-gateway \
+  --go_out ./pb --go_opt paths=source_relative \
+  --go-grpc_out ./pb --go-grpc_opt paths=source_relative \
+  --grpc-gateway_out ./pb --grpc-gateway_opt paths=source_relative \
+  --grpc-gateway_opt generate_unbound_methods=true \
+  ./billing/job/v1/job.proto;
+
+protoc -I . -I googleapis -I protovalidate/proto/protovalidate -I grpc-gateway \
+  --go_out ./pb --go_opt paths=source_relative \
+  --go-grpc_out ./pb --go-grpc_opt paths=source_relative \
+  --grpc-gateway_out ./pb --grpc-gateway_opt paths=source_relative \
+  --grpc-gateway_opt generate_unbound_methods=true \
+  ./billing/notification/v1/notification.proto;
+
+# generate the openapi v2 spec of every contract merged into openapi/billing.swagger.json, embedded and served by the REST server
+# openapi v3 is not generated, protoc-gen-openapiv2 only emits v2 and v3 is left to the clients converting this spec
+mkdir -p openapi
+protoc -I . -I googleapis -I protovalidate/proto/protovalidate -I grpc-gateway \
+  --openapiv2_out ./openapi \
+  --openapiv2_opt allow_merge=true,merge_file_name=billing,openapi_naming_strategy=simple \
+  --openapiv2_opt disable_service_tags=true \
+  ./billing/loan/v1/loan.proto \
+  ./billing/payment/v1/payment.proto \
+  ./billing/writeoff/v1/writeoff.proto \
+  ./billing/statement/v1/statement.proto \
+  ./billing/audit/v1/audit.proto \
+  ./billing/webhook/v1/webhook.proto \
+  ./billing/reconciliation/v1/reconciliation.proto \
+  ./billing/job/v1/job.proto \
+  ./billing/notification/v1/notification.proto;
 
 # go back to root of project
 cd ./..
\ No newline at end of file
@@ -0,0 +1,7 @@
+// Package openapi embeds the openapi spec generated from the contracts by gen-proto.sh
+package openapi
+
+import _ "embed"
+
+//go:embed billing.swagger.json
+var Spec []byte
@@ -0,0 +1,2520 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v3.20.3
+// source: billing/loan/v1/loan.proto
+
+package loanv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Loan struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	LoanId             string                 `protobuf:"bytes,1,opt,name=loanId,proto3" json:"loanId,omitempty"`
+	UserId             string                 `protobuf:"bytes,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Product            string                 `protobuf:"bytes,3,opt,name=product,proto3" json:"product,omitempty"`
+	Status             string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Amount             float32                `protobuf:"fixed32,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Interest           float32                `protobuf:"fixed32,6,opt,name=interest,proto3" json:"interest,omitempty"`
+	Fee                float32                `protobuf:"fixed32,7,opt,name=fee,proto3" json:"fee,omitempty"`
+	RefinancedByLoanId string                 `protobuf:"bytes,8,opt,name=refinancedByLoanId,proto3" json:"refinancedByLoanId,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// quoted on bank transfers to the loan, also its virtual account number
+	PaymentReference string `protobuf:"bytes,10,opt,name=paymentReference,proto3" json:"paymentReference,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Loan) Reset() {
+	*x = Loan{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Loan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Loan) ProtoMessage() {}
+
+func (x *Loan) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Loan.ProtoReflect.Descriptor instead.
+func (*Loan) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Loan) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *Loan) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Loan) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *Loan) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Loan) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Loan) GetInterest() float32 {
+	if x != nil {
+		return x.Interest
+	}
+	return 0
+}
+
+func (x *Loan) GetFee() float32 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *Loan) GetRefinancedByLoanId() string {
+	if x != nil {
+		return x.RefinancedByLoanId
+	}
+	return ""
+}
+
+func (x *Loan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Loan) GetPaymentReference() string {
+	if x != nil {
+		return x.PaymentReference
+	}
+	return ""
+}
+
+type GetLoanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	LoanId        string                 `protobuf:"bytes,2,opt,name=loanId,proto3" json:"loanId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoanRequest) Reset() {
+	*x = GetLoanRequest{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanRequest) ProtoMessage() {}
+
+func (x *GetLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanRequest.ProtoReflect.Descriptor instead.
+func (*GetLoanRequest) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetLoanRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+type GetLoanResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Loan                *Loan                  `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	Outstanding         float32                `protobuf:"fixed32,2,opt,name=outstanding,proto3" json:"outstanding,omitempty"`
+	Paid                float32                `protobuf:"fixed32,3,opt,name=paid,proto3" json:"paid,omitempty"`
+	PaidInstallments    int32                  `protobuf:"varint,4,opt,name=paidInstallments,proto3" json:"paidInstallments,omitempty"`
+	TotalInstallments   int32                  `protobuf:"varint,5,opt,name=totalInstallments,proto3" json:"totalInstallments,omitempty"`
+	ScheduleVersion     int32                  `protobuf:"varint,6,opt,name=scheduleVersion,proto3" json:"scheduleVersion,omitempty"`
+	InterestRate        float64                `protobuf:"fixed64,7,opt,name=interestRate,proto3" json:"interestRate,omitempty"`
+	Apr                 float64                `protobuf:"fixed64,8,opt,name=apr,proto3" json:"apr,omitempty"`
+	EffectiveAnnualRate float64                `protobuf:"fixed64,9,opt,name=effectiveAnnualRate,proto3" json:"effectiveAnnualRate,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetLoanResponse) Reset() {
+	*x = GetLoanResponse{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanResponse) ProtoMessage() {}
+
+func (x *GetLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanResponse.ProtoReflect.Descriptor instead.
+func (*GetLoanResponse) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetLoanResponse) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+func (x *GetLoanResponse) GetOutstanding() float32 {
+	if x != nil {
+		return x.Outstanding
+	}
+	return 0
+}
+
+func (x *GetLoanResponse) GetPaid() float32 {
+	if x != nil {
+		return x.Paid
+	}
+	return 0
+}
+
+func (x *GetLoanResponse) GetPaidInstallments() int32 {
+	if x != nil {
+		return x.PaidInstallments
+	}
+	return 0
+}
+
+func (x *GetLoanResponse) GetTotalInstallments() int32 {
+	if x != nil {
+		return x.TotalInstallments
+	}
+	return 0
+}
+
+func (x *GetLoanResponse) GetScheduleVersion() int32 {
+	if x != nil {
+		return x.ScheduleVersion
+	}
+	return 0
+}
+
+func (x *GetLoanResponse) GetInterestRate() float64 {
+	if x != nil {
+		return x.InterestRate
+	}
+	return 0
+}
+
+func (x *GetLoanResponse) GetApr() float64 {
+	if x != nil {
+		return x.Apr
+	}
+	return 0
+}
+
+func (x *GetLoanResponse) GetEffectiveAnnualRate() float64 {
+	if x != nil {
+		return x.EffectiveAnnualRate
+	}
+	return 0
+}
+
+type ListLoansRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	// filter by loan status: active, paid_off, written_off or refinanced
+	Status []string `protobuf:"bytes,2,rep,name=status,proto3" json:"status,omitempty"`
+	// at most 100, 0 uses the default page size
+	PageSize int32 `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// nextPageToken of the previous page
+	PageToken     string `protobuf:"bytes,4,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoansRequest) Reset() {
+	*x = ListLoansRequest{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoansRequest) ProtoMessage() {}
+
+func (x *ListLoansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoansRequest.ProtoReflect.Descriptor instead.
+func (*ListLoansRequest) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListLoansRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListLoansRequest) GetStatus() []string {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListLoansRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLoansRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListLoansResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Loans []*Loan                `protobuf:"bytes,1,rep,name=loans,proto3" json:"loans,omitempty"`
+	// empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoansResponse) Reset() {
+	*x = ListLoansResponse{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoansResponse) ProtoMessage() {}
+
+func (x *ListLoansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoansResponse.ProtoReflect.Descriptor instead.
+func (*ListLoansResponse) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListLoansResponse) GetLoans() []*Loan {
+	if x != nil {
+		return x.Loans
+	}
+	return nil
+}
+
+func (x *ListLoansResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateLoanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLoanRequest) Reset() {
+	*x = CreateLoanRequest{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLoanRequest) ProtoMessage() {}
+
+func (x *CreateLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLoanRequest.ProtoReflect.Descriptor instead.
+func (*CreateLoanRequest) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateLoanRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type QuoteLoanRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// principal to borrow, 0 quotes the standard loan amount
+	Amount float32 `protobuf:"fixed32,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// number of weekly installments, 0 quotes the standard tenor
+	Tenor         int32 `protobuf:"varint,2,opt,name=tenor,proto3" json:"tenor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteLoanRequest) Reset() {
+	*x = QuoteLoanRequest{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteLoanRequest) ProtoMessage() {}
+
+func (x *QuoteLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteLoanRequest.ProtoReflect.Descriptor instead.
+func (*QuoteLoanRequest) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QuoteLoanRequest) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *QuoteLoanRequest) GetTenor() int32 {
+	if x != nil {
+		return x.Tenor
+	}
+	return 0
+}
+
+type QuoteLoanResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Principal         float32                `protobuf:"fixed32,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	Tenor             int32                  `protobuf:"varint,2,opt,name=tenor,proto3" json:"tenor,omitempty"`
+	InterestRate      float64                `protobuf:"fixed64,3,opt,name=interestRate,proto3" json:"interestRate,omitempty"`
+	InstallmentAmount float32                `protobuf:"fixed32,4,opt,name=installmentAmount,proto3" json:"installmentAmount,omitempty"`
+	TotalInterest     float32                `protobuf:"fixed32,5,opt,name=totalInterest,proto3" json:"totalInterest,omitempty"`
+	TotalRepayable    float32                `protobuf:"fixed32,6,opt,name=totalRepayable,proto3" json:"totalRepayable,omitempty"`
+	// annual percentage rate, the weekly internal rate of return times 52
+	Apr          float64        `protobuf:"fixed64,7,opt,name=apr,proto3" json:"apr,omitempty"`
+	Installments []*Installment `protobuf:"bytes,8,rep,name=installments,proto3" json:"installments,omitempty"`
+	// weekly internal rate of return compounded over a year
+	EffectiveAnnualRate float64 `protobuf:"fixed64,9,opt,name=effectiveAnnualRate,proto3" json:"effectiveAnnualRate,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *QuoteLoanResponse) Reset() {
+	*x = QuoteLoanResponse{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteLoanResponse) ProtoMessage() {}
+
+func (x *QuoteLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteLoanResponse.ProtoReflect.Descriptor instead.
+func (*QuoteLoanResponse) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QuoteLoanResponse) GetPrincipal() float32 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *QuoteLoanResponse) GetTenor() int32 {
+	if x != nil {
+		return x.Tenor
+	}
+	return 0
+}
+
+func (x *QuoteLoanResponse) GetInterestRate() float64 {
+	if x != nil {
+		return x.InterestRate
+	}
+	return 0
+}
+
+func (x *QuoteLoanResponse) GetInstallmentAmount() float32 {
+	if x != nil {
+		return x.InstallmentAmount
+	}
+	return 0
+}
+
+func (x *QuoteLoanResponse) GetTotalInterest() float32 {
+	if x != nil {
+		return x.TotalInterest
+	}
+	return 0
+}
+
+func (x *QuoteLoanResponse) GetTotalRepayable() float32 {
+	if x != nil {
+		return x.TotalRepayable
+	}
+	return 0
+}
+
+func (x *QuoteLoanResponse) GetApr() float64 {
+	if x != nil {
+		return x.Apr
+	}
+	return 0
+}
+
+func (x *QuoteLoanResponse) GetInstallments() []*Installment {
+	if x != nil {
+		return x.Installments
+	}
+	return nil
+}
+
+func (x *QuoteLoanResponse) GetEffectiveAnnualRate() float64 {
+	if x != nil {
+		return x.EffectiveAnnualRate
+	}
+	return 0
+}
+
+type GetOutstandingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOutstandingRequest) Reset() {
+	*x = GetOutstandingRequest{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOutstandingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOutstandingRequest) ProtoMessage() {}
+
+func (x *GetOutstandingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOutstandingRequest.ProtoReflect.Descriptor instead.
+func (*GetOutstandingRequest) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetOutstandingRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetLoanOutstandingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	LoanId        string                 `protobuf:"bytes,2,opt,name=loanId,proto3" json:"loanId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoanOutstandingRequest) Reset() {
+	*x = GetLoanOutstandingRequest{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoanOutstandingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanOutstandingRequest) ProtoMessage() {}
+
+func (x *GetLoanOutstandingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanOutstandingRequest.ProtoReflect.Descriptor instead.
+func (*GetLoanOutstandingRequest) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetLoanOutstandingRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetLoanOutstandingRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+type LoanOutstanding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LoanId        string                 `protobuf:"bytes,1,opt,name=loanId,proto3" json:"loanId,omitempty"`
+	Outstanding   float32                `protobuf:"fixed32,2,opt,name=outstanding,proto3" json:"outstanding,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoanOutstanding) Reset() {
+	*x = LoanOutstanding{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoanOutstanding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanOutstanding) ProtoMessage() {}
+
+func (x *LoanOutstanding) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanOutstanding.ProtoReflect.Descriptor instead.
+func (*LoanOutstanding) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LoanOutstanding) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *LoanOutstanding) GetOutstanding() float32 {
+	if x != nil {
+		return x.Outstanding
+	}
+	return 0
+}
+
+type GetOutstandingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outstanding   float32                `protobuf:"fixed32,1,opt,name=outstanding,proto3" json:"outstanding,omitempty"`
+	Loans         []*LoanOutstanding     `protobuf:"bytes,2,rep,name=loans,proto3" json:"loans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOutstandingResponse) Reset() {
+	*x = GetOutstandingResponse{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOutstandingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOutstandingResponse) ProtoMessage() {}
+
+func (x *GetOutstandingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOutstandingResponse.ProtoReflect.Descriptor instead.
+func (*GetOutstandingResponse) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetOutstandingResponse) GetOutstanding() float32 {
+	if x != nil {
+		return x.Outstanding
+	}
+	return 0
+}
+
+func (x *GetOutstandingResponse) GetLoans() []*LoanOutstanding {
+	if x != nil {
+		return x.Loans
+	}
+	return nil
+}
+
+type GetRepaymentScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	LoanId        string                 `protobuf:"bytes,2,opt,name=loanId,proto3" json:"loanId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRepaymentScheduleRequest) Reset() {
+	*x = GetRepaymentScheduleRequest{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRepaymentScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRepaymentScheduleRequest) ProtoMessage() {}
+
+func (x *GetRepaymentScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRepaymentScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetRepaymentScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetRepaymentScheduleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetRepaymentScheduleRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+type Installment struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Sequence   int32                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	PaymentId  string                 `protobuf:"bytes,2,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	StartAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=startAt,proto3" json:"startAt,omitempty"`
+	EndAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=endAt,proto3" json:"endAt,omitempty"`
+	DueAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=dueAt,proto3" json:"dueAt,omitempty"`
+	Amount     float32                `protobuf:"fixed32,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountPaid float32                `protobuf:"fixed32,7,opt,name=amountPaid,proto3" json:"amountPaid,omitempty"`
+	PaidAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=paidAt,proto3" json:"paidAt,omitempty"`
+	// upcoming, due, overdue or paid
+	Status        string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Installment) Reset() {
+	*x = Installment{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Installment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Installment) ProtoMessage() {}
+
+func (x *Installment) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Installment.ProtoReflect.Descriptor instead.
+func (*Installment) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Installment) GetSequence() int32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Installment) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *Installment) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *Installment) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+func (x *Installment) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *Installment) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Installment) GetAmountPaid() float32 {
+	if x != nil {
+		return x.AmountPaid
+	}
+	return 0
+}
+
+func (x *Installment) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+func (x *Installment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type RepaymentScheduleSummary struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TotalInstallments   int32                  `protobuf:"varint,1,opt,name=totalInstallments,proto3" json:"totalInstallments,omitempty"`
+	PaidInstallments    int32                  `protobuf:"varint,2,opt,name=paidInstallments,proto3" json:"paidInstallments,omitempty"`
+	OverdueInstallments int32                  `protobuf:"varint,3,opt,name=overdueInstallments,proto3" json:"overdueInstallments,omitempty"`
+	NextDue             *Installment           `protobuf:"bytes,4,opt,name=nextDue,proto3" json:"nextDue,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RepaymentScheduleSummary) Reset() {
+	*x = RepaymentScheduleSummary{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepaymentScheduleSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepaymentScheduleSummary) ProtoMessage() {}
+
+func (x *RepaymentScheduleSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepaymentScheduleSummary.ProtoReflect.Descriptor instead.
+func (*RepaymentScheduleSummary) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RepaymentScheduleSummary) GetTotalInstallments() int32 {
+	if x != nil {
+		return x.TotalInstallments
+	}
+	return 0
+}
+
+func (x *RepaymentScheduleSummary) GetPaidInstallments() int32 {
+	if x != nil {
+		return x.PaidInstallments
+	}
+	return 0
+}
+
+func (x *RepaymentScheduleSummary) GetOverdueInstallments() int32 {
+	if x != nil {
+		return x.OverdueInstallments
+	}
+	return 0
+}
+
+func (x *RepaymentScheduleSummary) GetNextDue() *Installment {
+	if x != nil {
+		return x.NextDue
+	}
+	return nil
+}
+
+type GetRepaymentScheduleResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	LoanId        string                    `protobuf:"bytes,1,opt,name=loanId,proto3" json:"loanId,omitempty"`
+	Installments  []*Installment            `protobuf:"bytes,2,rep,name=installments,proto3" json:"installments,omitempty"`
+	Summary       *RepaymentScheduleSummary `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRepaymentScheduleResponse) Reset() {
+	*x = GetRepaymentScheduleResponse{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRepaymentScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRepaymentScheduleResponse) ProtoMessage() {}
+
+func (x *GetRepaymentScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRepaymentScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetRepaymentScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetRepaymentScheduleResponse) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *GetRepaymentScheduleResponse) GetInstallments() []*Installment {
+	if x != nil {
+		return x.Installments
+	}
+	return nil
+}
+
+func (x *GetRepaymentScheduleResponse) GetSummary() *RepaymentScheduleSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type GetIsDelinquentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIsDelinquentRequest) Reset() {
+	*x = GetIsDelinquentRequest{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIsDelinquentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIsDelinquentRequest) ProtoMessage() {}
+
+func (x *GetIsDelinquentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIsDelinquentRequest.ProtoReflect.Descriptor instead.
+func (*GetIsDelinquentRequest) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetIsDelinquentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetLoanIsDelinquentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	LoanId        string                 `protobuf:"bytes,2,opt,name=loanId,proto3" json:"loanId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoanIsDelinquentRequest) Reset() {
+	*x = GetLoanIsDelinquentRequest{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoanIsDelinquentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanIsDelinquentRequest) ProtoMessage() {}
+
+func (x *GetLoanIsDelinquentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanIsDelinquentRequest.ProtoReflect.Descriptor instead.
+func (*GetLoanIsDelinquentRequest) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetLoanIsDelinquentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetLoanIsDelinquentRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+type LoanIsDelinquent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LoanId        string                 `protobuf:"bytes,1,opt,name=loanId,proto3" json:"loanId,omitempty"`
+	IsDelinquent  bool                   `protobuf:"varint,2,opt,name=isDelinquent,proto3" json:"isDelinquent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoanIsDelinquent) Reset() {
+	*x = LoanIsDelinquent{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoanIsDelinquent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanIsDelinquent) ProtoMessage() {}
+
+func (x *LoanIsDelinquent) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanIsDelinquent.ProtoReflect.Descriptor instead.
+func (*LoanIsDelinquent) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LoanIsDelinquent) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *LoanIsDelinquent) GetIsDelinquent() bool {
+	if x != nil {
+		return x.IsDelinquent
+	}
+	return false
+}
+
+type GetIsDelinquentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsDelinquent  bool                   `protobuf:"varint,1,opt,name=isDelinquent,proto3" json:"isDelinquent,omitempty"`
+	Loans         []*LoanIsDelinquent    `protobuf:"bytes,2,rep,name=loans,proto3" json:"loans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIsDelinquentResponse) Reset() {
+	*x = GetIsDelinquentResponse{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIsDelinquentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIsDelinquentResponse) ProtoMessage() {}
+
+func (x *GetIsDelinquentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIsDelinquentResponse.ProtoReflect.Descriptor instead.
+func (*GetIsDelinquentResponse) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetIsDelinquentResponse) GetIsDelinquent() bool {
+	if x != nil {
+		return x.IsDelinquent
+	}
+	return false
+}
+
+func (x *GetIsDelinquentResponse) GetLoans() []*LoanIsDelinquent {
+	if x != nil {
+		return x.Loans
+	}
+	return nil
+}
+
+type RestructureLoanRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	LoanId string                 `protobuf:"bytes,2,opt,name=loanId,proto3" json:"loanId,omitempty"`
+	// number of weekly installments of the new schedule, 0 keeps the number of restructured installments
+	Tenor int32 `protobuf:"varint,3,opt,name=tenor,proto3" json:"tenor,omitempty"`
+	// flat interest rate of the new schedule, unset keeps the current rate
+	InterestRate *float64 `protobuf:"fixed64,4,opt,name=interestRate,proto3,oneof" json:"interestRate,omitempty"`
+	// add the overdue installments to the principal of the new schedule
+	CapitaliseArrears bool `protobuf:"varint,5,opt,name=capitaliseArrears,proto3" json:"capitaliseArrears,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RestructureLoanRequest) Reset() {
+	*x = RestructureLoanRequest{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestructureLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestructureLoanRequest) ProtoMessage() {}
+
+func (x *RestructureLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestructureLoanRequest.ProtoReflect.Descriptor instead.
+func (*RestructureLoanRequest) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RestructureLoanRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RestructureLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *RestructureLoanRequest) GetTenor() int32 {
+	if x != nil {
+		return x.Tenor
+	}
+	return 0
+}
+
+func (x *RestructureLoanRequest) GetInterestRate() float64 {
+	if x != nil && x.InterestRate != nil {
+		return *x.InterestRate
+	}
+	return 0
+}
+
+func (x *RestructureLoanRequest) GetCapitaliseArrears() bool {
+	if x != nil {
+		return x.CapitaliseArrears
+	}
+	return false
+}
+
+type RestructureLoanResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId         string                 `protobuf:"bytes,1,opt,name=scheduleId,proto3" json:"scheduleId,omitempty"`
+	Version            int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	PreviousScheduleId string                 `protobuf:"bytes,3,opt,name=previousScheduleId,proto3" json:"previousScheduleId,omitempty"`
+	Principal          float32                `protobuf:"fixed32,4,opt,name=principal,proto3" json:"principal,omitempty"`
+	Interest           float32                `protobuf:"fixed32,5,opt,name=interest,proto3" json:"interest,omitempty"`
+	InterestRate       float64                `protobuf:"fixed64,6,opt,name=interestRate,proto3" json:"interestRate,omitempty"`
+	Tenor              int32                  `protobuf:"varint,7,opt,name=tenor,proto3" json:"tenor,omitempty"`
+	InstallmentAmount  float32                `protobuf:"fixed32,8,opt,name=installmentAmount,proto3" json:"installmentAmount,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RestructureLoanResponse) Reset() {
+	*x = RestructureLoanResponse{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestructureLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestructureLoanResponse) ProtoMessage() {}
+
+func (x *RestructureLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestructureLoanResponse.ProtoReflect.Descriptor instead.
+func (*RestructureLoanResponse) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RestructureLoanResponse) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *RestructureLoanResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RestructureLoanResponse) GetPreviousScheduleId() string {
+	if x != nil {
+		return x.PreviousScheduleId
+	}
+	return ""
+}
+
+func (x *RestructureLoanResponse) GetPrincipal() float32 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *RestructureLoanResponse) GetInterest() float32 {
+	if x != nil {
+		return x.Interest
+	}
+	return 0
+}
+
+func (x *RestructureLoanResponse) GetInterestRate() float64 {
+	if x != nil {
+		return x.InterestRate
+	}
+	return 0
+}
+
+func (x *RestructureLoanResponse) GetTenor() int32 {
+	if x != nil {
+		return x.Tenor
+	}
+	return 0
+}
+
+func (x *RestructureLoanResponse) GetInstallmentAmount() float32 {
+	if x != nil {
+		return x.InstallmentAmount
+	}
+	return 0
+}
+
+type DeferInstallmentsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	LoanId string                 `protobuf:"bytes,2,opt,name=loanId,proto3" json:"loanId,omitempty"`
+	// number of upcoming installments to push back
+	Installments  int32 `protobuf:"varint,3,opt,name=installments,proto3" json:"installments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeferInstallmentsRequest) Reset() {
+	*x = DeferInstallmentsRequest{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeferInstallmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeferInstallmentsRequest) ProtoMessage() {}
+
+func (x *DeferInstallmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeferInstallmentsRequest.ProtoReflect.Descriptor instead.
+func (*DeferInstallmentsRequest) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeferInstallmentsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeferInstallmentsRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *DeferInstallmentsRequest) GetInstallments() int32 {
+	if x != nil {
+		return x.Installments
+	}
+	return 0
+}
+
+type DeferInstallmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeferralId    string                 `protobuf:"bytes,1,opt,name=deferralId,proto3" json:"deferralId,omitempty"`
+	Installments  int32                  `protobuf:"varint,2,opt,name=installments,proto3" json:"installments,omitempty"`
+	StartAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=startAt,proto3" json:"startAt,omitempty"`
+	EndAt         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=endAt,proto3" json:"endAt,omitempty"`
+	Fee           float32                `protobuf:"fixed32,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	Interest      float32                `protobuf:"fixed32,6,opt,name=interest,proto3" json:"interest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeferInstallmentsResponse) Reset() {
+	*x = DeferInstallmentsResponse{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeferInstallmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeferInstallmentsResponse) ProtoMessage() {}
+
+func (x *DeferInstallmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeferInstallmentsResponse.ProtoReflect.Descriptor instead.
+func (*DeferInstallmentsResponse) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeferInstallmentsResponse) GetDeferralId() string {
+	if x != nil {
+		return x.DeferralId
+	}
+	return ""
+}
+
+func (x *DeferInstallmentsResponse) GetInstallments() int32 {
+	if x != nil {
+		return x.Installments
+	}
+	return 0
+}
+
+func (x *DeferInstallmentsResponse) GetStartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartAt
+	}
+	return nil
+}
+
+func (x *DeferInstallmentsResponse) GetEndAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndAt
+	}
+	return nil
+}
+
+func (x *DeferInstallmentsResponse) GetFee() float32 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *DeferInstallmentsResponse) GetInterest() float32 {
+	if x != nil {
+		return x.Interest
+	}
+	return 0
+}
+
+type TopUpLoanRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	LoanId string                 `protobuf:"bytes,2,opt,name=loanId,proto3" json:"loanId,omitempty"`
+	// new cash on top of the remaining principal of the loan
+	Amount        float32 `protobuf:"fixed32,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopUpLoanRequest) Reset() {
+	*x = TopUpLoanRequest{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopUpLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUpLoanRequest) ProtoMessage() {}
+
+func (x *TopUpLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUpLoanRequest.ProtoReflect.Descriptor instead.
+func (*TopUpLoanRequest) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TopUpLoanRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TopUpLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *TopUpLoanRequest) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type TopUpLoanResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LoanId           string                 `protobuf:"bytes,1,opt,name=loanId,proto3" json:"loanId,omitempty"`
+	RefinancedLoanId string                 `protobuf:"bytes,2,opt,name=refinancedLoanId,proto3" json:"refinancedLoanId,omitempty"`
+	Principal        float32                `protobuf:"fixed32,3,opt,name=principal,proto3" json:"principal,omitempty"`
+	Interest         float32                `protobuf:"fixed32,4,opt,name=interest,proto3" json:"interest,omitempty"`
+	// the remaining principal of the refinanced loan, without the interest of the installments not due yet
+	PayoffAmount      float32 `protobuf:"fixed32,5,opt,name=payoffAmount,proto3" json:"payoffAmount,omitempty"`
+	NetDisbursement   float32 `protobuf:"fixed32,6,opt,name=netDisbursement,proto3" json:"netDisbursement,omitempty"`
+	Tenor             int32   `protobuf:"varint,7,opt,name=tenor,proto3" json:"tenor,omitempty"`
+	InstallmentAmount float32 `protobuf:"fixed32,8,opt,name=installmentAmount,proto3" json:"installmentAmount,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TopUpLoanResponse) Reset() {
+	*x = TopUpLoanResponse{}
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopUpLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUpLoanResponse) ProtoMessage() {}
+
+func (x *TopUpLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_loan_v1_loan_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUpLoanResponse.ProtoReflect.Descriptor instead.
+func (*TopUpLoanResponse) Descriptor() ([]byte, []int) {
+	return file_billing_loan_v1_loan_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TopUpLoanResponse) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *TopUpLoanResponse) GetRefinancedLoanId() string {
+	if x != nil {
+		return x.RefinancedLoanId
+	}
+	return ""
+}
+
+func (x *TopUpLoanResponse) GetPrincipal() float32 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *TopUpLoanResponse) GetInterest() float32 {
+	if x != nil {
+		return x.Interest
+	}
+	return 0
+}
+
+func (x *TopUpLoanResponse) GetPayoffAmount() float32 {
+	if x != nil {
+		return x.PayoffAmount
+	}
+	return 0
+}
+
+func (x *TopUpLoanResponse) GetNetDisbursement() float32 {
+	if x != nil {
+		return x.NetDisbursement
+	}
+	return 0
+}
+
+func (x *TopUpLoanResponse) GetTenor() int32 {
+	if x != nil {
+		return x.Tenor
+	}
+	return 0
+}
+
+func (x *TopUpLoanResponse) GetInstallmentAmount() float32 {
+	if x != nil {
+		return x.InstallmentAmount
+	}
+	return 0
+}
+
+var File_billing_loan_v1_loan_proto protoreflect.FileDescriptor
+
+var file_billing_loan_v1_loan_proto_rawDesc = string([]byte{
+	0x0a, 0x1a, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x2f, 0x76,
+	0x31, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x62, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62,
+	0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67,
+	0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc4, 0x02, 0x0a, 0x04, 0x4c, 0x6f, 0x61, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x72, 0x65, 0x66, 0x69, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x64, 0x42, 0x79, 0x4c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x66, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x42,
+	0x79, 0x4c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x90, 0x01,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x31, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x19, 0x92, 0x41, 0x0d, 0x4a, 0x0b, 0x22, 0x75, 0x73, 0x65, 0x72, 0x2d, 0x31, 0x30, 0x30,
+	0x31, 0x22, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x32, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x28, 0x4a, 0x26, 0x22, 0x30, 0x62, 0x39, 0x61, 0x33,
+	0x65, 0x35, 0x32, 0x2d, 0x32, 0x66, 0x37, 0x63, 0x2d, 0x31, 0x31, 0x66, 0x30, 0x2d, 0x39, 0x63,
+	0x64, 0x32, 0x2d, 0x30, 0x32, 0x34, 0x32, 0x61, 0x63, 0x31, 0x32, 0x30, 0x30, 0x30, 0x32, 0x22,
+	0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64,
+	0x22, 0xde, 0x02, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x12,
+	0x20, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x04, 0x70, 0x61, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x61, 0x69, 0x64, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x10, 0x70, 0x61, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x2c, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x28, 0x0a, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x61, 0x70, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x61, 0x70, 0x72, 0x12,
+	0x30, 0x0a, 0x13, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x41, 0x6e, 0x6e, 0x75,
+	0x61, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x41, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x52, 0x61, 0x74,
+	0x65, 0x22, 0xef, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x0d, 0x4a, 0x0b, 0x22, 0x75, 0x73,
+	0x65, 0x72, 0x2d, 0x31, 0x30, 0x30, 0x31, 0x22, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18,
+	0x32, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x5c, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x44, 0x92, 0x41, 0x0c, 0x4a, 0x0a,
+	0x5b, 0x22, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x5d, 0xba, 0x48, 0x32, 0x92, 0x01, 0x2f,
+	0x22, 0x2d, 0x72, 0x2b, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x08, 0x70, 0x61,
+	0x69, 0x64, 0x5f, 0x6f, 0x66, 0x66, 0x52, 0x0b, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x5f,
+	0x6f, 0x66, 0x66, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x10, 0x92, 0x41, 0x04, 0x4a, 0x02,
+	0x32, 0x30, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x18, 0x64, 0x28, 0x00, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x66, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x6c, 0x6f, 0x61, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x05,
+	0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x46, 0x0a, 0x11, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x31, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x19, 0x92, 0x41, 0x0d, 0x4a, 0x0b, 0x22, 0x75, 0x73, 0x65, 0x72, 0x2d, 0x31, 0x30, 0x30,
+	0x31, 0x22, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x32, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x6b, 0x0a, 0x10, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x42, 0x16, 0x92, 0x41, 0x09, 0x4a, 0x07, 0x35, 0x30,
+	0x30, 0x30, 0x30, 0x30, 0x30, 0xba, 0x48, 0x07, 0x0a, 0x05, 0x2d, 0x00, 0x00, 0x00, 0x00, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x65, 0x6e, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x11, 0x92, 0x41, 0x04, 0x4a, 0x02, 0x35, 0x30, 0xba,
+	0x48, 0x07, 0x1a, 0x05, 0x18, 0x84, 0x02, 0x28, 0x00, 0x52, 0x05, 0x74, 0x65, 0x6e, 0x6f, 0x72,
+	0x22, 0xed, 0x02, 0x0a, 0x11, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69,
+	0x70, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63,
+	0x69, 0x70, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x6e, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x65, 0x6e, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2c,
+	0x0a, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x61, 0x79,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x52, 0x65, 0x70, 0x61, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x61, 0x70, 0x72, 0x12, 0x40, 0x0a, 0x0c,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30,
+	0x0a, 0x13, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x41, 0x6e, 0x6e, 0x75, 0x61,
+	0x6c, 0x52, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x65, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x41, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x65,
+	0x22, 0x4a, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x0d, 0x4a, 0x0b,
+	0x22, 0x75, 0x73, 0x65, 0x72, 0x2d, 0x31, 0x30, 0x30, 0x31, 0x22, 0xba, 0x48, 0x06, 0x72, 0x04,
+	0x10, 0x01, 0x18, 0x32, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x9b, 0x01, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x0d, 0x4a,
+	0x0b, 0x22, 0x75, 0x73, 0x65, 0x72, 0x2d, 0x31, 0x30, 0x30, 0x31, 0x22, 0xba, 0x48, 0x06, 0x72,
+	0x04, 0x10, 0x01, 0x18, 0x32, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4b, 0x0a,
+	0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92,
+	0x41, 0x28, 0x4a, 0x26, 0x22, 0x30, 0x62, 0x39, 0x61, 0x33, 0x65, 0x35, 0x32, 0x2d, 0x32, 0x66,
+	0x37, 0x63, 0x2d, 0x31, 0x31, 0x66, 0x30, 0x2d, 0x39, 0x63, 0x64, 0x32, 0x2d, 0x30, 0x32, 0x34,
+	0x32, 0x61, 0x63, 0x31, 0x32, 0x30, 0x30, 0x30, 0x32, 0x22, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0,
+	0x01, 0x01, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x0f, 0x4c, 0x6f,
+	0x61, 0x6e, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c,
+	0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x73,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x72, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4f, 0x75,
+	0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a, 0x05, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x1b,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x0d,
+	0x4a, 0x0b, 0x22, 0x75, 0x73, 0x65, 0x72, 0x2d, 0x31, 0x30, 0x30, 0x31, 0x22, 0xba, 0x48, 0x06,
+	0x72, 0x04, 0x10, 0x01, 0x18, 0x32, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4b,
+	0x0a, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33,
+	0x92, 0x41, 0x28, 0x4a, 0x26, 0x22, 0x30, 0x62, 0x39, 0x61, 0x33, 0x65, 0x35, 0x32, 0x2d, 0x32,
+	0x66, 0x37, 0x63, 0x2d, 0x31, 0x31, 0x66, 0x30, 0x2d, 0x39, 0x63, 0x64, 0x32, 0x2d, 0x30, 0x32,
+	0x34, 0x32, 0x61, 0x63, 0x31, 0x32, 0x30, 0x30, 0x30, 0x32, 0x22, 0xba, 0x48, 0x05, 0x72, 0x03,
+	0xb0, 0x01, 0x01, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x22, 0xe5, 0x02, 0x0a, 0x0b,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x65,
+	0x6e, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a,
+	0x05, 0x64, 0x75, 0x65, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x64, 0x75, 0x65, 0x41, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x50, 0x61, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x70, 0x61, 0x69, 0x64, 0x41,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x06, 0x70, 0x61, 0x69, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0xde, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0x2c, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2a,
+	0x0a, 0x10, 0x70, 0x61, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x70, 0x61, 0x69, 0x64, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x6f, 0x76,
+	0x65, 0x72, 0x64, 0x75, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x6f, 0x76, 0x65, 0x72, 0x64, 0x75, 0x65,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x07,
+	0x6e, 0x65, 0x78, 0x74, 0x44, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x6e, 0x65, 0x78,
+	0x74, 0x44, 0x75, 0x65, 0x22, 0xbd, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x40, 0x0a,
+	0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f,
+	0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x43, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x22, 0x4b, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x49, 0x73, 0x44, 0x65, 0x6c,
+	0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19,
+	0x92, 0x41, 0x0d, 0x4a, 0x0b, 0x22, 0x75, 0x73, 0x65, 0x72, 0x2d, 0x31, 0x30, 0x30, 0x31, 0x22,
+	0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x32, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x9c, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x49, 0x73, 0x44,
+	0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x31, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x19, 0x92, 0x41, 0x0d, 0x4a, 0x0b, 0x22, 0x75, 0x73, 0x65, 0x72, 0x2d, 0x31, 0x30, 0x30,
+	0x31, 0x22, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x32, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x28, 0x4a, 0x26, 0x22, 0x30, 0x62, 0x39, 0x61, 0x33,
+	0x65, 0x35, 0x32, 0x2d, 0x32, 0x66, 0x37, 0x63, 0x2d, 0x31, 0x31, 0x66, 0x30, 0x2d, 0x39, 0x63,
+	0x64, 0x32, 0x2d, 0x30, 0x32, 0x34, 0x32, 0x61, 0x63, 0x31, 0x32, 0x30, 0x30, 0x30, 0x32, 0x22,
+	0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64,
+	0x22, 0x4e, 0x0a, 0x10, 0x4c, 0x6f, 0x61, 0x6e, 0x49, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71,
+	0x75, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c,
+	0x69, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74,
+	0x22, 0x76, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x49, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69,
+	0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x12,
+	0x37, 0x0a, 0x05, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x49, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e,
+	0x74, 0x52, 0x05, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x22, 0xca, 0x02, 0x0a, 0x16, 0x52, 0x65, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x0d, 0x4a, 0x0b, 0x22, 0x75, 0x73, 0x65, 0x72, 0x2d,
+	0x31, 0x30, 0x30, 0x31, 0x22, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x32, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x28, 0x4a, 0x26, 0x22, 0x30, 0x62,
+	0x39, 0x61, 0x33, 0x65, 0x35, 0x32, 0x2d, 0x32, 0x66, 0x37, 0x63, 0x2d, 0x31, 0x31, 0x66, 0x30,
+	0x2d, 0x39, 0x63, 0x64, 0x32, 0x2d, 0x30, 0x32, 0x34, 0x32, 0x61, 0x63, 0x31, 0x32, 0x30, 0x30,
+	0x30, 0x32, 0x22, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x6c, 0x6f, 0x61,
+	0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x65, 0x6e, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x11, 0x92, 0x41, 0x04, 0x4a, 0x02, 0x36, 0x30, 0xba, 0x48, 0x07, 0x1a, 0x05,
+	0x18, 0x84, 0x02, 0x28, 0x00, 0x52, 0x05, 0x74, 0x65, 0x6e, 0x6f, 0x72, 0x12, 0x48, 0x0a, 0x0c,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x42, 0x1f, 0x92, 0x41, 0x05, 0x4a, 0x03, 0x30, 0x2e, 0x31, 0xba, 0x48, 0x14, 0x12,
+	0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x48, 0x00, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52,
+	0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x61, 0x70, 0x69, 0x74, 0x61,
+	0x6c, 0x69, 0x73, 0x65, 0x41, 0x72, 0x72, 0x65, 0x61, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x63, 0x61, 0x70, 0x69, 0x74, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x41, 0x72, 0x72,
+	0x65, 0x61, 0x72, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73,
+	0x74, 0x52, 0x61, 0x74, 0x65, 0x22, 0xa5, 0x02, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09,
+	0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73,
+	0x74, 0x52, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x6e,
+	0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x65, 0x6e, 0x6f, 0x72, 0x12,
+	0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xcd, 0x01,
+	0x0a, 0x18, 0x44, 0x65, 0x66, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x0d, 0x4a,
+	0x0b, 0x22, 0x75, 0x73, 0x65, 0x72, 0x2d, 0x31, 0x30, 0x30, 0x31, 0x22, 0xba, 0x48, 0x06, 0x72,
+	0x04, 0x10, 0x01, 0x18, 0x32, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4b, 0x0a,
+	0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92,
+	0x41, 0x28, 0x4a, 0x26, 0x22, 0x30, 0x62, 0x39, 0x61, 0x33, 0x65, 0x35, 0x32, 0x2d, 0x32, 0x66,
+	0x37, 0x63, 0x2d, 0x31, 0x31, 0x66, 0x30, 0x2d, 0x39, 0x63, 0x64, 0x32, 0x2d, 0x30, 0x32, 0x34,
+	0x32, 0x61, 0x63, 0x31, 0x32, 0x30, 0x30, 0x30, 0x32, 0x22, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0,
+	0x01, 0x01, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x0c, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x0d, 0x92, 0x41, 0x03, 0x4a, 0x01, 0x32, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52,
+	0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xf5, 0x01,
+	0x0a, 0x19, 0x44, 0x65, 0x66, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64,
+	0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x64, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x34, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x22, 0xc2, 0x01, 0x0a, 0x10, 0x54, 0x6f, 0x70, 0x55, 0x70, 0x4c,
+	0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0x92, 0x41, 0x0d, 0x4a,
+	0x0b, 0x22, 0x75, 0x73, 0x65, 0x72, 0x2d, 0x31, 0x30, 0x30, 0x31, 0x22, 0xba, 0x48, 0x06, 0x72,
+	0x04, 0x10, 0x01, 0x18, 0x32, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4b, 0x0a,
+	0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92,
+	0x41, 0x28, 0x4a, 0x26, 0x22, 0x30, 0x62, 0x39, 0x61, 0x33, 0x65, 0x35, 0x32, 0x2d, 0x32, 0x66,
+	0x37, 0x63, 0x2d, 0x31, 0x31, 0x66, 0x30, 0x2d, 0x39, 0x63, 0x64, 0x32, 0x2d, 0x30, 0x32, 0x34,
+	0x32, 0x61, 0x63, 0x31, 0x32, 0x30, 0x30, 0x30, 0x32, 0x22, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0,
+	0x01, 0x01, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x42, 0x16, 0x92, 0x41, 0x09, 0x4a,
+	0x07, 0x31, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0xba, 0x48, 0x07, 0x0a, 0x05, 0x25, 0x00, 0x00,
+	0x00, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa3, 0x02, 0x0a, 0x11, 0x54,
+	0x6f, 0x70, 0x55, 0x70, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x66, 0x69,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x4c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x66, 0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x64, 0x4c, 0x6f,
+	0x61, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70,
+	0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x22,
+	0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x70, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x44, 0x69, 0x73, 0x62, 0x75, 0x72, 0x73,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x6e, 0x65, 0x74,
+	0x44, 0x69, 0x73, 0x62, 0x75, 0x72, 0x73, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x65, 0x6e, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x65, 0x6e,
+	0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e,
+	0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x32, 0xa1, 0x17, 0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0xec, 0x01, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x1f, 0x2e, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x9d, 0x01, 0x92, 0x41, 0x80, 0x01, 0x0a, 0x05, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x0a, 0x47,
+	0x65, 0x74, 0x20, 0x61, 0x20, 0x6c, 0x6f, 0x61, 0x6e, 0x1a, 0x6b, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x6f, 0x61, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x20, 0x77, 0x69, 0x74, 0x68,
+	0x20, 0x69, 0x74, 0x73, 0x20, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x2c, 0x20, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x2c, 0x20, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x20, 0x74, 0x65, 0x72,
+	0x6d, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x41, 0x50, 0x52, 0x20, 0x64, 0x69, 0x73, 0x63, 0x6c,
+	0x6f, 0x73, 0x75, 0x72, 0x65, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76,
+	0x31, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x2f, 0x7b, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x7d, 0x12,
+	0xc9, 0x01, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x21, 0x2e,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x75, 0x92, 0x41, 0x62, 0x0a, 0x05, 0x4c, 0x6f, 0x61, 0x6e, 0x73,
+	0x12, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x1a, 0x4d, 0x4c, 0x69,
+	0x73, 0x74, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x2c, 0x20, 0x6e,
+	0x65, 0x77, 0x65, 0x73, 0x74, 0x20, 0x66, 0x69, 0x72, 0x73, 0x74, 0x2c, 0x20, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x6c, 0x79, 0x20, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x20, 0x62, 0x79, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0a, 0x12, 0x08, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x12, 0xc6, 0x01, 0x0a, 0x0a,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x22, 0x2e, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x7c, 0x92, 0x41, 0x66, 0x0a, 0x05, 0x4c, 0x6f, 0x61,
+	0x6e, 0x73, 0x12, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x6c, 0x6f, 0x61,
+	0x6e, 0x1a, 0x4e, 0x44, 0x69, 0x73, 0x62, 0x75, 0x72, 0x73, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x20, 0x6c, 0x6f, 0x61, 0x6e, 0x20, 0x74,
+	0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x20, 0x77,
+	0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65,
+	0x20, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x20, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x73, 0x20, 0x69, 0x74,
+	0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x3a, 0x01, 0x2a, 0x22, 0x08, 0x2f, 0x76, 0x31, 0x2f,
+	0x6c, 0x6f, 0x61, 0x6e, 0x12, 0xda, 0x01, 0x0a, 0x09, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f,
+	0x61, 0x6e, 0x12, 0x21, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x61,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x01, 0x92, 0x41, 0x6c, 0x0a,
+	0x05, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x0c, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x20, 0x61, 0x20,
+	0x6c, 0x6f, 0x61, 0x6e, 0x1a, 0x55, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x20, 0x61, 0x20, 0x6c,
+	0x6f, 0x61, 0x6e, 0x20, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x20, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x74, 0x2c, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x41, 0x50, 0x52, 0x20,
+	0x64, 0x69, 0x73, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x2f, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x12, 0xee, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8a, 0x01, 0x92, 0x41, 0x6b, 0x0a, 0x05, 0x4c, 0x6f, 0x61,
+	0x6e, 0x73, 0x12, 0x18, 0x47, 0x65, 0x74, 0x20, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72,
+	0x20, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x1a, 0x48, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x20, 0x61, 0x63,
+	0x72, 0x6f, 0x73, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x20,
+	0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x6f, 0x72,
+	0x72, 0x6f, 0x77, 0x65, 0x72, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76,
+	0x31, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x2f, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x12, 0xe3, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x4f, 0x75,
+	0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x2e, 0x62, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c,
+	0x6f, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x74,
+	0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78,
+	0x92, 0x41, 0x50, 0x0a, 0x05, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x14, 0x47, 0x65, 0x74, 0x20,
+	0x6c, 0x6f, 0x61, 0x6e, 0x20, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x1a, 0x31, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x75,
+	0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x20, 0x6c, 0x6f,
+	0x61, 0x6e, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x6c,
+	0x6f, 0x61, 0x6e, 0x2f, 0x7b, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x7d, 0x2f, 0x6f, 0x75, 0x74,
+	0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x9c, 0x02, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x2c, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2d, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa6,
+	0x01, 0x92, 0x41, 0x80, 0x01, 0x0a, 0x05, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x16, 0x47, 0x65,
+	0x74, 0x20, 0x72, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x1a, 0x5f, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x65, 0x76,
+	0x65, 0x72, 0x79, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x20,
+	0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x20, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x69, 0x74, 0x73,
+	0x20, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20,
+	0x6e, 0x65, 0x78, 0x74, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x20, 0x64, 0x75, 0x65, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31,
+	0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x2f, 0x7b, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x7d, 0x2f, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0xf2, 0x01, 0x0a, 0x0c, 0x49, 0x73, 0x44, 0x65,
+	0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x73,
+	0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8e, 0x01, 0x92, 0x41,
+	0x6d, 0x0a, 0x05, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x1a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x20,
+	0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x20, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x79, 0x1a, 0x48, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x20, 0x77, 0x68,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x20, 0x61, 0x6e, 0x79, 0x20, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x20, 0x6c, 0x6f, 0x61, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x6f, 0x72,
+	0x72, 0x6f, 0x77, 0x65, 0x72, 0x20, 0x68, 0x61, 0x73, 0x20, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x2f, 0x69,
+	0x73, 0x2d, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x12, 0xe7, 0x01, 0x0a,
+	0x10, 0x49, 0x73, 0x4c, 0x6f, 0x61, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e,
+	0x74, 0x12, 0x2b, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x49, 0x73, 0x44, 0x65, 0x6c,
+	0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x49, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7c, 0x92, 0x41, 0x52, 0x0a, 0x05, 0x4c,
+	0x6f, 0x61, 0x6e, 0x73, 0x12, 0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x20, 0x6c, 0x6f, 0x61, 0x6e,
+	0x20, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x1a, 0x31, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x20, 0x77, 0x68, 0x65, 0x74, 0x68, 0x65, 0x72, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x6c, 0x6f, 0x61, 0x6e, 0x20, 0x68, 0x61, 0x73, 0x20, 0x6d, 0x69, 0x73, 0x73, 0x65,
+	0x64, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x2f,
+	0x7b, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x7d, 0x2f, 0x69, 0x73, 0x2d, 0x64, 0x65, 0x6c, 0x69,
+	0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x12, 0xec, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x27, 0x2e, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f,
+	0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x01,
+	0x92, 0x41, 0x5a, 0x0a, 0x05, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x12, 0x52, 0x65, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x20, 0x61, 0x20, 0x6c, 0x6f, 0x61, 0x6e, 0x1a, 0x3d,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x6e, 0x70,
+	0x61, 0x69, 0x64, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x20, 0x6e, 0x65, 0x77, 0x20, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x61, 0x6e,
+	0x2f, 0x7b, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0xa0, 0x02, 0x0a, 0x11, 0x44, 0x65, 0x66, 0x65, 0x72, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x2e, 0x62, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x66, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x65, 0x72, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xb3, 0x01, 0x92, 0x41, 0x8d, 0x01, 0x0a, 0x05, 0x4c, 0x6f, 0x61, 0x6e, 0x73,
+	0x12, 0x12, 0x44, 0x65, 0x66, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x1a, 0x70, 0x50, 0x75, 0x73, 0x68, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x6e, 0x65, 0x78, 0x74, 0x20, 0x75, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x20, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x62, 0x61, 0x63, 0x6b, 0x20, 0x62,
+	0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x77, 0x65, 0x65, 0x6b, 0x73, 0x2c, 0x20, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x65, 0x66, 0x65, 0x72, 0x72,
+	0x61, 0x6c, 0x20, 0x66, 0x65, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22,
+	0x17, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x2f, 0x7b, 0x6c, 0x6f, 0x61, 0x6e, 0x49,
+	0x64, 0x7d, 0x2f, 0x64, 0x65, 0x66, 0x65, 0x72, 0x12, 0xc9, 0x02, 0x0a, 0x09, 0x54, 0x6f, 0x70,
+	0x55, 0x70, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x21, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x55, 0x70, 0x4c, 0x6f,
+	0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x55,
+	0x70, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf4, 0x01,
+	0x92, 0x41, 0xcd, 0x01, 0x0a, 0x05, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x0d, 0x54, 0x6f, 0x70,
+	0x20, 0x75, 0x70, 0x20, 0x61, 0x20, 0x6c, 0x6f, 0x61, 0x6e, 0x1a, 0xb4, 0x01, 0x52, 0x65, 0x66,
+	0x69, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x6f, 0x61, 0x6e,
+	0x20, 0x69, 0x6e, 0x74, 0x6f, 0x20, 0x61, 0x20, 0x6e, 0x65, 0x77, 0x20, 0x6f, 0x6e, 0x65, 0x20,
+	0x64, 0x69, 0x73, 0x62, 0x75, 0x72, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x20, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x20,
+	0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x70, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61,
+	0x79, 0x6f, 0x66, 0x66, 0x3a, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x20, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x20, 0x6f, 0x66,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x6c, 0x6f, 0x61, 0x6e, 0x20, 0x70, 0x6c, 0x75, 0x73, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20,
+	0x66, 0x65, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x69, 0x74, 0x73, 0x20, 0x6f, 0x76, 0x65, 0x72,
+	0x64, 0x75, 0x65, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f,
+	0x6c, 0x6f, 0x61, 0x6e, 0x2f, 0x7b, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x7d, 0x2f, 0x74, 0x6f,
+	0x70, 0x2d, 0x75, 0x70, 0x42, 0xd8, 0x08, 0x92, 0x41, 0x8b, 0x08, 0x12, 0xf0, 0x01, 0x0a, 0x12,
+	0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x20, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x20, 0x41,
+	0x50, 0x49, 0x12, 0xd5, 0x01, 0x57, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x20, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x3a, 0x20, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x72, 0x65, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2c, 0x20, 0x72, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75,
+	0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x20, 0x61, 0x6e, 0x64, 0x20, 0x77, 0x72, 0x69, 0x74, 0x65, 0x2d, 0x6f, 0x66, 0x66, 0x73, 0x2e,
+	0x20, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x20, 0x63, 0x61, 0x72, 0x72, 0x79, 0x20, 0x61, 0x20,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x61, 0x20, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x20, 0x72, 0x65, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x20, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x61, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20,
+	0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x66, 0x66, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x2e, 0x32, 0x02, 0x76, 0x31, 0x2a, 0x02,
+	0x01, 0x02, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0xb1, 0x01, 0x0a, 0xae, 0x01, 0x0a, 0x06, 0x62, 0x65,
+	0x61, 0x72, 0x65, 0x72, 0x12, 0xa3, 0x01, 0x08, 0x02, 0x12, 0x8d, 0x01, 0x41, 0x20, 0x4a, 0x57,
+	0x54, 0x20, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x48, 0x53,
+	0x32, 0x35, 0x36, 0x20, 0x6f, 0x72, 0x20, 0x52, 0x53, 0x32, 0x35, 0x36, 0x20, 0x61, 0x73, 0x20,
+	0x22, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x3c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3e, 0x22,
+	0x2e, 0x20, 0x49, 0x74, 0x73, 0x20, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x20, 0x69, 0x73,
+	0x20, 0x74, 0x68, 0x65, 0x20, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x20, 0x6f, 0x66, 0x20, 0x62,
+	0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x6f,
+	0x6c, 0x65, 0x20, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x20, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x20,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x20, 0x6f, 0x72, 0x20, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x20, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x1a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x02, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06,
+	0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x6a, 0x38, 0x0a, 0x05, 0x4c, 0x6f, 0x61, 0x6e,
+	0x73, 0x12, 0x2f, 0x4c, 0x6f, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2c, 0x20, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x20, 0x61, 0x6e,
+	0x64, 0x20, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x6a, 0x3e, 0x0a, 0x08, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x32,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x6a, 0x35, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x27, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x20, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x61, 0x73, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2c, 0x20, 0x63,
+	0x73, 0x76, 0x20, 0x6f, 0x72, 0x20, 0x70, 0x64, 0x66, 0x6a, 0x41, 0x0a, 0x0a, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x2d, 0x6f, 0x66, 0x66, 0x73, 0x12, 0x33, 0x57, 0x72, 0x69, 0x74, 0x65, 0x2d, 0x6f,
+	0x66, 0x66, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e,
+	0x74, 0x20, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x69,
+	0x72, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x6a, 0x36, 0x0a, 0x05,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x2d, 0x54, 0x61, 0x6d, 0x70, 0x65, 0x72, 0x20, 0x65, 0x76,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x20, 0x6f, 0x66, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20,
+	0x6c, 0x6f, 0x61, 0x6e, 0x6a, 0x41, 0x0a, 0x08, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
+	0x12, 0x35, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x70, 0x61, 0x72, 0x74, 0x6e,
+	0x65, 0x72, 0x20, 0x75, 0x72, 0x6c, 0x73, 0x6a, 0x43, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x6f, 0x6e,
+	0x63, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x42, 0x61, 0x6e, 0x6b, 0x20,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x65, 0x78, 0x63, 0x65,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x71, 0x75, 0x65, 0x75, 0x65, 0x6a, 0x2d, 0x0a, 0x04,
+	0x4a, 0x6f, 0x62, 0x73, 0x12, 0x25, 0x52, 0x75, 0x6e, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+	0x65, 0x20, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x20, 0x62, 0x61, 0x63, 0x6b,
+	0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x20, 0x6a, 0x6f, 0x62, 0x73, 0x6a, 0x49, 0x0a, 0x0d, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x20,
+	0x74, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x72, 0x73,
+	0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65, 0x69, 0x72, 0x20, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x69, 0x7a, 0x68, 0x61, 0x6e, 0x67, 0x2f, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x2d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6f, 0x61, 0x6e, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_billing_loan_v1_loan_proto_rawDescOnce sync.Once
+	file_billing_loan_v1_loan_proto_rawDescData []byte
+)
+
+func file_billing_loan_v1_loan_proto_rawDescGZIP() []byte {
+	file_billing_loan_v1_loan_proto_rawDescOnce.Do(func() {
+		file_billing_loan_v1_loan_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_billing_loan_v1_loan_proto_rawDesc), len(file_billing_loan_v1_loan_proto_rawDesc)))
+	})
+	return file_billing_loan_v1_loan_proto_rawDescData
+}
+
+var file_billing_loan_v1_loan_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_billing_loan_v1_loan_proto_goTypes = []any{
+	(*Loan)(nil),                         // 0: billing.loan.v1.Loan
+	(*GetLoanRequest)(nil),               // 1: billing.loan.v1.GetLoanRequest
+	(*GetLoanResponse)(nil),              // 2: billing.loan.v1.GetLoanResponse
+	(*ListLoansRequest)(nil),             // 3: billing.loan.v1.ListLoansRequest
+	(*ListLoansResponse)(nil),            // 4: billing.loan.v1.ListLoansResponse
+	(*CreateLoanRequest)(nil),            // 5: billing.loan.v1.CreateLoanRequest
+	(*QuoteLoanRequest)(nil),             // 6: billing.loan.v1.QuoteLoanRequest
+	(*QuoteLoanResponse)(nil),            // 7: billing.loan.v1.QuoteLoanResponse
+	(*GetOutstandingRequest)(nil),        // 8: billing.loan.v1.GetOutstandingRequest
+	(*GetLoanOutstandingRequest)(nil),    // 9: billing.loan.v1.GetLoanOutstandingRequest
+	(*LoanOutstanding)(nil),              // 10: billing.loan.v1.LoanOutstanding
+	(*GetOutstandingResponse)(nil),       // 11: billing.loan.v1.GetOutstandingResponse
+	(*GetRepaymentScheduleRequest)(nil),  // 12: billing.loan.v1.GetRepaymentScheduleRequest
+	(*Installment)(nil),                  // 13: billing.loan.v1.Installment
+	(*RepaymentScheduleSummary)(nil),     // 14: billing.loan.v1.RepaymentScheduleSummary
+	(*GetRepaymentScheduleResponse)(nil), // 15: billing.loan.v1.GetRepaymentScheduleResponse
+	(*GetIsDelinquentRequest)(nil),       // 16: billing.loan.v1.GetIsDelinquentRequest
+	(*GetLoanIsDelinquentRequest)(nil),   // 17: billing.loan.v1.GetLoanIsDelinquentRequest
+	(*LoanIsDelinquent)(nil),             // 18: billing.loan.v1.LoanIsDelinquent
+	(*GetIsDelinquentResponse)(nil),      // 19: billing.loan.v1.GetIsDelinquentResponse
+	(*RestructureLoanRequest)(nil),       // 20: billing.loan.v1.RestructureLoanRequest
+	(*RestructureLoanResponse)(nil),      // 21: billing.loan.v1.RestructureLoanResponse
+	(*DeferInstallmentsRequest)(nil),     // 22: billing.loan.v1.DeferInstallmentsRequest
+	(*DeferInstallmentsResponse)(nil),    // 23: billing.loan.v1.DeferInstallmentsResponse
+	(*TopUpLoanRequest)(nil),             // 24: billing.loan.v1.TopUpLoanRequest
+	(*TopUpLoanResponse)(nil),            // 25: billing.loan.v1.TopUpLoanResponse
+	(*timestamppb.Timestamp)(nil),        // 26: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                // 27: google.protobuf.Empty
+}
+var file_billing_loan_v1_loan_proto_depIdxs = []int32{
+	26, // 0: billing.loan.v1.Loan.createdAt:type_name -> google.protobuf.Timestamp
+	0,  // 1: billing.loan.v1.GetLoanResponse.loan:type_name -> billing.loan.v1.Loan
+	0,  // 2: billing.loan.v1.ListLoansResponse.loans:type_name -> billing.loan.v1.Loan
+	13, // 3: billing.loan.v1.QuoteLoanResponse.installments:type_name -> billing.loan.v1.Installment
+	10, // 4: billing.loan.v1.GetOutstandingResponse.loans:type_name -> billing.loan.v1.LoanOutstanding
+	26, // 5: billing.loan.v1.Installment.startAt:type_name -> google.protobuf.Timestamp
+	26, // 6: billing.loan.v1.Installment.endAt:type_name -> google.protobuf.Timestamp
+	26, // 7: billing.loan.v1.Installment.dueAt:type_name -> google.protobuf.Timestamp
+	26, // 8: billing.loan.v1.Installment.paidAt:type_name -> google.protobuf.Timestamp
+	13, // 9: billing.loan.v1.RepaymentScheduleSummary.nextDue:type_name -> billing.loan.v1.Installment
+	13, // 10: billing.loan.v1.GetRepaymentScheduleResponse.installments:type_name -> billing.loan.v1.Installment
+	14, // 11: billing.loan.v1.GetRepaymentScheduleResponse.summary:type_name -> billing.loan.v1.RepaymentScheduleSummary
+	18, // 12: billing.loan.v1.GetIsDelinquentResponse.loans:type_name -> billing.loan.v1.LoanIsDelinquent
+	26, // 13: billing.loan.v1.DeferInstallmentsResponse.startAt:type_name -> google.protobuf.Timestamp
+	26, // 14: billing.loan.v1.DeferInstallmentsResponse.endAt:type_name -> google.protobuf.Timestamp
+	1,  // 15: billing.loan.v1.LoanService.GetLoan:input_type -> billing.loan.v1.GetLoanRequest
+	3,  // 16: billing.loan.v1.LoanService.ListLoans:input_type -> billing.loan.v1.ListLoansRequest
+	5,  // 17: billing.loan.v1.LoanService.CreateLoan:input_type -> billing.loan.v1.CreateLoanRequest
+	6,  // 18: billing.loan.v1.LoanService.QuoteLoan:input_type -> billing.loan.v1.QuoteLoanRequest
+	8,  // 19: billing.loan.v1.LoanService.GetOutstanding:input_type -> billing.loan.v1.GetOutstandingRequest
+	9,  // 20: billing.loan.v1.LoanService.GetLoanOutstanding:input_type -> billing.loan.v1.GetLoanOutstandingRequest
+	12, // 21: billing.loan.v1.LoanService.GetRepaymentSchedule:input_type -> billing.loan.v1.GetRepaymentScheduleRequest
+	16, // 22: billing.loan.v1.LoanService.IsDelinquent:input_type -> billing.loan.v1.GetIsDelinquentRequest
+	17, // 23: billing.loan.v1.LoanService.IsLoanDelinquent:input_type -> billing.loan.v1.GetLoanIsDelinquentRequest
+	20, // 24: billing.loan.v1.LoanService.RestructureLoan:input_type -> billing.loan.v1.RestructureLoanRequest
+	22, // 25: billing.loan.v1.LoanService.DeferInstallments:input_type -> billing.loan.v1.DeferInstallmentsRequest
+	24, // 26: billing.loan.v1.LoanService.TopUpLoan:input_type -> billing.loan.v1.TopUpLoanRequest
+	2,  // 27: billing.loan.v1.LoanService.GetLoan:output_type -> billing.loan.v1.GetLoanResponse
+	4,  // 28: billing.loan.v1.LoanService.ListLoans:output_type -> billing.loan.v1.ListLoansResponse
+	27, // 29: billing.loan.v1.LoanService.CreateLoan:output_type -> google.protobuf.Empty
+	7,  // 30: billing.loan.v1.LoanService.QuoteLoan:output_type -> billing.loan.v1.QuoteLoanResponse
+	11, // 31: billing.loan.v1.LoanService.GetOutstanding:output_type -> billing.loan.v1.GetOutstandingResponse
+	11, // 32: billing.loan.v1.LoanService.GetLoanOutstanding:output_type -> billing.loan.v1.GetOutstandingResponse
+	15, // 33: billing.loan.v1.LoanService.GetRepaymentSchedule:output_type -> billing.loan.v1.GetRepaymentScheduleResponse
+	19, // 34: billing.loan.v1.LoanService.IsDelinquent:output_type -> billing.loan.v1.GetIsDelinquentResponse
+	19, // 35: billing.loan.v1.LoanService.IsLoanDelinquent:output_type -> billing.loan.v1.GetIsDelinquentResponse
+	21, // 36: billing.loan.v1.LoanService.RestructureLoan:output_type -> billing.loan.v1.RestructureLoanResponse
+	23, // 37: billing.loan.v1.LoanService.DeferInstallments:output_type -> billing.loan.v1.DeferInstallmentsResponse
+	25, // 38: billing.loan.v1.LoanService.TopUpLoan:output_type -> billing.loan.v1.TopUpLoanResponse
+	27, // [27:39] is the sub-list for method output_type
+	15, // [15:27] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_billing_loan_v1_loan_proto_init() }
+func file_billing_loan_v1_loan_proto_init() {
+	if File_billing_loan_v1_loan_proto != nil {
+		return
+	}
+	file_billing_loan_v1_loan_proto_msgTypes[20].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_billing_loan_v1_loan_proto_rawDesc), len(file_billing_loan_v1_loan_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_billing_loan_v1_loan_proto_goTypes,
+		DependencyIndexes: file_billing_loan_v1_loan_proto_depIdxs,
+		MessageInfos:      file_billing_loan_v1_loan_proto_msgTypes,
+	}.Build()
+	File_billing_loan_v1_loan_proto = out.File
+	file_billing_loan_v1_loan_proto_goTypes = nil
+	file_billing_loan_v1_loan_proto_depIdxs = nil
+}
@@ -0,0 +1,542 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.20.3
+// source: billing/loan/v1/loan.proto
+
+package loanv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	LoanService_GetLoan_FullMethodName              = "/billing.loan.v1.LoanService/GetLoan"
+	LoanService_ListLoans_FullMethodName            = "/billing.loan.v1.LoanService/ListLoans"
+	LoanService_CreateLoan_FullMethodName           = "/billing.loan.v1.LoanService/CreateLoan"
+	LoanService_QuoteLoan_FullMethodName            = "/billing.loan.v1.LoanService/QuoteLoan"
+	LoanService_GetOutstanding_FullMethodName       = "/billing.loan.v1.LoanService/GetOutstanding"
+	LoanService_GetLoanOutstanding_FullMethodName   = "/billing.loan.v1.LoanService/GetLoanOutstanding"
+	LoanService_GetRepaymentSchedule_FullMethodName = "/billing.loan.v1.LoanService/GetRepaymentSchedule"
+	LoanService_IsDelinquent_FullMethodName         = "/billing.loan.v1.LoanService/IsDelinquent"
+	LoanService_IsLoanDelinquent_FullMethodName     = "/billing.loan.v1.LoanService/IsLoanDelinquent"
+	LoanService_RestructureLoan_FullMethodName      = "/billing.loan.v1.LoanService/RestructureLoan"
+	LoanService_DeferInstallments_FullMethodName    = "/billing.loan.v1.LoanService/DeferInstallments"
+	LoanService_TopUpLoan_FullMethodName            = "/billing.loan.v1.LoanService/TopUpLoan"
+)
+
+// LoanServiceClient is the client API for LoanService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LoanServiceClient interface {
+	// GetLoan is declared before the static /loan/... routes, the gateway matches routes registered later first
+	GetLoan(ctx context.Context, in *GetLoanRequest, opts ...grpc.CallOption) (*GetLoanResponse, error)
+	ListLoans(ctx context.Context, in *ListLoansRequest, opts ...grpc.CallOption) (*ListLoansResponse, error)
+	CreateLoan(ctx context.Context, in *CreateLoanRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	QuoteLoan(ctx context.Context, in *QuoteLoanRequest, opts ...grpc.CallOption) (*QuoteLoanResponse, error)
+	GetOutstanding(ctx context.Context, in *GetOutstandingRequest, opts ...grpc.CallOption) (*GetOutstandingResponse, error)
+	GetLoanOutstanding(ctx context.Context, in *GetLoanOutstandingRequest, opts ...grpc.CallOption) (*GetOutstandingResponse, error)
+	GetRepaymentSchedule(ctx context.Context, in *GetRepaymentScheduleRequest, opts ...grpc.CallOption) (*GetRepaymentScheduleResponse, error)
+	IsDelinquent(ctx context.Context, in *GetIsDelinquentRequest, opts ...grpc.CallOption) (*GetIsDelinquentResponse, error)
+	IsLoanDelinquent(ctx context.Context, in *GetLoanIsDelinquentRequest, opts ...grpc.CallOption) (*GetIsDelinquentResponse, error)
+	RestructureLoan(ctx context.Context, in *RestructureLoanRequest, opts ...grpc.CallOption) (*RestructureLoanResponse, error)
+	DeferInstallments(ctx context.Context, in *DeferInstallmentsRequest, opts ...grpc.CallOption) (*DeferInstallmentsResponse, error)
+	TopUpLoan(ctx context.Context, in *TopUpLoanRequest, opts ...grpc.CallOption) (*TopUpLoanResponse, error)
+}
+
+type loanServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLoanServiceClient(cc grpc.ClientConnInterface) LoanServiceClient {
+	return &loanServiceClient{cc}
+}
+
+func (c *loanServiceClient) GetLoan(ctx context.Context, in *GetLoanRequest, opts ...grpc.CallOption) (*GetLoanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLoanResponse)
+	err := c.cc.Invoke(ctx, LoanService_GetLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) ListLoans(ctx context.Context, in *ListLoansRequest, opts ...grpc.CallOption) (*ListLoansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLoansResponse)
+	err := c.cc.Invoke(ctx, LoanService_ListLoans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) CreateLoan(ctx context.Context, in *CreateLoanRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, LoanService_CreateLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) QuoteLoan(ctx context.Context, in *QuoteLoanRequest, opts ...grpc.CallOption) (*QuoteLoanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteLoanResponse)
+	err := c.cc.Invoke(ctx, LoanService_QuoteLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) GetOutstanding(ctx context.Context, in *GetOutstandingRequest, opts ...grpc.CallOption) (*GetOutstandingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOutstandingResponse)
+	err := c.cc.Invoke(ctx, LoanService_GetOutstanding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) GetLoanOutstanding(ctx context.Context, in *GetLoanOutstandingRequest, opts ...grpc.CallOption) (*GetOutstandingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOutstandingResponse)
+	err := c.cc.Invoke(ctx, LoanService_GetLoanOutstanding_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) GetRepaymentSchedule(ctx context.Context, in *GetRepaymentScheduleRequest, opts ...grpc.CallOption) (*GetRepaymentScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRepaymentScheduleResponse)
+	err := c.cc.Invoke(ctx, LoanService_GetRepaymentSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) IsDelinquent(ctx context.Context, in *GetIsDelinquentRequest, opts ...grpc.CallOption) (*GetIsDelinquentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIsDelinquentResponse)
+	err := c.cc.Invoke(ctx, LoanService_IsDelinquent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) IsLoanDelinquent(ctx context.Context, in *GetLoanIsDelinquentRequest, opts ...grpc.CallOption) (*GetIsDelinquentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIsDelinquentResponse)
+	err := c.cc.Invoke(ctx, LoanService_IsLoanDelinquent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) RestructureLoan(ctx context.Context, in *RestructureLoanRequest, opts ...grpc.CallOption) (*RestructureLoanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestructureLoanResponse)
+	err := c.cc.Invoke(ctx, LoanService_RestructureLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) DeferInstallments(ctx context.Context, in *DeferInstallmentsRequest, opts ...grpc.CallOption) (*DeferInstallmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeferInstallmentsResponse)
+	err := c.cc.Invoke(ctx, LoanService_DeferInstallments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanServiceClient) TopUpLoan(ctx context.Context, in *TopUpLoanRequest, opts ...grpc.CallOption) (*TopUpLoanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TopUpLoanResponse)
+	err := c.cc.Invoke(ctx, LoanService_TopUpLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LoanServiceServer is the server API for LoanService service.
+// All implementations must embed UnimplementedLoanServiceServer
+// for forward compatibility.
+type LoanServiceServer interface {
+	// GetLoan is declared before the static /loan/... routes, the gateway matches routes registered later first
+	GetLoan(context.Context, *GetLoanRequest) (*GetLoanResponse, error)
+	ListLoans(context.Context, *ListLoansRequest) (*ListLoansResponse, error)
+	CreateLoan(context.Context, *CreateLoanRequest) (*emptypb.Empty, error)
+	QuoteLoan(context.Context, *QuoteLoanRequest) (*QuoteLoanResponse, error)
+	GetOutstanding(context.Context, *GetOutstandingRequest) (*GetOutstandingResponse, error)
+	GetLoanOutstanding(context.Context, *GetLoanOutstandingRequest) (*GetOutstandingResponse, error)
+	GetRepaymentSchedule(context.Context, *GetRepaymentScheduleRequest) (*GetRepaymentScheduleResponse, error)
+	IsDelinquent(context.Context, *GetIsDelinquentRequest) (*GetIsDelinquentResponse, error)
+	IsLoanDelinquent(context.Context, *GetLoanIsDelinquentRequest) (*GetIsDelinquentResponse, error)
+	RestructureLoan(context.Context, *RestructureLoanRequest) (*RestructureLoanResponse, error)
+	DeferInstallments(context.Context, *DeferInstallmentsRequest) (*DeferInstallmentsResponse, error)
+	TopUpLoan(context.Context, *TopUpLoanRequest) (*TopUpLoanResponse, error)
+	mustEmbedUnimplementedLoanServiceServer()
+}
+
+// UnimplementedLoanServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLoanServiceServer struct{}
+
+func (UnimplementedLoanServiceServer) GetLoan(context.Context, *GetLoanRequest) (*GetLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoan not implemented")
+}
+func (UnimplementedLoanServiceServer) ListLoans(context.Context, *ListLoansRequest) (*ListLoansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoans not implemented")
+}
+func (UnimplementedLoanServiceServer) CreateLoan(context.Context, *CreateLoanRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLoan not implemented")
+}
+func (UnimplementedLoanServiceServer) QuoteLoan(context.Context, *QuoteLoanRequest) (*QuoteLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteLoan not implemented")
+}
+func (UnimplementedLoanServiceServer) GetOutstanding(context.Context, *GetOutstandingRequest) (*GetOutstandingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutstanding not implemented")
+}
+func (UnimplementedLoanServiceServer) GetLoanOutstanding(context.Context, *GetLoanOutstandingRequest) (*GetOutstandingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoanOutstanding not implemented")
+}
+func (UnimplementedLoanServiceServer) GetRepaymentSchedule(context.Context, *GetRepaymentScheduleRequest) (*GetRepaymentScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRepaymentSchedule not implemented")
+}
+func (UnimplementedLoanServiceServer) IsDelinquent(context.Context, *GetIsDelinquentRequest) (*GetIsDelinquentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsDelinquent not implemented")
+}
+func (UnimplementedLoanServiceServer) IsLoanDelinquent(context.Context, *GetLoanIsDelinquentRequest) (*GetIsDelinquentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsLoanDelinquent not implemented")
+}
+func (UnimplementedLoanServiceServer) RestructureLoan(context.Context, *RestructureLoanRequest) (*RestructureLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestructureLoan not implemented")
+}
+func (UnimplementedLoanServiceServer) DeferInstallments(context.Context, *DeferInstallmentsRequest) (*DeferInstallmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeferInstallments not implemented")
+}
+func (UnimplementedLoanServiceServer) TopUpLoan(context.Context, *TopUpLoanRequest) (*TopUpLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpLoan not implemented")
+}
+func (UnimplementedLoanServiceServer) mustEmbedUnimplementedLoanServiceServer() {}
+func (UnimplementedLoanServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeLoanServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LoanServiceServer will
+// result in compilation errors.
+type UnsafeLoanServiceServer interface {
+	mustEmbedUnimplementedLoanServiceServer()
+}
+
+func RegisterLoanServiceServer(s grpc.ServiceRegistrar, srv LoanServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLoanServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LoanService_ServiceDesc, srv)
+}
+
+func _LoanService_GetLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).GetLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_GetLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).GetLoan(ctx, req.(*GetLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_ListLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).ListLoans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_ListLoans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).ListLoans(ctx, req.(*ListLoansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_CreateLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).CreateLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_CreateLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).CreateLoan(ctx, req.(*CreateLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_QuoteLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).QuoteLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_QuoteLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).QuoteLoan(ctx, req.(*QuoteLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_GetOutstanding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOutstandingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).GetOutstanding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_GetOutstanding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).GetOutstanding(ctx, req.(*GetOutstandingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_GetLoanOutstanding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoanOutstandingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).GetLoanOutstanding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_GetLoanOutstanding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).GetLoanOutstanding(ctx, req.(*GetLoanOutstandingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_GetRepaymentSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRepaymentScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).GetRepaymentSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_GetRepaymentSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).GetRepaymentSchedule(ctx, req.(*GetRepaymentScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_IsDelinquent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIsDelinquentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).IsDelinquent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_IsDelinquent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).IsDelinquent(ctx, req.(*GetIsDelinquentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_IsLoanDelinquent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoanIsDelinquentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).IsLoanDelinquent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_IsLoanDelinquent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).IsLoanDelinquent(ctx, req.(*GetLoanIsDelinquentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_RestructureLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestructureLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).RestructureLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_RestructureLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).RestructureLoan(ctx, req.(*RestructureLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_DeferInstallments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeferInstallmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).DeferInstallments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_DeferInstallments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).DeferInstallments(ctx, req.(*DeferInstallmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanService_TopUpLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopUpLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServiceServer).TopUpLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanService_TopUpLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServiceServer).TopUpLoan(ctx, req.(*TopUpLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LoanService_ServiceDesc is the grpc.ServiceDesc for LoanService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LoanService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "billing.loan.v1.LoanService",
+	HandlerType: (*LoanServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLoan",
+			Handler:    _LoanService_GetLoan_Handler,
+		},
+		{
+			MethodName: "ListLoans",
+			Handler:    _LoanService_ListLoans_Handler,
+		},
+		{
+			MethodName: "CreateLoan",
+			Handler:    _LoanService_CreateLoan_Handler,
+		},
+		{
+			MethodName: "QuoteLoan",
+			Handler:    _LoanService_QuoteLoan_Handler,
+		},
+		{
+			MethodName: "GetOutstanding",
+			Handler:    _LoanService_GetOutstanding_Handler,
+		},
+		{
+			MethodName: "GetLoanOutstanding",
+			Handler:    _LoanService_GetLoanOutstanding_Handler,
+		},
+		{
+			MethodName: "GetRepaymentSchedule",
+			Handler:    _LoanService_GetRepaymentSchedule_Handler,
+		},
+		{
+			MethodName: "IsDelinquent",
+			Handler:    _LoanService_IsDelinquent_Handler,
+		},
+		{
+			MethodName: "IsLoanDelinquent",
+			Handler:    _LoanService_IsLoanDelinquent_Handler,
+		},
+		{
+			MethodName: "RestructureLoan",
+			Handler:    _LoanService_RestructureLoan_Handler,
+		},
+		{
+			MethodName: "DeferInstallments",
+			Handler:    _LoanService_DeferInstallments_Handler,
+		},
+		{
+			MethodName: "TopUpLoan",
+			Handler:    _LoanService_TopUpLoan_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "billing/loan/v1/loan.proto",
+}
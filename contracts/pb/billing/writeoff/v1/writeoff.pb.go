@@ -0,0 +1,654 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v3.20.3
+// source: billing/writeoff/v1/writeoff.proto
+
+package writeoffv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WriteOffLoanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LoanId        string                 `protobuf:"bytes,1,opt,name=loanId,proto3" json:"loanId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WriteOffLoanRequest) Reset() {
+	*x = WriteOffLoanRequest{}
+	mi := &file_billing_writeoff_v1_writeoff_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WriteOffLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteOffLoanRequest) ProtoMessage() {}
+
+func (x *WriteOffLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_writeoff_v1_writeoff_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteOffLoanRequest.ProtoReflect.Descriptor instead.
+func (*WriteOffLoanRequest) Descriptor() ([]byte, []int) {
+	return file_billing_writeoff_v1_writeoff_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WriteOffLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+type LoanWriteOff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WriteOffId    string                 `protobuf:"bytes,1,opt,name=writeOffId,proto3" json:"writeOffId,omitempty"`
+	LoanId        string                 `protobuf:"bytes,2,opt,name=loanId,proto3" json:"loanId,omitempty"`
+	Principal     float32                `protobuf:"fixed32,3,opt,name=principal,proto3" json:"principal,omitempty"`
+	Interest      float32                `protobuf:"fixed32,4,opt,name=interest,proto3" json:"interest,omitempty"`
+	Fee           float32                `protobuf:"fixed32,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	DaysPastDue   int32                  `protobuf:"varint,6,opt,name=daysPastDue,proto3" json:"daysPastDue,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	WrittenOffAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=writtenOffAt,proto3" json:"writtenOffAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoanWriteOff) Reset() {
+	*x = LoanWriteOff{}
+	mi := &file_billing_writeoff_v1_writeoff_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoanWriteOff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanWriteOff) ProtoMessage() {}
+
+func (x *LoanWriteOff) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_writeoff_v1_writeoff_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanWriteOff.ProtoReflect.Descriptor instead.
+func (*LoanWriteOff) Descriptor() ([]byte, []int) {
+	return file_billing_writeoff_v1_writeoff_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LoanWriteOff) GetWriteOffId() string {
+	if x != nil {
+		return x.WriteOffId
+	}
+	return ""
+}
+
+func (x *LoanWriteOff) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *LoanWriteOff) GetPrincipal() float32 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *LoanWriteOff) GetInterest() float32 {
+	if x != nil {
+		return x.Interest
+	}
+	return 0
+}
+
+func (x *LoanWriteOff) GetFee() float32 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *LoanWriteOff) GetDaysPastDue() int32 {
+	if x != nil {
+		return x.DaysPastDue
+	}
+	return 0
+}
+
+func (x *LoanWriteOff) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LoanWriteOff) GetWrittenOffAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WrittenOffAt
+	}
+	return nil
+}
+
+type ApplyWriteOffPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WriteOffs     []*LoanWriteOff        `protobuf:"bytes,1,rep,name=writeOffs,proto3" json:"writeOffs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyWriteOffPolicyResponse) Reset() {
+	*x = ApplyWriteOffPolicyResponse{}
+	mi := &file_billing_writeoff_v1_writeoff_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyWriteOffPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyWriteOffPolicyResponse) ProtoMessage() {}
+
+func (x *ApplyWriteOffPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_writeoff_v1_writeoff_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyWriteOffPolicyResponse.ProtoReflect.Descriptor instead.
+func (*ApplyWriteOffPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_billing_writeoff_v1_writeoff_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ApplyWriteOffPolicyResponse) GetWriteOffs() []*LoanWriteOff {
+	if x != nil {
+		return x.WriteOffs
+	}
+	return nil
+}
+
+type GetRecoveriesReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecoveriesReportRequest) Reset() {
+	*x = GetRecoveriesReportRequest{}
+	mi := &file_billing_writeoff_v1_writeoff_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecoveriesReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecoveriesReportRequest) ProtoMessage() {}
+
+func (x *GetRecoveriesReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_writeoff_v1_writeoff_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecoveriesReportRequest.ProtoReflect.Descriptor instead.
+func (*GetRecoveriesReportRequest) Descriptor() ([]byte, []int) {
+	return file_billing_writeoff_v1_writeoff_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRecoveriesReportRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetRecoveriesReportRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type LoanRecovery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryId    string                 `protobuf:"bytes,1,opt,name=recoveryId,proto3" json:"recoveryId,omitempty"`
+	LoanId        string                 `protobuf:"bytes,2,opt,name=loanId,proto3" json:"loanId,omitempty"`
+	WriteOffId    string                 `protobuf:"bytes,3,opt,name=writeOffId,proto3" json:"writeOffId,omitempty"`
+	PaymentId     string                 `protobuf:"bytes,4,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	Amount        float32                `protobuf:"fixed32,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	RecoveredAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=recoveredAt,proto3" json:"recoveredAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoanRecovery) Reset() {
+	*x = LoanRecovery{}
+	mi := &file_billing_writeoff_v1_writeoff_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoanRecovery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanRecovery) ProtoMessage() {}
+
+func (x *LoanRecovery) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_writeoff_v1_writeoff_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanRecovery.ProtoReflect.Descriptor instead.
+func (*LoanRecovery) Descriptor() ([]byte, []int) {
+	return file_billing_writeoff_v1_writeoff_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LoanRecovery) GetRecoveryId() string {
+	if x != nil {
+		return x.RecoveryId
+	}
+	return ""
+}
+
+func (x *LoanRecovery) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *LoanRecovery) GetWriteOffId() string {
+	if x != nil {
+		return x.WriteOffId
+	}
+	return ""
+}
+
+func (x *LoanRecovery) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *LoanRecovery) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *LoanRecovery) GetRecoveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecoveredAt
+	}
+	return nil
+}
+
+type GetRecoveriesReportResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	WrittenOffPrincipal float32                `protobuf:"fixed32,1,opt,name=writtenOffPrincipal,proto3" json:"writtenOffPrincipal,omitempty"`
+	WrittenOffInterest  float32                `protobuf:"fixed32,2,opt,name=writtenOffInterest,proto3" json:"writtenOffInterest,omitempty"`
+	WrittenOffFee       float32                `protobuf:"fixed32,3,opt,name=writtenOffFee,proto3" json:"writtenOffFee,omitempty"`
+	Recovered           float32                `protobuf:"fixed32,4,opt,name=recovered,proto3" json:"recovered,omitempty"`
+	WriteOffs           []*LoanWriteOff        `protobuf:"bytes,5,rep,name=writeOffs,proto3" json:"writeOffs,omitempty"`
+	Recoveries          []*LoanRecovery        `protobuf:"bytes,6,rep,name=recoveries,proto3" json:"recoveries,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetRecoveriesReportResponse) Reset() {
+	*x = GetRecoveriesReportResponse{}
+	mi := &file_billing_writeoff_v1_writeoff_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecoveriesReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecoveriesReportResponse) ProtoMessage() {}
+
+func (x *GetRecoveriesReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_billing_writeoff_v1_writeoff_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecoveriesReportResponse.ProtoReflect.Descriptor instead.
+func (*GetRecoveriesReportResponse) Descriptor() ([]byte, []int) {
+	return file_billing_writeoff_v1_writeoff_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetRecoveriesReportResponse) GetWrittenOffPrincipal() float32 {
+	if x != nil {
+		return x.WrittenOffPrincipal
+	}
+	return 0
+}
+
+func (x *GetRecoveriesReportResponse) GetWrittenOffInterest() float32 {
+	if x != nil {
+		return x.WrittenOffInterest
+	}
+	return 0
+}
+
+func (x *GetRecoveriesReportResponse) GetWrittenOffFee() float32 {
+	if x != nil {
+		return x.WrittenOffFee
+	}
+	return 0
+}
+
+func (x *GetRecoveriesReportResponse) GetRecovered() float32 {
+	if x != nil {
+		return x.Recovered
+	}
+	return 0
+}
+
+func (x *GetRecoveriesReportResponse) GetWriteOffs() []*LoanWriteOff {
+	if x != nil {
+		return x.WriteOffs
+	}
+	return nil
+}
+
+func (x *GetRecoveriesReportResponse) GetRecoveries() []*LoanRecovery {
+	if x != nil {
+		return x.Recoveries
+	}
+	return nil
+}
+
+var File_billing_writeoff_v1_writeoff_proto protoreflect.FileDescriptor
+
+var file_billing_writeoff_v1_writeoff_proto_rawDesc = string([]byte{
+	0x0a, 0x22, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x6f,
+	0x66, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x6f, 0x66, 0x66, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x6f, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x62, 0x0a, 0x13, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x4c, 0x6f,
+	0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x06, 0x6c, 0x6f, 0x61,
+	0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92, 0x41, 0x28, 0x4a, 0x26,
+	0x22, 0x30, 0x62, 0x39, 0x61, 0x33, 0x65, 0x35, 0x32, 0x2d, 0x32, 0x66, 0x37, 0x63, 0x2d, 0x31,
+	0x31, 0x66, 0x30, 0x2d, 0x39, 0x63, 0x64, 0x32, 0x2d, 0x30, 0x32, 0x34, 0x32, 0x61, 0x63, 0x31,
+	0x32, 0x30, 0x30, 0x30, 0x32, 0x22, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06,
+	0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x8c, 0x02, 0x0a, 0x0c, 0x4c, 0x6f, 0x61, 0x6e, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x4f, 0x66, 0x66, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x4f, 0x66, 0x66, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x61, 0x79, 0x73, 0x50, 0x61, 0x73, 0x74, 0x44, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x64, 0x61, 0x79, 0x73, 0x50, 0x61, 0x73, 0x74, 0x44, 0x75, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0c, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e,
+	0x4f, 0x66, 0x66, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e,
+	0x4f, 0x66, 0x66, 0x41, 0x74, 0x22, 0x5e, 0x0a, 0x1b, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x6f, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f,
+	0x61, 0x6e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x09, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x4f, 0x66, 0x66, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x21,
+	0x92, 0x41, 0x18, 0x4a, 0x16, 0x22, 0x32, 0x30, 0x32, 0x35, 0x2d, 0x30, 0x31, 0x2d, 0x30, 0x31,
+	0x54, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x5a, 0x22, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x4d, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x21, 0x92, 0x41, 0x18, 0x4a, 0x16, 0x22, 0x32, 0x30, 0x32, 0x35, 0x2d, 0x30, 0x34, 0x2d, 0x30,
+	0x31, 0x54, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x5a, 0x22, 0xba, 0x48, 0x03, 0xc8,
+	0x01, 0x01, 0x52, 0x02, 0x74, 0x6f, 0x22, 0xda, 0x01, 0x0a, 0x0c, 0x4c, 0x6f, 0x61, 0x6e, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0xc7, 0x02, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x4f, 0x66,
+	0x66, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x13, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x4f, 0x66, 0x66, 0x50, 0x72, 0x69, 0x6e,
+	0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x12, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e,
+	0x4f, 0x66, 0x66, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x12, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x4f, 0x66, 0x66, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e,
+	0x4f, 0x66, 0x66, 0x46, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x77, 0x72,
+	0x69, 0x74, 0x74, 0x65, 0x6e, 0x4f, 0x66, 0x66, 0x46, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09,
+	0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x09, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x6f, 0x66, 0x66, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x52,
+	0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x6f, 0x66,
+	0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x32, 0x97, 0x06,
+	0x0a, 0x0f, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0xff, 0x01, 0x0a, 0x0c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x4c, 0x6f,
+	0x61, 0x6e, 0x12, 0x28, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x6f, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66,
+	0x66, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x6f, 0x66, 0x66, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x22,
+	0xa1, 0x01, 0x92, 0x41, 0x78, 0x0a, 0x0a, 0x57, 0x72, 0x69, 0x74, 0x65, 0x2d, 0x6f, 0x66, 0x66,
+	0x73, 0x12, 0x10, 0x57, 0x72, 0x69, 0x74, 0x65, 0x20, 0x6f, 0x66, 0x66, 0x20, 0x61, 0x20, 0x6c,
+	0x6f, 0x61, 0x6e, 0x1a, 0x58, 0x57, 0x72, 0x69, 0x74, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x66, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x20,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6c,
+	0x6f, 0x61, 0x6e, 0x2c, 0x20, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x62, 0x6f, 0x6f, 0x6b, 0x65, 0x64, 0x20, 0x61,
+	0x73, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2e, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x61, 0x6e,
+	0x2f, 0x7b, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x7d, 0x2f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x2d,
+	0x6f, 0x66, 0x66, 0x12, 0xf7, 0x01, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x4f, 0x66, 0x66, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x30, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x6f, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95, 0x01, 0x92, 0x41, 0x73, 0x0a, 0x0a, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x2d, 0x6f, 0x66, 0x66, 0x73, 0x12, 0x1a, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x77, 0x72, 0x69, 0x74, 0x65, 0x20, 0x6f, 0x66, 0x66, 0x20, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x1a, 0x49, 0x57, 0x72, 0x69, 0x74, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x66, 0x20,
+	0x65, 0x76, 0x65, 0x72, 0x79, 0x20, 0x6c, 0x6f, 0x61, 0x6e, 0x20, 0x70, 0x61, 0x73, 0x74, 0x20,
+	0x64, 0x75, 0x65, 0x20, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x20, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x6f, 0x66, 0x20, 0x64, 0x61, 0x79, 0x73, 0x2e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x2d, 0x6f, 0x66, 0x66, 0x2f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x87, 0x02,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2f, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x6f, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x2e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x6f, 0x66, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8c, 0x01, 0x92, 0x41, 0x69, 0x0a, 0x0a,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x2d, 0x6f, 0x66, 0x66, 0x73, 0x12, 0x11, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x48, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x73, 0x65, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x2d, 0x6f, 0x66, 0x66, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x74, 0x68, 0x65,
+	0x20, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x20, 0x62, 0x6f, 0x6f, 0x6b,
+	0x65, 0x64, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20,
+	0x61, 0x6e, 0x64, 0x20, 0x74, 0x6f, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f,
+	0x76, 0x31, 0x2f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x2d, 0x6f, 0x66, 0x66, 0x2f, 0x72, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x42, 0x51, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x65, 0x72, 0x69, 0x7a, 0x68, 0x61, 0x6e, 0x67, 0x2f,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x62, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x2f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x6f, 0x66, 0x66, 0x2f, 0x76, 0x31, 0x3b,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x6f, 0x66, 0x66, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+})
+
+var (
+	file_billing_writeoff_v1_writeoff_proto_rawDescOnce sync.Once
+	file_billing_writeoff_v1_writeoff_proto_rawDescData []byte
+)
+
+func file_billing_writeoff_v1_writeoff_proto_rawDescGZIP() []byte {
+	file_billing_writeoff_v1_writeoff_proto_rawDescOnce.Do(func() {
+		file_billing_writeoff_v1_writeoff_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_billing_writeoff_v1_writeoff_proto_rawDesc), len(file_billing_writeoff_v1_writeoff_proto_rawDesc)))
+	})
+	return file_billing_writeoff_v1_writeoff_proto_rawDescData
+}
+
+var file_billing_writeoff_v1_writeoff_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_billing_writeoff_v1_writeoff_proto_goTypes = []any{
+	(*WriteOffLoanRequest)(nil),         // 0: billing.writeoff.v1.WriteOffLoanRequest
+	(*LoanWriteOff)(nil),                // 1: billing.writeoff.v1.LoanWriteOff
+	(*ApplyWriteOffPolicyResponse)(nil), // 2: billing.writeoff.v1.ApplyWriteOffPolicyResponse
+	(*GetRecoveriesReportRequest)(nil),  // 3: billing.writeoff.v1.GetRecoveriesReportRequest
+	(*LoanRecovery)(nil),                // 4: billing.writeoff.v1.LoanRecovery
+	(*GetRecoveriesReportResponse)(nil), // 5: billing.writeoff.v1.GetRecoveriesReportResponse
+	(*timestamppb.Timestamp)(nil),       // 6: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),               // 7: google.protobuf.Empty
+}
+var file_billing_writeoff_v1_writeoff_proto_depIdxs = []int32{
+	6,  // 0: billing.writeoff.v1.LoanWriteOff.writtenOffAt:type_name -> google.protobuf.Timestamp
+	1,  // 1: billing.writeoff.v1.ApplyWriteOffPolicyResponse.writeOffs:type_name -> billing.writeoff.v1.LoanWriteOff
+	6,  // 2: billing.writeoff.v1.GetRecoveriesReportRequest.from:type_name -> google.protobuf.Timestamp
+	6,  // 3: billing.writeoff.v1.GetRecoveriesReportRequest.to:type_name -> google.protobuf.Timestamp
+	6,  // 4: billing.writeoff.v1.LoanRecovery.recoveredAt:type_name -> google.protobuf.Timestamp
+	1,  // 5: billing.writeoff.v1.GetRecoveriesReportResponse.writeOffs:type_name -> billing.writeoff.v1.LoanWriteOff
+	4,  // 6: billing.writeoff.v1.GetRecoveriesReportResponse.recoveries:type_name -> billing.writeoff.v1.LoanRecovery
+	0,  // 7: billing.writeoff.v1.WriteOffService.WriteOffLoan:input_type -> billing.writeoff.v1.WriteOffLoanRequest
+	7,  // 8: billing.writeoff.v1.WriteOffService.ApplyWriteOffPolicy:input_type -> google.protobuf.Empty
+	3,  // 9: billing.writeoff.v1.WriteOffService.GetRecoveriesReport:input_type -> billing.writeoff.v1.GetRecoveriesReportRequest
+	1,  // 10: billing.writeoff.v1.WriteOffService.WriteOffLoan:output_type -> billing.writeoff.v1.LoanWriteOff
+	2,  // 11: billing.writeoff.v1.WriteOffService.ApplyWriteOffPolicy:output_type -> billing.writeoff.v1.ApplyWriteOffPolicyResponse
+	5,  // 12: billing.writeoff.v1.WriteOffService.GetRecoveriesReport:output_type -> billing.writeoff.v1.GetRecoveriesReportResponse
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_billing_writeoff_v1_writeoff_proto_init() }
+func file_billing_writeoff_v1_writeoff_proto_init() {
+	if File_billing_writeoff_v1_writeoff_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_billing_writeoff_v1_writeoff_proto_rawDesc), len(file_billing_writeoff_v1_writeoff_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_billing_writeoff_v1_writeoff_proto_goTypes,
+		DependencyIndexes: file_billing_writeoff_v1_writeoff_proto_depIdxs,
+		MessageInfos:      file_billing_writeoff_v1_writeoff_proto_msgTypes,
+	}.Build()
+	File_billing_writeoff_v1_writeoff_proto = out.File
+	file_billing_writeoff_v1_writeoff_proto_goTypes = nil
+	file_billing_writeoff_v1_writeoff_proto_depIdxs = nil
+}
@@ -0,0 +1,198 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.20.3
+// source: billing/writeoff/v1/writeoff.proto
+
+package writeoffv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WriteOffService_WriteOffLoan_FullMethodName        = "/billing.writeoff.v1.WriteOffService/WriteOffLoan"
+	WriteOffService_ApplyWriteOffPolicy_FullMethodName = "/billing.writeoff.v1.WriteOffService/ApplyWriteOffPolicy"
+	WriteOffService_GetRecoveriesReport_FullMethodName = "/billing.writeoff.v1.WriteOffService/GetRecoveriesReport"
+)
+
+// WriteOffServiceClient is the client API for WriteOffService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WriteOffServiceClient interface {
+	WriteOffLoan(ctx context.Context, in *WriteOffLoanRequest, opts ...grpc.CallOption) (*LoanWriteOff, error)
+	ApplyWriteOffPolicy(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApplyWriteOffPolicyResponse, error)
+	GetRecoveriesReport(ctx context.Context, in *GetRecoveriesReportRequest, opts ...grpc.CallOption) (*GetRecoveriesReportResponse, error)
+}
+
+type writeOffServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWriteOffServiceClient(cc grpc.ClientConnInterface) WriteOffServiceClient {
+	return &writeOffServiceClient{cc}
+}
+
+func (c *writeOffServiceClient) WriteOffLoan(ctx context.Context, in *WriteOffLoanRequest, opts ...grpc.CallOption) (*LoanWriteOff, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoanWriteOff)
+	err := c.cc.Invoke(ctx, WriteOffService_WriteOffLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *writeOffServiceClient) ApplyWriteOffPolicy(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ApplyWriteOffPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyWriteOffPolicyResponse)
+	err := c.cc.Invoke(ctx, WriteOffService_ApplyWriteOffPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *writeOffServiceClient) GetRecoveriesReport(ctx context.Context, in *GetRecoveriesReportRequest, opts ...grpc.CallOption) (*GetRecoveriesReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecoveriesReportResponse)
+	err := c.cc.Invoke(ctx, WriteOffService_GetRecoveriesReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WriteOffServiceServer is the server API for WriteOffService service.
+// All implementations must embed UnimplementedWriteOffServiceServer
+// for forward compatibility.
+type WriteOffServiceServer interface {
+	WriteOffLoan(context.Context, *WriteOffLoanRequest) (*LoanWriteOff, error)
+	ApplyWriteOffPolicy(context.Context, *emptypb.Empty) (*ApplyWriteOffPolicyResponse, error)
+	GetRecoveriesReport(context.Context, *GetRecoveriesReportRequest) (*GetRecoveriesReportResponse, error)
+	mustEmbedUnimplementedWriteOffServiceServer()
+}
+
+// UnimplementedWriteOffServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWriteOffServiceServer struct{}
+
+func (UnimplementedWriteOffServiceServer) WriteOffLoan(context.Context, *WriteOffLoanRequest) (*LoanWriteOff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteOffLoan not implemented")
+}
+func (UnimplementedWriteOffServiceServer) ApplyWriteOffPolicy(context.Context, *emptypb.Empty) (*ApplyWriteOffPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyWriteOffPolicy not implemented")
+}
+func (UnimplementedWriteOffServiceServer) GetRecoveriesReport(context.Context, *GetRecoveriesReportRequest) (*GetRecoveriesReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecoveriesReport not implemented")
+}
+func (UnimplementedWriteOffServiceServer) mustEmbedUnimplementedWriteOffServiceServer() {}
+func (UnimplementedWriteOffServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeWriteOffServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WriteOffServiceServer will
+// result in compilation errors.
+type UnsafeWriteOffServiceServer interface {
+	mustEmbedUnimplementedWriteOffServiceServer()
+}
+
+func RegisterWriteOffServiceServer(s grpc.ServiceRegistrar, srv WriteOffServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWriteOffServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WriteOffService_ServiceDesc, srv)
+}
+
+func _WriteOffService_WriteOffLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteOffLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WriteOffServiceServer).WriteOffLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WriteOffService_WriteOffLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WriteOffServiceServer).WriteOffLoan(ctx, req.(*WriteOffLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WriteOffService_ApplyWriteOffPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WriteOffServiceServer).ApplyWriteOffPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WriteOffService_ApplyWriteOffPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WriteOffServiceServer).ApplyWriteOffPolicy(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WriteOffService_GetRecoveriesReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecoveriesReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WriteOffServiceServer).GetRecoveriesReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WriteOffService_GetRecoveriesReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WriteOffServiceServer).GetRecoveriesReport(ctx, req.(*GetRecoveriesReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WriteOffService_ServiceDesc is the grpc.ServiceDesc for WriteOffService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WriteOffService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "billing.writeoff.v1.WriteOffService",
+	HandlerType: (*WriteOffServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WriteOffLoan",
+			Handler:    _WriteOffService_WriteOffLoan_Handler,
+		},
+		{
+			MethodName: "ApplyWriteOffPolicy",
+			Handler:    _WriteOffService_ApplyWriteOffPolicy_Handler,
+		},
+		{
+			MethodName: "GetRecoveriesReport",
+			Handler:    _WriteOffService_GetRecoveriesReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "billing/writeoff/v1/writeoff.proto",
+}
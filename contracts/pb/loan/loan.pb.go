@@ -1,351 +0,0 @@
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.36.5
-// 	protoc        v3.20.3
-// source: loan.proto
-
-package loanpb
-
-import (
-	_ "google.golang.org/genproto/googleapis/api/annotations"
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
-	reflect "reflect"
-	sync "sync"
-	unsafe "unsafe"
-)
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-type CreateLoanRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateLoanRequest) Reset() {
-	*x = CreateLoanRequest{}
-	mi := &file_loan_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateLoanRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateLoanRequest) ProtoMessage() {}
-
-func (x *CreateLoanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_proto_msgTypes[0]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateLoanRequest.ProtoReflect.Descriptor instead.
-func (*CreateLoanRequest) Descriptor() ([]byte, []int) {
-	return file_loan_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *CreateLoanRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-type GetOutstandingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetOutstandingRequest) Reset() {
-	*x = GetOutstandingRequest{}
-	mi := &file_loan_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetOutstandingRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetOutstandingRequest) ProtoMessage() {}
-
-func (x *GetOutstandingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_proto_msgTypes[1]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetOutstandingRequest.ProtoReflect.Descriptor instead.
-func (*GetOutstandingRequest) Descriptor() ([]byte, []int) {
-	return file_loan_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *GetOutstandingRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-type GetOutstandingResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Outstanding   float32                `protobuf:"fixed32,1,opt,name=outstanding,proto3" json:"outstanding,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetOutstandingResponse) Reset() {
-	*x = GetOutstandingResponse{}
-	mi := &file_loan_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetOutstandingResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetOutstandingResponse) ProtoMessage() {}
-
-func (x *GetOutstandingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_proto_msgTypes[2]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetOutstandingResponse.ProtoReflect.Descriptor instead.
-func (*GetOutstandingResponse) Descriptor() ([]byte, []int) {
-	return file_loan_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *GetOutstandingResponse) GetOutstanding() float32 {
-	if x != nil {
-		return x.Outstanding
-	}
-	return 0
-}
-
-type GetIsDelinquentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetIsDelinquentRequest) Reset() {
-	*x = GetIsDelinquentRequest{}
-	mi := &file_loan_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetIsDelinquentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetIsDelinquentRequest) ProtoMessage() {}
-
-func (x *GetIsDelinquentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_proto_msgTypes[3]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetIsDelinquentRequest.ProtoReflect.Descriptor instead.
-func (*GetIsDelinquentRequest) Descriptor() ([]byte, []int) {
-	return file_loan_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *GetIsDelinquentRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-type GetIsDelinquentResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsDelinquent  bool                   `protobuf:"varint,1,opt,name=isDelinquent,proto3" json:"isDelinquent,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetIsDelinquentResponse) Reset() {
-	*x = GetIsDelinquentResponse{}
-	mi := &file_loan_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetIsDelinquentResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetIsDelinquentResponse) ProtoMessage() {}
-
-func (x *GetIsDelinquentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_proto_msgTypes[4]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetIsDelinquentResponse.ProtoReflect.Descriptor instead.
-func (*GetIsDelinquentResponse) Descriptor() ([]byte, []int) {
-	return file_loan_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *GetIsDelinquentResponse) GetIsDelinquent() bool {
-	if x != nil {
-		return x.IsDelinquent
-	}
-	return false
-}
-
-var File_loan_proto protoreflect.FileDescriptor
-
-var file_loan_proto_rawDesc = string([]byte{
-	0x0a, 0x0a, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x6c, 0x6f,
-	0x61, 0x6e, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2b, 0x0a,
-	0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x2f, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x16, 0x47,
-	0x65, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x73,
-	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x30, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x49, 0x73,
-	0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3d, 0x0a, 0x17, 0x47, 0x65, 0x74,
-	0x49, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71,
-	0x75, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x44, 0x65,
-	0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x32, 0xa9, 0x02, 0x0a, 0x04, 0x6c, 0x6f, 0x61,
-	0x6e, 0x12, 0x4f, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x12,
-	0x17, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x10, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x3a, 0x01, 0x2a, 0x22, 0x05, 0x2f, 0x6c, 0x6f,
-	0x61, 0x6e, 0x12, 0x66, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4f,
-	0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x73,
-	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x2f, 0x6f,
-	0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x68, 0x0a, 0x0c, 0x49, 0x73,
-	0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x6c, 0x6f, 0x61,
-	0x6e, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x2e,
-	0x47, 0x65, 0x74, 0x49, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12,
-	0x13, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x2f, 0x69, 0x73, 0x2d, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x71,
-	0x75, 0x65, 0x6e, 0x74, 0x42, 0x1c, 0x5a, 0x1a, 0x2e, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x70, 0x62, 0x3b, 0x6c, 0x6f, 0x61, 0x6e,
-	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-})
-
-var (
-	file_loan_proto_rawDescOnce sync.Once
-	file_loan_proto_rawDescData []byte
-)
-
-func file_loan_proto_rawDescGZIP() []byte {
-	file_loan_proto_rawDescOnce.Do(func() {
-		file_loan_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_loan_proto_rawDesc), len(file_loan_proto_rawDesc)))
-	})
-	return file_loan_proto_rawDescData
-}
-
-var file_loan_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
-var file_loan_proto_goTypes = []any{
-	(*CreateLoanRequest)(nil),       // 0: loan.CreateLoanRequest
-	(*GetOutstandingRequest)(nil),   // 1: loan.GetOutstandingRequest
-	(*GetOutstandingResponse)(nil),  // 2: loan.GetOutstandingResponse
-	(*GetIsDelinquentRequest)(nil),  // 3: loan.GetIsDelinquentRequest
-	(*GetIsDelinquentResponse)(nil), // 4: loan.GetIsDelinquentResponse
-	(*emptypb.Empty)(nil),           // 5: google.protobuf.Empty
-}
-var file_loan_proto_depIdxs = []int32{
-	0, // 0: loan.loan.CreateLoan:input_type -> loan.CreateLoanRequest
-	1, // 1: loan.loan.GetOutstanding:input_type -> loan.GetOutstandingRequest
-	3, // 2: loan.loan.IsDelinquent:input_type -> loan.GetIsDelinquentRequest
-	5, // 3: loan.loan.CreateLoan:output_type -> google.protobuf.Empty
-	2, // 4: loan.loan.GetOutstanding:output_type -> loan.GetOutstandingResponse
-	4, // 5: loan.loan.IsDelinquent:output_type -> loan.GetIsDelinquentResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
-}
-
-func init() { file_loan_proto_init() }
-func file_loan_proto_init() {
-	if File_loan_proto != nil {
-		return
-	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: unsafe.Slice(unsafe.StringData(file_loan_proto_rawDesc), len(file_loan_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   5,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_loan_proto_goTypes,
-		DependencyIndexes: file_loan_proto_depIdxs,
-		MessageInfos:      file_loan_proto_msgTypes,
-	}.Build()
-	File_loan_proto = out.File
-	file_loan_proto_goTypes = nil
-	file_loan_proto_depIdxs = nil
-}
@@ -1,122 +0,0 @@
-// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
-// versions:
-// - protoc-gen-go-grpc v1.5.1
-// - protoc             v3.20.3
-// source: payment.proto
-
-package paymentpb
-
-import (
-	context "context"
-	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
-)
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.64.0 or later.
-const _ = grpc.SupportPackageIsVersion9
-
-const (
-	Payment_MakePayment_FullMethodName = "/loan.payment/MakePayment"
-)
-
-// PaymentClient is the client API for Payment service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type PaymentClient interface {
-	MakePayment(ctx context.Context, in *MakePaymentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-}
-
-type paymentClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewPaymentClient(cc grpc.ClientConnInterface) PaymentClient {
-	return &paymentClient{cc}
-}
-
-func (c *paymentClient) MakePayment(ctx context.Context, in *MakePaymentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, Payment_MakePayment_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// PaymentServer is the server API for Payment service.
-// All implementations must embed UnimplementedPaymentServer
-// for forward compatibility.
-type PaymentServer interface {
-	MakePayment(context.Context, *MakePaymentRequest) (*emptypb.Empty, error)
-	mustEmbedUnimplementedPaymentServer()
-}
-
-// UnimplementedPaymentServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedPaymentServer struct{}
-
-func (UnimplementedPaymentServer) MakePayment(context.Context, *MakePaymentRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MakePayment not implemented")
-}
-func (UnimplementedPaymentServer) mustEmbedUnimplementedPaymentServer() {}
-func (UnimplementedPaymentServer) testEmbeddedByValue()                 {}
-
-// UnsafePaymentServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to PaymentServer will
-// result in compilation errors.
-type UnsafePaymentServer interface {
-	mustEmbedUnimplementedPaymentServer()
-}
-
-func RegisterPaymentServer(s grpc.ServiceRegistrar, srv PaymentServer) {
-	// If the following call pancis, it indicates UnimplementedPaymentServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
-	}
-	s.RegisterService(&Payment_ServiceDesc, srv)
-}
-
-func _Payment_MakePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MakePaymentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PaymentServer).MakePayment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: Payment_MakePayment_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServer).MakePayment(ctx, req.(*MakePaymentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-// Payment_ServiceDesc is the grpc.ServiceDesc for Payment service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Payment_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "loan.payment",
-	HandlerType: (*PaymentServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "MakePayment",
-			Handler:    _Payment_MakePayment_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "payment.proto",
-}
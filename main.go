@@ -1,65 +1,273 @@
 package main
 
 import (
+	"buf.build/go/protovalidate"
 	"context"
 	"fmt"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/verizhang/billing-engine/config"
-	loanpb "github.com/verizhang/billing-engine/contracts/pb/loan"
-	paymentpb "github.com/verizhang/billing-engine/contracts/pb/payment"
+	"github.com/verizhang/billing-engine/contracts/openapi"
+	auditv1 "github.com/verizhang/billing-engine/contracts/pb/billing/audit/v1"
+	jobv1 "github.com/verizhang/billing-engine/contracts/pb/billing/job/v1"
+	loanv1 "github.com/verizhang/billing-engine/contracts/pb/billing/loan/v1"
+	notificationv1 "github.com/verizhang/billing-engine/contracts/pb/billing/notification/v1"
+	paymentv1 "github.com/verizhang/billing-engine/contracts/pb/billing/payment/v1"
+	reconciliationv1 "github.com/verizhang/billing-engine/contracts/pb/billing/reconciliation/v1"
+	statementv1 "github.com/verizhang/billing-engine/contracts/pb/billing/statement/v1"
+	webhookv1 "github.com/verizhang/billing-engine/contracts/pb/billing/webhook/v1"
+	writeoffv1 "github.com/verizhang/billing-engine/contracts/pb/billing/writeoff/v1"
+	"github.com/verizhang/billing-engine/src/entities"
 	"github.com/verizhang/billing-engine/src/handlers"
+	"github.com/verizhang/billing-engine/src/interceptors"
+	"github.com/verizhang/billing-engine/src/notifications"
+	"github.com/verizhang/billing-engine/src/providers"
+	"github.com/verizhang/billing-engine/src/publishers"
 	"github.com/verizhang/billing-engine/src/repositories"
+	"github.com/verizhang/billing-engine/src/scheduler"
 	"github.com/verizhang/billing-engine/src/services"
+	"github.com/verizhang/billing-engine/src/utils/auth"
+	"github.com/verizhang/billing-engine/src/utils/paymentref"
 	"google.golang.org/grpc"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"log/slog"
 	"net"
 	"net/http"
+	"os"
 	"time"
 )
 
 func main() {
 	cfg := config.New()
-	grpcServer := startGRPCServer(cfg)
-	startHTTPServer(cfg)
+	if len(os.Args) > 1 && os.Args[1] == "reconcile" {
+		os.Exit(reconcile(cfg, os.Args[2:]))
+	}
+
+	grpcServer, callbacks := startGRPCServer(cfg)
+	startHTTPServer(cfg, callbacks)
 	grpcServer.GracefulStop()
 }
 
-func registerSvc(cfg config.Config, server *grpc.Server) {
-	db := InitDB(
-		cfg.PostgresHost,
-		cfg.PostgresUsername,
-		cfg.PostgresPassword,
-		cfg.PostgresDatabase,
-		cfg.PostgresPort,
-		cfg.PostgresSslmode,
-		cfg.PostgresTimeZone,
-		cfg.PostgresMaxConnections,
-		cfg.PostgresMaxIdleConnection,
-		cfg.PostgresConnectionMaxIdleTime,
-	)
+// registerSvc registers the gRPC services and returns the handler of the provider callbacks, which are plain HTTP
+func registerSvc(cfg config.Config, server *grpc.Server, legacy *handlers.LegacyServices) http.Handler {
+	db := openDB(cfg)
+
+	// a bad payment reference format would otherwise only surface when the first loan is created
+	err := paymentref.Format{
+		Prefix:     cfg.PaymentReferencePrefix,
+		Length:     cfg.PaymentReferenceLength,
+		CheckDigit: cfg.PaymentReferenceCheckDigit,
+	}.Validate()
+	if err != nil {
+		panic(fmt.Sprintf("failed to configure payment references: %v", err))
+	}
 
 	// Repository
 	unitOfWork := repositories.NewUnitOfWork(db)
 	loanRepository := repositories.NewLoanRepository(db)
 	paymentRepository := repositories.NewPaymentRepository(db)
+	paymentScheduleRepository := repositories.NewPaymentScheduleRepository(db)
+	paymentDeferralRepository := repositories.NewPaymentDeferralRepository(db)
+	loanWriteOffRepository := repositories.NewLoanWriteOffRepository(db)
+	loanRecoveryRepository := repositories.NewLoanRecoveryRepository(db)
+	paymentTransactionRepository := repositories.NewPaymentTransactionRepository(db)
+	auditEventRepository := repositories.NewAuditEventRepository(db)
+	outboxEventRepository := repositories.NewOutboxEventRepository(db)
+	webhookSubscriptionRepository := repositories.NewWebhookSubscriptionRepository(db)
+	webhookDeliveryRepository := repositories.NewWebhookDeliveryRepository(db)
+	providerCallbackRepository := repositories.NewProviderCallbackRepository(db)
+	bankStatementImportRepository := repositories.NewBankStatementImportRepository(db)
+	bankStatementLineRepository := repositories.NewBankStatementLineRepository(db)
+	jobRunRepository := repositories.NewJobRunRepository(db)
+	notificationPreferenceRepository := repositories.NewNotificationPreferenceRepository(db)
+	notificationDeliveryRepository := repositories.NewNotificationDeliveryRepository(db)
+
+	// Publisher
+	publisher, err := publishers.New(cfg, db)
+	if err != nil {
+		panic(fmt.Sprintf("failed to create event publisher: %v", err))
+	}
+
+	// Notification
+	channels, err := notifications.New(cfg)
+	if err != nil {
+		panic(fmt.Sprintf("failed to create notification channels: %v", err))
+	}
+	templates, err := notifications.LoadTemplates(cfg.NotificationTemplatesDir, cfg.NotificationDefaultLocale)
+	if err != nil {
+		panic(fmt.Sprintf("failed to load notification templates: %v", err))
+	}
 
 	// Service
-	loanService := services.NewLoanService(unitOfWork, loanRepository, paymentRepository)
-	paymentService := services.NewPaymentService(cfg, paymentRepository, loanRepository, unitOfWork)
+	loanService := services.NewLoanService(cfg, unitOfWork, loanRepository, paymentRepository, paymentScheduleRepository, paymentDeferralRepository)
+	paymentService := services.NewPaymentService(cfg, paymentRepository, loanRepository, unitOfWork, paymentTransactionRepository)
+	writeOffService := services.NewWriteOffService(cfg, unitOfWork, loanRepository, paymentRepository, loanWriteOffRepository, loanRecoveryRepository)
+	statementService := services.NewStatementService(loanRepository, paymentRepository, paymentScheduleRepository, paymentDeferralRepository)
+	auditService := services.NewAuditService(loanRepository, auditEventRepository)
+	providerCallbackService := services.NewProviderCallbackService(paymentService, providerCallbackRepository)
+	reconciliationService := services.NewReconciliationService(cfg, unitOfWork, loanRepository, paymentRepository, bankStatementImportRepository, bankStatementLineRepository, paymentService)
+	webhookService := services.NewWebhookService(cfg, unitOfWork, webhookSubscriptionRepository, webhookDeliveryRepository, outboxEventRepository, &http.Client{Timeout: cfg.WebhookTimeout})
+	outboxService := services.NewOutboxService(cfg, unitOfWork, outboxEventRepository, publisher, webhookService)
+	notificationService := services.NewNotificationService(cfg, unitOfWork, paymentService, loanRepository, paymentRepository, notificationPreferenceRepository, notificationDeliveryRepository, channels, templates)
+
+	// Worker
+	go runEvery(cfg.OutboxRelayInterval, "relay outbox events", func(ctx context.Context) error {
+		_, err := outboxService.RelayOutboxEvents(ctx)
+		return err
+	})
+	go runEvery(cfg.OutboxRelayInterval, "fan out outbox events", func(ctx context.Context) error {
+		_, err := outboxService.FanOutOutboxEvents(ctx)
+		return err
+	})
+	go runEvery(cfg.WebhookDispatchInterval, "deliver webhooks", func(ctx context.Context) error {
+		_, err := webhookService.DeliverWebhooks(ctx)
+		return err
+	})
+	jobService := services.NewJobService(jobRunRepository, map[string]services.JobFunc{
+		entities.JOB_DELINQUENCY_SWEEP: func(ctx context.Context) (int, error) {
+			loans, err := loanService.SweepDelinquentLoans(ctx)
+			return len(loans), err
+		},
+		entities.JOB_LATE_FEE_ACCRUAL: func(ctx context.Context) (int, error) {
+			payments, err := loanService.AccrueLateFees(ctx)
+			return len(payments), err
+		},
+		entities.JOB_PAYMENT_REMINDERS: func(ctx context.Context) (int, error) {
+			deliveries, err := notificationService.SendPaymentReminders(ctx)
+			return len(deliveries), err
+		},
+	}, hostname())
+	startScheduler(cfg, jobService)
 
 	// Handler
 	loanHandler := handlers.NewLoanHandler(loanService)
 	paymentHandler := handlers.NewPaymentHandler(paymentService)
+	writeOffHandler := handlers.NewWriteOffHandler(writeOffService)
+	statementHandler := handlers.NewStatementHandler(statementService)
+	auditHandler := handlers.NewAuditHandler(auditService)
+	webhookHandler := handlers.NewWebhookHandler(webhookService)
+	jobHandler := handlers.NewJobHandler(jobService)
+	notificationHandler := handlers.NewNotificationHandler(notificationService)
+	reconciliationHandler := handlers.NewReconciliationHandler(reconciliationService)
+
+	loanv1.RegisterLoanServiceServer(server, loanHandler)
+	paymentv1.RegisterPaymentServiceServer(server, paymentHandler)
+	writeoffv1.RegisterWriteOffServiceServer(server, writeOffHandler)
+	statementv1.RegisterStatementServiceServer(server, statementHandler)
+	auditv1.RegisterAuditServiceServer(server, auditHandler)
+	webhookv1.RegisterWebhookServiceServer(server, webhookHandler)
+	reconciliationv1.RegisterReconciliationServiceServer(server, reconciliationHandler)
+	jobv1.RegisterJobServiceServer(server, jobHandler)
+	notificationv1.RegisterNotificationServiceServer(server, notificationHandler)
 
-	loanpb.RegisterLoanServer(server, loanHandler)
-	paymentpb.RegisterPaymentServer(server, paymentHandler)
+	// the service names of the unversioned contracts, served until the clients have moved to v1
+	legacy.Register("loan.loan", &loanv1.LoanService_ServiceDesc, loanHandler)
+	legacy.Register("loan.payment", &paymentv1.PaymentService_ServiceDesc, paymentHandler)
+	legacy.Register("writeoff.writeOff", &writeoffv1.WriteOffService_ServiceDesc, writeOffHandler)
+	legacy.Register("statement.statement", &statementv1.StatementService_ServiceDesc, statementHandler)
+
+	return handlers.ProviderCallbacks(providers.New(cfg), providerCallbackService)
 }
 
-func startGRPCServer(cfg config.Config) *grpc.Server {
-	grpcServer := grpc.NewServer()
+// runEvery runs job at every interval for the life of the process, a failed run is logged and retried at the next one
+func runEvery(interval time.Duration, name string, job func(ctx context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
 
-	registerSvc(cfg, grpcServer)
+	for range ticker.C {
+		if err := job(context.Background()); err != nil {
+			slog.Error("failed to run the background task", "task", name, "error", err)
+		}
+	}
+}
+
+// startScheduler runs the scheduled jobs on every replica, the advisory lock of a job elects the one running it each time
+func startScheduler(cfg config.Config, jobService services.JobService) {
+	location, err := time.LoadLocation(cfg.SchedulerTimezone)
+	if err != nil {
+		panic(fmt.Sprintf("failed to load scheduler timezone: %v", err))
+	}
+
+	jobScheduler := scheduler.New(location)
+	schedules := []struct {
+		job  string
+		spec string
+	}{
+		{job: entities.JOB_DELINQUENCY_SWEEP, spec: cfg.DelinquencySweepSchedule},
+		{job: entities.JOB_LATE_FEE_ACCRUAL, spec: cfg.LateFeeSchedule},
+		{job: entities.JOB_PAYMENT_REMINDERS, spec: cfg.PaymentReminderSchedule},
+	}
+	for _, schedule := range schedules {
+		job := schedule.job
+		err = jobScheduler.Add(job, schedule.spec, func(ctx context.Context, scheduledAt time.Time) {
+			if _, err := jobService.RunJob(ctx, job, scheduledAt); err != nil {
+				slog.ErrorContext(ctx, "failed to run the job", "job", job, "error", err)
+			}
+		})
+		if err != nil {
+			panic(fmt.Sprintf("failed to schedule jobs: %v", err))
+		}
+	}
+
+	jobScheduler.Start(context.Background())
+}
+
+// hostname names the replica in the job runs
+func hostname() string {
+	name, err := os.Hostname()
+	if err != nil {
+		return "unknown"
+	}
+	return name
+}
+
+// roles lists the methods reserved for staff, every other method is open to borrowers on their own data
+var roles = map[string]auth.Role{
+	loanv1.LoanService_RestructureLoan_FullMethodName:                              auth.ROLE_OPERATOR,
+	writeoffv1.WriteOffService_WriteOffLoan_FullMethodName:                         auth.ROLE_OPERATOR,
+	writeoffv1.WriteOffService_GetRecoveriesReport_FullMethodName:                  auth.ROLE_OPERATOR,
+	writeoffv1.WriteOffService_ApplyWriteOffPolicy_FullMethodName:                  auth.ROLE_ADMIN,
+	auditv1.AuditService_ListAuditEvents_FullMethodName:                            auth.ROLE_OPERATOR,
+	auditv1.AuditService_VerifyAuditTrail_FullMethodName:                           auth.ROLE_OPERATOR,
+	webhookv1.WebhookService_CreateWebhookSubscription_FullMethodName:              auth.ROLE_ADMIN,
+	webhookv1.WebhookService_ListWebhookSubscriptions_FullMethodName:               auth.ROLE_OPERATOR,
+	webhookv1.WebhookService_UpdateWebhookSubscription_FullMethodName:              auth.ROLE_ADMIN,
+	webhookv1.WebhookService_DeleteWebhookSubscription_FullMethodName:              auth.ROLE_ADMIN,
+	webhookv1.WebhookService_ListWebhookDeliveries_FullMethodName:                  auth.ROLE_OPERATOR,
+	webhookv1.WebhookService_RedeliverWebhook_FullMethodName:                       auth.ROLE_OPERATOR,
+	reconciliationv1.ReconciliationService_ImportBankStatement_FullMethodName:      auth.ROLE_OPERATOR,
+	reconciliationv1.ReconciliationService_ListBankStatementLines_FullMethodName:   auth.ROLE_OPERATOR,
+	reconciliationv1.ReconciliationService_ResolveBankStatementLine_FullMethodName: auth.ROLE_OPERATOR,
+	reconciliationv1.ReconciliationService_DismissBankStatementLine_FullMethodName: auth.ROLE_OPERATOR,
+	reconciliationv1.ReconciliationService_GetReconciliationReport_FullMethodName:  auth.ROLE_OPERATOR,
+	jobv1.JobService_ListJobRuns_FullMethodName:                                    auth.ROLE_OPERATOR,
+}
+
+func startGRPCServer(cfg config.Config) (*grpc.Server, http.Handler) {
+	validator, err := protovalidate.New()
+	if err != nil {
+		panic(fmt.Sprintf("failed to create request validator: %v", err))
+	}
+
+	verifier, err := auth.NewVerifier(cfg.AuthHS256Secret, cfg.AuthJWKSFile, cfg.AuthIssuer, cfg.AuthAudience)
+	if err != nil {
+		panic(fmt.Sprintf("failed to create token verifier: %v", err))
+	}
+
+	// the request id is assigned first so every later step can log and audit with it,
+	// authentication runs before validation so borrowers get their userId filled in before it is validated
+	interceptor := interceptors.Chain(
+		interceptors.RequestID(),
+		interceptors.Authentication(verifier, roles),
+		interceptors.Validation(validator),
+	)
+	legacy := handlers.NewLegacyServices(interceptor)
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(interceptor),
+		grpc.UnknownServiceHandler(legacy.Handle),
+	)
+
+	callbacks := registerSvc(cfg, grpcServer, legacy)
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", cfg.GRPCPort))
 	if err != nil {
@@ -67,37 +275,105 @@ func startGRPCServer(cfg config.Config) *grpc.Server {
 	}
 
 	go func() {
-		fmt.Printf("running gRPC server on port %s\n", cfg.GRPCPort)
+		slog.Info("running gRPC server", "port", cfg.GRPCPort)
 		if err := grpcServer.Serve(lis); err != nil {
 			panic(fmt.Sprintf("failed to serve gRPC server: %v", err))
 		}
 	}()
 
-	return grpcServer
+	return grpcServer, callbacks
 }
 
-func startHTTPServer(cfg config.Config) {
+func startHTTPServer(cfg config.Config, callbacks http.Handler) {
 	ctx := context.Background()
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(handlers.IncomingHeader),
+		runtime.WithOutgoingHeaderMatcher(handlers.OutgoingHeader),
+	)
 	opts := []grpc.DialOption{grpc.WithInsecure()}
 
-	err := loanpb.RegisterLoanHandlerFromEndpoint(ctx, mux, fmt.Sprintf(":%s", cfg.GRPCPort), opts)
+	err := loanv1.RegisterLoanServiceHandlerFromEndpoint(ctx, mux, fmt.Sprintf(":%s", cfg.GRPCPort), opts)
 	if err != nil {
 		panic(fmt.Sprintf("failed to register loan gRPC Gateway: %v", err))
 	}
 
-	err = paymentpb.RegisterPaymentHandlerFromEndpoint(ctx, mux, fmt.Sprintf(":%s", cfg.GRPCPort), opts)
+	err = paymentv1.RegisterPaymentServiceHandlerFromEndpoint(ctx, mux, fmt.Sprintf(":%s", cfg.GRPCPort), opts)
 	if err != nil {
 		panic(fmt.Sprintf("failed to register payment gRPC Gateway: %v", err))
 	}
 
-	fmt.Printf("running REST server on port %s\n", cfg.RESTPort)
-	err = http.ListenAndServe(fmt.Sprintf(":%s", cfg.RESTPort), mux)
+	err = writeoffv1.RegisterWriteOffServiceHandlerFromEndpoint(ctx, mux, fmt.Sprintf(":%s", cfg.GRPCPort), opts)
+	if err != nil {
+		panic(fmt.Sprintf("failed to register write off gRPC Gateway: %v", err))
+	}
+
+	err = statementv1.RegisterStatementServiceHandlerFromEndpoint(ctx, mux, fmt.Sprintf(":%s", cfg.GRPCPort), opts)
+	if err != nil {
+		panic(fmt.Sprintf("failed to register statement gRPC Gateway: %v", err))
+	}
+
+	err = auditv1.RegisterAuditServiceHandlerFromEndpoint(ctx, mux, fmt.Sprintf(":%s", cfg.GRPCPort), opts)
+	if err != nil {
+		panic(fmt.Sprintf("failed to register audit gRPC Gateway: %v", err))
+	}
+
+	err = webhookv1.RegisterWebhookServiceHandlerFromEndpoint(ctx, mux, fmt.Sprintf(":%s", cfg.GRPCPort), opts)
+	if err != nil {
+		panic(fmt.Sprintf("failed to register webhook gRPC Gateway: %v", err))
+	}
+
+	err = reconciliationv1.RegisterReconciliationServiceHandlerFromEndpoint(ctx, mux, fmt.Sprintf(":%s", cfg.GRPCPort), opts)
+	if err != nil {
+		panic(fmt.Sprintf("failed to register reconciliation gRPC Gateway: %v", err))
+	}
+
+	err = jobv1.RegisterJobServiceHandlerFromEndpoint(ctx, mux, fmt.Sprintf(":%s", cfg.GRPCPort), opts)
+	if err != nil {
+		panic(fmt.Sprintf("failed to register job gRPC Gateway: %v", err))
+	}
+
+	err = notificationv1.RegisterNotificationServiceHandlerFromEndpoint(ctx, mux, fmt.Sprintf(":%s", cfg.GRPCPort), opts)
+	if err != nil {
+		panic(fmt.Sprintf("failed to register notification gRPC Gateway: %v", err))
+	}
+
+	docs := handlers.OpenAPI(openapi.Spec)
+	root := http.NewServeMux()
+	root.Handle(handlers.OPENAPI_SPEC_PATH, docs)
+	root.Handle(handlers.OPENAPI_DOCS_PATH, docs)
+	root.Handle(handlers.PROVIDER_CALLBACK_PATH, callbacks)
+	root.Handle("/", handlers.LegacyRoutes(mux))
+
+	slog.Info("running REST server", "port", cfg.RESTPort)
+	err = http.ListenAndServe(fmt.Sprintf(":%s", cfg.RESTPort), root)
 	if err != nil {
 		panic(fmt.Sprintf("failed to serve HTTP: %v", err))
 	}
 }
 
+// openDB connects to the configured database with the audit callbacks registered
+func openDB(cfg config.Config) *gorm.DB {
+	db := InitDB(
+		cfg.PostgresHost,
+		cfg.PostgresUsername,
+		cfg.PostgresPassword,
+		cfg.PostgresDatabase,
+		cfg.PostgresPort,
+		cfg.PostgresSslmode,
+		cfg.PostgresTimeZone,
+		cfg.PostgresMaxConnections,
+		cfg.PostgresMaxIdleConnection,
+		cfg.PostgresConnectionMaxIdleTime,
+	)
+
+	err := repositories.RegisterAuditCallbacks(db)
+	if err != nil {
+		panic(fmt.Sprintf("failed to register audit callbacks: %v", err))
+	}
+
+	return db
+}
+
 func InitDB(host, user, password, dbname, port, sslmode, timezone string, maxConnections, maxIdleConnections, connectionsMaxIdleTime int) *gorm.DB {
 	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=%s TimeZone=%s",
 		host,
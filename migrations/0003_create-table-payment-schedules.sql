@@ -0,0 +1,37 @@
+CREATE TABLE payment_schedules(
+    id VARCHAR(50) PRIMARY KEY,
+    loan_id VARCHAR(50) NOT NULL REFERENCES loans(id),
+    version INTEGER NOT NULL,
+    previous_schedule_id VARCHAR(50) REFERENCES payment_schedules(id),
+    principal NUMERIC NOT NULL,
+    interest NUMERIC NOT NULL,
+    interest_rate NUMERIC NOT NULL,
+    tenor INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL,
+    created_by VARCHAR(50) DEFAULT NULL,
+    updated_by VARCHAR(50) DEFAULT NULL,
+    deleted_by VARCHAR(50) DEFAULT NULL
+);
+CREATE UNIQUE INDEX IDX_loan_id_version ON payment_schedules(loan_id, version);
+
+ALTER TABLE payments
+    ADD COLUMN schedule_id VARCHAR(50) REFERENCES payment_schedules(id),
+    ADD COLUMN principal NUMERIC NOT NULL DEFAULT 0,
+    ADD COLUMN interest NUMERIC NOT NULL DEFAULT 0;
+CREATE INDEX IDX_schedule_id ON payments(schedule_id);
+
+-- existing loans get their original schedule as version 1
+INSERT INTO payment_schedules(id, loan_id, version, principal, interest, interest_rate, tenor, created_at)
+SELECT l.id, l.id, 1, l.amount, l.interest, l.interest / l.amount,
+       (SELECT COUNT(*) FROM payments p WHERE p.loan_id = l.id), l.created_at
+FROM loans l;
+
+UPDATE payments p
+SET schedule_id = p.loan_id,
+    principal = l.amount / s.tenor,
+    interest = l.interest / s.tenor
+FROM loans l
+JOIN payment_schedules s ON s.id = l.id
+WHERE l.id = p.loan_id;
@@ -0,0 +1,24 @@
+ALTER TABLE loans
+    ADD COLUMN product VARCHAR(50) NOT NULL DEFAULT 'default',
+    ADD COLUMN fee NUMERIC NOT NULL DEFAULT 0;
+
+CREATE TABLE payment_deferrals(
+    id VARCHAR(50) PRIMARY KEY,
+    loan_id VARCHAR(50) NOT NULL REFERENCES loans(id),
+    installments INTEGER NOT NULL,
+    start_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    end_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    fee NUMERIC NOT NULL DEFAULT 0,
+    interest NUMERIC NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL,
+    created_by VARCHAR(50) DEFAULT NULL,
+    updated_by VARCHAR(50) DEFAULT NULL,
+    deleted_by VARCHAR(50) DEFAULT NULL
+);
+CREATE INDEX IDX_payment_deferrals_loan_id ON payment_deferrals(loan_id);
+
+ALTER TABLE payments
+    ADD COLUMN fee NUMERIC NOT NULL DEFAULT 0,
+    ADD COLUMN deferral_id VARCHAR(50) REFERENCES payment_deferrals(id);
@@ -0,0 +1,39 @@
+ALTER TABLE loans ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'active';
+UPDATE loans SET status = 'paid_off' WHERE is_active = false;
+CREATE INDEX IDX_status ON loans(status);
+
+CREATE TABLE loan_write_offs(
+    id VARCHAR(50) PRIMARY KEY,
+    loan_id VARCHAR(50) NOT NULL REFERENCES loans(id),
+    principal NUMERIC NOT NULL,
+    interest NUMERIC NOT NULL,
+    fee NUMERIC NOT NULL,
+    days_past_due INTEGER NOT NULL,
+    reason VARCHAR(20) NOT NULL,
+    written_off_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL,
+    created_by VARCHAR(50) DEFAULT NULL,
+    updated_by VARCHAR(50) DEFAULT NULL,
+    deleted_by VARCHAR(50) DEFAULT NULL
+);
+CREATE UNIQUE INDEX IDX_loan_write_offs_loan_id ON loan_write_offs(loan_id);
+CREATE INDEX IDX_written_off_at ON loan_write_offs(written_off_at);
+
+CREATE TABLE loan_recoveries(
+    id VARCHAR(50) PRIMARY KEY,
+    loan_id VARCHAR(50) NOT NULL REFERENCES loans(id),
+    write_off_id VARCHAR(50) NOT NULL REFERENCES loan_write_offs(id),
+    payment_id VARCHAR(50) NOT NULL REFERENCES payments(id),
+    amount NUMERIC NOT NULL,
+    recovered_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL,
+    created_by VARCHAR(50) DEFAULT NULL,
+    updated_by VARCHAR(50) DEFAULT NULL,
+    deleted_by VARCHAR(50) DEFAULT NULL
+);
+CREATE INDEX IDX_loan_recoveries_loan_id ON loan_recoveries(loan_id);
+CREATE INDEX IDX_recovered_at ON loan_recoveries(recovered_at);
@@ -0,0 +1 @@
+ALTER TABLE loans ADD COLUMN refinanced_by_loan_id VARCHAR(50) DEFAULT NULL REFERENCES loans(id);
@@ -0,0 +1,2 @@
+CREATE INDEX IDX_user_id_created_at_id ON loans(user_id, created_at DESC, id DESC);
+CREATE INDEX IDX_user_id_status_created_at_id ON loans(user_id, status, created_at DESC, id DESC);
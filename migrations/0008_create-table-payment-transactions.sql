@@ -0,0 +1,47 @@
+CREATE TABLE payment_transactions(
+    id VARCHAR(50) PRIMARY KEY,
+    loan_id VARCHAR(50) NOT NULL REFERENCES loans(id),
+    user_id VARCHAR(50) NOT NULL,
+    amount NUMERIC NOT NULL,
+    channel VARCHAR(20) NOT NULL,
+    reference VARCHAR(100) DEFAULT NULL,
+    paid_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    reversed_at TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL,
+    created_by VARCHAR(50) DEFAULT NULL,
+    updated_by VARCHAR(50) DEFAULT NULL,
+    deleted_by VARCHAR(50) DEFAULT NULL
+);
+CREATE INDEX IDX_payment_transactions_loan_id_paid_at ON payment_transactions(loan_id, paid_at DESC, id DESC);
+CREATE INDEX IDX_payment_transactions_user_id_paid_at ON payment_transactions(user_id, paid_at DESC, id DESC);
+
+CREATE TABLE payment_allocations(
+    id VARCHAR(50) PRIMARY KEY,
+    transaction_id VARCHAR(50) NOT NULL REFERENCES payment_transactions(id),
+    payment_id VARCHAR(50) NOT NULL REFERENCES payments(id),
+    amount NUMERIC NOT NULL,
+    principal NUMERIC NOT NULL,
+    interest NUMERIC NOT NULL,
+    fee NUMERIC NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL,
+    created_by VARCHAR(50) DEFAULT NULL,
+    updated_by VARCHAR(50) DEFAULT NULL,
+    deleted_by VARCHAR(50) DEFAULT NULL
+);
+CREATE INDEX IDX_payment_allocations_transaction_id ON payment_allocations(transaction_id);
+CREATE INDEX IDX_payment_allocations_payment_id ON payment_allocations(payment_id);
+
+-- every installment paid before this migration becomes a transaction settling only that installment
+INSERT INTO payment_transactions(id, loan_id, user_id, amount, channel, paid_at)
+SELECT payments.id, payments.loan_id, loans.user_id, payments.amount, 'legacy', payments.paid_at
+FROM payments JOIN loans ON loans.id = payments.loan_id
+WHERE payments.paid_at IS NOT NULL;
+
+INSERT INTO payment_allocations(id, transaction_id, payment_id, amount, principal, interest, fee)
+SELECT payments.id, payments.id, payments.id, payments.amount, payments.principal, payments.interest, payments.fee
+FROM payments
+WHERE payments.paid_at IS NOT NULL;
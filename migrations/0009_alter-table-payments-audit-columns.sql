@@ -0,0 +1,4 @@
+ALTER TABLE payments
+    ALTER COLUMN created_by TYPE VARCHAR(50) USING created_by::VARCHAR,
+    ALTER COLUMN updated_by TYPE VARCHAR(50) USING updated_by::VARCHAR,
+    ALTER COLUMN deleted_by TYPE VARCHAR(50) USING deleted_by::VARCHAR;
@@ -0,0 +1,30 @@
+CREATE TABLE audit_events(
+    id VARCHAR(50) PRIMARY KEY,
+    loan_id VARCHAR(50) DEFAULT NULL REFERENCES loans(id),
+    sequence BIGINT NOT NULL,
+    actor VARCHAR(50) NOT NULL,
+    action VARCHAR(50) NOT NULL,
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id VARCHAR(50) NOT NULL,
+    before JSON DEFAULT NULL,
+    after JSON DEFAULT NULL,
+    request_id VARCHAR(100) NOT NULL DEFAULT '',
+    prev_hash VARCHAR(64) NOT NULL DEFAULT '',
+    hash VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+-- one chain per loan, two writers appending the same sequence make the later transaction fail instead of forking the chain
+CREATE UNIQUE INDEX UIDX_audit_events_loan_id_sequence ON audit_events(loan_id, sequence) WHERE loan_id IS NOT NULL;
+-- changes outside of a loan (webhook subscriptions, bank statements, notification preferences) chain per entity
+CREATE UNIQUE INDEX UIDX_audit_events_entity_sequence ON audit_events(entity_type, entity_id, sequence) WHERE loan_id IS NULL;
+
+-- the trail is append only, rows are never updated or deleted
+CREATE FUNCTION audit_events_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER TRG_audit_events_append_only
+    BEFORE UPDATE OR DELETE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
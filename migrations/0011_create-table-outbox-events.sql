@@ -0,0 +1,18 @@
+CREATE TABLE outbox_events(
+    id VARCHAR(50) PRIMARY KEY,
+    -- allocation order of the events, a transaction committing later can hold a lower position,
+    -- the events of a loan still follow their sequence since the next one is only written once the previous one is committed
+    position BIGSERIAL NOT NULL,
+    loan_id VARCHAR(50) NOT NULL REFERENCES loans(id),
+    sequence BIGINT NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    payload JSON NOT NULL,
+    request_id VARCHAR(100) NOT NULL DEFAULT '',
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT DEFAULT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    published_at TIMESTAMP WITH TIME ZONE DEFAULT NULL
+);
+-- one ordered stream per loan, two writers appending the same sequence make the later transaction fail
+CREATE UNIQUE INDEX UIDX_outbox_events_loan_id_sequence ON outbox_events(loan_id, sequence);
+CREATE INDEX IDX_outbox_events_pending ON outbox_events(position) WHERE published_at IS NULL;
@@ -0,0 +1,2 @@
+-- set when the loan turns delinquent, cleared once it is back on schedule so the next delinquency is published again
+ALTER TABLE loans ADD COLUMN delinquent_at TIMESTAMP WITH TIME ZONE DEFAULT NULL;
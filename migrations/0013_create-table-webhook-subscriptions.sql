@@ -0,0 +1,34 @@
+CREATE TABLE webhook_subscriptions(
+    id VARCHAR(50) PRIMARY KEY,
+    url VARCHAR(2048) NOT NULL,
+    -- JSON array of the event types delivered to the url
+    event_types JSON NOT NULL,
+    secret VARCHAR(255) NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL,
+    created_by VARCHAR(50) DEFAULT NULL,
+    updated_by VARCHAR(50) DEFAULT NULL,
+    deleted_by VARCHAR(50) DEFAULT NULL
+);
+
+CREATE TABLE webhook_deliveries(
+    id VARCHAR(50) PRIMARY KEY,
+    subscription_id VARCHAR(50) NOT NULL REFERENCES webhook_subscriptions(id),
+    event_id VARCHAR(50) NOT NULL REFERENCES outbox_events(id),
+    event_type VARCHAR(50) NOT NULL,
+    -- pending, delivered or dead
+    status VARCHAR(20) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_status_code INT DEFAULT NULL,
+    last_error TEXT DEFAULT NULL,
+    delivered_at TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+-- the relay publishes an event at least once, a subscription gets one delivery per event
+CREATE UNIQUE INDEX UIDX_webhook_deliveries_subscription_id_event_id ON webhook_deliveries(subscription_id, event_id);
+CREATE INDEX IDX_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IDX_webhook_deliveries_subscription_id_created_at ON webhook_deliveries(subscription_id, created_at DESC, id DESC);
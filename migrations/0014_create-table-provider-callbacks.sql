@@ -0,0 +1,21 @@
+-- a provider payment is posted once however often the provider notifies it
+CREATE UNIQUE INDEX UIDX_payment_transactions_channel_reference ON payment_transactions(channel, reference) WHERE reference IS NOT NULL;
+
+-- every verified provider notification with its outcome, unmatched and rejected payments wait here for an operator
+CREATE TABLE provider_callbacks(
+    id VARCHAR(50) PRIMARY KEY,
+    provider VARCHAR(50) NOT NULL,
+    external_id VARCHAR(100) NOT NULL,
+    loan_id VARCHAR(50) DEFAULT NULL REFERENCES loans(id),
+    virtual_account VARCHAR(50) NOT NULL DEFAULT '',
+    reference VARCHAR(100) NOT NULL DEFAULT '',
+    amount NUMERIC NOT NULL,
+    -- posted, duplicate, unmatched or rejected
+    status VARCHAR(20) NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    payload JSON NOT NULL,
+    request_id VARCHAR(100) NOT NULL DEFAULT '',
+    received_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+CREATE INDEX IDX_provider_callbacks_provider_external_id ON provider_callbacks(provider, external_id);
+CREATE INDEX IDX_provider_callbacks_status_received_at ON provider_callbacks(status, received_at);
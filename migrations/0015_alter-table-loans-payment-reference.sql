@@ -0,0 +1,3 @@
+-- the payment reference, or virtual account number, a borrower quotes on bank transfers, NULL for loans created before it existed
+ALTER TABLE loans ADD COLUMN payment_reference VARCHAR(34) DEFAULT NULL;
+CREATE UNIQUE INDEX UIDX_loans_payment_reference ON loans(payment_reference) WHERE payment_reference IS NOT NULL;
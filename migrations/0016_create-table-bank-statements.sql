@@ -0,0 +1,38 @@
+-- a statement file is imported once, uploading it again resumes the lines an interrupted import left pending
+CREATE TABLE bank_statement_imports(
+    id VARCHAR(50) PRIMARY KEY,
+    -- csv, mt940 or camt053
+    format VARCHAR(20) NOT NULL,
+    file_name VARCHAR(255) NOT NULL DEFAULT '',
+    file_hash VARCHAR(64) NOT NULL,
+    lines INT NOT NULL,
+    imported_by VARCHAR(50) NOT NULL,
+    imported_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+CREATE UNIQUE INDEX UIDX_bank_statement_imports_file_hash ON bank_statement_imports(file_hash);
+CREATE INDEX IDX_bank_statement_imports_imported_at ON bank_statement_imports(imported_at);
+
+CREATE TABLE bank_statement_lines(
+    id VARCHAR(50) PRIMARY KEY,
+    import_id VARCHAR(50) NOT NULL REFERENCES bank_statement_imports(id),
+    line_no INT NOT NULL,
+    booked_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    -- negative for debits
+    amount NUMERIC NOT NULL,
+    currency VARCHAR(3) NOT NULL DEFAULT '',
+    reference VARCHAR(255) NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    bank_reference VARCHAR(100) NOT NULL DEFAULT '',
+    -- pending, posted, duplicate, exception, resolved, dismissed or ignored
+    status VARCHAR(20) NOT NULL,
+    reason VARCHAR(50) NOT NULL DEFAULT '',
+    loan_id VARCHAR(50) DEFAULT NULL REFERENCES loans(id),
+    resolved_by VARCHAR(50) NOT NULL DEFAULT '',
+    resolved_at TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+CREATE UNIQUE INDEX UIDX_bank_statement_lines_import_id_line_no ON bank_statement_lines(import_id, line_no);
+-- the exceptions queue
+CREATE INDEX IDX_bank_statement_lines_status_created_at ON bank_statement_lines(status, created_at DESC, id DESC);
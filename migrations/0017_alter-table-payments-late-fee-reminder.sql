@@ -0,0 +1,7 @@
+-- set once per installment by the late fee accrual and the payment reminders so neither is repeated,
+-- late_fee keeps the fee charged apart from the fee of a deferral for the statements
+ALTER TABLE payments
+    ADD COLUMN late_fee NUMERIC NOT NULL DEFAULT 0,
+    ADD COLUMN late_fee_at TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    ADD COLUMN reminded_at TIMESTAMP WITH TIME ZONE DEFAULT NULL;
+CREATE INDEX IDX_payments_unpaid_end_at ON payments(end_at) WHERE paid_at IS NULL AND deleted_at IS NULL;
@@ -0,0 +1,17 @@
+CREATE TABLE job_runs(
+    id VARCHAR(50) PRIMARY KEY,
+    job VARCHAR(50) NOT NULL,
+    scheduled_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    finished_at TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    duration_ms BIGINT NOT NULL DEFAULT 0,
+    -- running, succeeded or failed
+    status VARCHAR(20) NOT NULL,
+    items INT NOT NULL DEFAULT 0,
+    error TEXT DEFAULT NULL,
+    -- host name of the replica that ran the job
+    instance VARCHAR(255) NOT NULL
+);
+-- a replica whose clock lags takes the lock after the run finished elsewhere, the run of a schedule is recorded once
+CREATE UNIQUE INDEX UIDX_job_runs_job_scheduled_at ON job_runs(job, scheduled_at);
+CREATE INDEX IDX_job_runs_started_at ON job_runs(started_at DESC, id DESC);
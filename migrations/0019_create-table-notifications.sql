@@ -0,0 +1,33 @@
+CREATE TABLE notification_preferences(
+    user_id VARCHAR(50) PRIMARY KEY,
+    locale VARCHAR(10) NOT NULL,
+    email VARCHAR(255) NOT NULL DEFAULT '',
+    phone VARCHAR(20) NOT NULL DEFAULT '',
+    -- JSON array of the channels the borrower turned off
+    opt_outs JSON NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE TABLE notification_deliveries(
+    id VARCHAR(50) PRIMARY KEY,
+    user_id VARCHAR(50) NOT NULL,
+    loan_id VARCHAR(50) NOT NULL REFERENCES loans(id),
+    payment_id VARCHAR(50) NOT NULL REFERENCES payments(id),
+    -- payment_upcoming, payment_due or payment_overdue
+    kind VARCHAR(30) NOT NULL,
+    -- smtp, sms or log
+    channel VARCHAR(20) NOT NULL,
+    locale VARCHAR(10) NOT NULL,
+    recipient VARCHAR(255) NOT NULL,
+    subject TEXT NOT NULL,
+    body TEXT NOT NULL,
+    -- sent or failed
+    status VARCHAR(20) NOT NULL,
+    error TEXT DEFAULT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+-- a reminder is sent once per channel, failed attempts are kept next to it
+CREATE UNIQUE INDEX UIDX_notification_deliveries_sent ON notification_deliveries(payment_id, kind, channel) WHERE status = 'sent';
+CREATE INDEX IDX_notification_deliveries_payment_id_kind ON notification_deliveries(payment_id, kind);
+CREATE INDEX IDX_notification_deliveries_user_id_created_at ON notification_deliveries(user_id, created_at DESC, id DESC);
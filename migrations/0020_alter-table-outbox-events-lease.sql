@@ -0,0 +1,8 @@
+-- the relay leases a batch in a short transaction and publishes it outside, a failed event is leased again after a backoff
+-- and parked once it has failed OUTBOX_MAX_ATTEMPTS times so it no longer holds back its loan
+ALTER TABLE outbox_events
+    ADD COLUMN locked_until TIMESTAMP WITH TIME ZONE DEFAULT NULL,
+    ADD COLUMN parked_at TIMESTAMP WITH TIME ZONE DEFAULT NULL;
+DROP INDEX IDX_outbox_events_pending;
+CREATE INDEX IDX_outbox_events_pending ON outbox_events(position) WHERE published_at IS NULL AND parked_at IS NULL;
+CREATE INDEX IDX_outbox_events_leased ON outbox_events(loan_id) WHERE published_at IS NULL AND parked_at IS NULL AND locked_until IS NOT NULL;
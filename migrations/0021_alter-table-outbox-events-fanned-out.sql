@@ -0,0 +1,5 @@
+-- the webhook subscriptions get their deliveries independently of the configured publisher,
+-- the events published so far were fanned out with them
+ALTER TABLE outbox_events ADD COLUMN fanned_out_at TIMESTAMP WITH TIME ZONE DEFAULT NULL;
+UPDATE outbox_events SET fanned_out_at = published_at WHERE published_at IS NOT NULL;
+CREATE INDEX IDX_outbox_events_fan_out ON outbox_events(position) WHERE fanned_out_at IS NULL;
@@ -0,0 +1,4 @@
+-- a reminder is logged as sending before it goes out so a failure to log the outcome never sends it twice,
+-- one run at a time holds a channel of a reminder and a delivery left sending by a crash is not sent again
+DROP INDEX UIDX_notification_deliveries_sent;
+CREATE UNIQUE INDEX UIDX_notification_deliveries_sent ON notification_deliveries(payment_id, kind, channel) WHERE status IN ('sending', 'sent');
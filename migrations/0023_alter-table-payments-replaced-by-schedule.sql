@@ -0,0 +1,10 @@
+-- a restructure links the installments it cancels to the schedule version replacing them, so statements net them from
+-- that version instead of matching timestamps. Installments replaced before were deleted as their replacement was created
+ALTER TABLE payments ADD COLUMN replaced_by_schedule_id VARCHAR(50) REFERENCES payment_schedules(id);
+
+UPDATE payments p
+SET replaced_by_schedule_id = s.id
+FROM payment_schedules s
+WHERE s.previous_schedule_id = p.schedule_id
+  AND p.paid_at IS NULL
+  AND p.deleted_at = s.created_at;
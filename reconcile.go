@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/verizhang/billing-engine/config"
+	"github.com/verizhang/billing-engine/src/repositories"
+	"github.com/verizhang/billing-engine/src/services"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// reconcile imports a bank statement file from the command line, as in
+// billing-engine reconcile -format camt053 statement.xml, and returns the exit code
+func reconcile(cfg config.Config, args []string) int {
+	flags := flag.NewFlagSet("reconcile", flag.ContinueOnError)
+	format := flags.String("format", "csv", "format of the statement: csv, mt940 or camt053")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: billing-engine reconcile -format csv|mt940|camt053 <file>")
+		return 2
+	}
+
+	path := flags.Arg(0)
+	content, err := os.ReadFile(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to read statement: %v\n", err)
+		return 1
+	}
+
+	db := openDB(cfg)
+	unitOfWork := repositories.NewUnitOfWork(db)
+	loanRepository := repositories.NewLoanRepository(db)
+	paymentRepository := repositories.NewPaymentRepository(db)
+	paymentService := services.NewPaymentService(cfg, paymentRepository, loanRepository, unitOfWork, repositories.NewPaymentTransactionRepository(db))
+	reconciliationService := services.NewReconciliationService(cfg, unitOfWork, loanRepository, paymentRepository, repositories.NewBankStatementImportRepository(db), repositories.NewBankStatementLineRepository(db), paymentService)
+
+	result, err := reconciliationService.ImportBankStatement(context.Background(), *format, filepath.Base(path), content)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to reconcile statement: %v\n", err)
+		return 1
+	}
+
+	counts := map[string]int{}
+	for _, line := range result.Lines {
+		counts[line.Status]++
+	}
+	statuses := make([]string, 0, len(counts))
+	for status := range counts {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+
+	fmt.Printf("import %s: %d lines\n", result.Import.ID, result.Import.Lines)
+	for _, status := range statuses {
+		fmt.Printf("  %-10s %d\n", status, counts[status])
+	}
+
+	return 0
+}
@@ -10,6 +10,60 @@ export POSTGRES_TIMEZONE="Asia/Jakarta"
 export POSTGRES_MAX_CONNECTIONS="100"
 export POSTGRES_MAX_IDLE_CONNECTIONS="10"
 export POSTGRES_CONNECTIONS_MAX_IDLE_TIME="3600"
+export LOAN_MAX_EXPOSURE="15000000"
+export LOAN_CURRENCY="IDR"
+export WRITE_OFF_DAYS_PAST_DUE="180"
+export AUTH_HS256_SECRET="change-me"
+export AUTH_JWKS_FILE=""
+export AUTH_ISSUER=""
+export AUTH_AUDIENCE=""
+export EVENT_PUBLISHER="stdout"
+export EVENT_FILE_PATH="events.jsonl"
+export EVENT_WEBHOOK_URL=""
+export EVENT_WEBHOOK_SECRET=""
+export EVENT_WEBHOOK_TIMEOUT="10s"
+export EVENT_NOTIFY_CHANNEL="billing_events"
+export OUTBOX_RELAY_INTERVAL="5s"
+export OUTBOX_BATCH_SIZE="100"
+export OUTBOX_LEASE="20m"
+export OUTBOX_MAX_ATTEMPTS="10"
+export OUTBOX_BACKOFF_BASE="5s"
+export OUTBOX_BACKOFF_MAX="10m"
+export SCHEDULER_TIMEZONE="Asia/Jakarta"
+export DELINQUENCY_SWEEP_SCHEDULE="0 1 * * *"
+export LATE_FEE_SCHEDULE="30 1 * * *"
+export LATE_FEE_AMOUNT="25000"
+export LATE_FEE_GRACE_DAYS="3"
+export PAYMENT_REMINDER_SCHEDULE="0 8 * * *"
+export PAYMENT_REMINDER_DAYS="3"
+export NOTIFICATION_CHANNELS="log"
+export NOTIFICATION_DEFAULT_LOCALE="id"
+export NOTIFICATION_TEMPLATES_DIR=""
+export NOTIFICATION_OVERDUE_DAYS="1"
+export NOTIFICATION_MAX_ATTEMPTS="3"
+export SMTP_HOST=""
+export SMTP_PORT="587"
+export SMTP_USERNAME=""
+export SMTP_PASSWORD=""
+export SMTP_FROM=""
+export SMTP_TIMEOUT="10s"
+export SMS_GATEWAY_URL=""
+export SMS_GATEWAY_TOKEN=""
+export SMS_GATEWAY_TIMEOUT="10s"
+export WEBHOOK_MAX_ATTEMPTS="8"
+export WEBHOOK_BACKOFF_BASE="30s"
+export WEBHOOK_BACKOFF_MAX="6h"
+export WEBHOOK_TIMEOUT="10s"
+export WEBHOOK_DISPATCH_INTERVAL="5s"
+export WEBHOOK_BATCH_SIZE="100"
+export WEBHOOK_LEASE="20m"
+export PAYMENT_REFERENCE_PREFIX="8808"
+export PAYMENT_REFERENCE_LENGTH="16"
+export PAYMENT_REFERENCE_CHECK_DIGIT="luhn"
+export PROVIDER_GENERIC_SECRET=""
+export PROVIDER_XENDIT_CALLBACK_TOKEN=""
+export PROVIDER_SIGNATURE_TOLERANCE="5m"
+export DEFERRAL_POLICIES='{"default":{"maxDeferrals":2,"maxInstallments":4,"allowWhileDelinquent":false,"fee":50000,"interestRate":0}}'
 
 sh contracts/gen-proto.sh
 go run .
\ No newline at end of file
@@ -0,0 +1,169 @@
+package bankstatements
+
+import (
+	"encoding/xml"
+	"fmt"
+	"github.com/verizhang/billing-engine/src/entities"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// the elements of an ISO 20022 camt.053 Document used here, matched by local name so every schema version reads
+type camtDocument struct {
+	Statements []*camtStatement `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type camtStatement struct {
+	Entries []*camtEntry `xml:"Ntry"`
+}
+
+type camtEntry struct {
+	Reference      string             `xml:"NtryRef"`
+	Amount         camtAmount         `xml:"Amt"`
+	CreditDebit    string             `xml:"CdtDbtInd"`
+	BookingDate    camtDate           `xml:"BookgDt"`
+	ServicerRef    string             `xml:"AcctSvcrRef"`
+	Transactions   []*camtTransaction `xml:"NtryDtls>TxDtls"`
+	AdditionalInfo string             `xml:"AddtlNtryInf"`
+}
+
+type camtAmount struct {
+	Value    string `xml:",chardata"`
+	Currency string `xml:"Ccy,attr"`
+}
+
+type camtDate struct {
+	Date     string `xml:"Dt"`
+	DateTime string `xml:"DtTm"`
+}
+
+type camtTransaction struct {
+	Amount            *camtAmount `xml:"Amt"`
+	CreditDebit       string      `xml:"CdtDbtInd"`
+	ServicerRef       string      `xml:"Refs>AcctSvcrRef"`
+	EndToEndID        string      `xml:"Refs>EndToEndId"`
+	Unstructured      []string    `xml:"RmtInf>Ustrd"`
+	CreditorReference string      `xml:"RmtInf>Strd>CdtrRefInf>Ref"`
+	DebtorName        string      `xml:"RltdPties>Dbtr>Nm"`
+}
+
+// parseCAMT053 reads a line per entry, or per transaction of a batch entry whose transactions carry their own amounts
+func parseCAMT053(data []byte) ([]*entities.BankStatementLine, error) {
+	var document camtDocument
+	if err := xml.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("%w: %s", ParseError, err.Error())
+	}
+
+	var lines []*entities.BankStatementLine
+	for _, statement := range document.Statements {
+		for _, entry := range statement.Entries {
+			entryLines, err := parseCAMTEntry(entry)
+			if err != nil {
+				return nil, err
+			}
+			lines = append(lines, entryLines...)
+		}
+	}
+
+	return lines, nil
+}
+
+func parseCAMTEntry(entry *camtEntry) ([]*entities.BankStatementLine, error) {
+	bookedAt, err := entry.BookingDate.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	bankReference := entry.ServicerRef
+	if bankReference == "" {
+		bankReference = entry.Reference
+	}
+
+	batch := len(entry.Transactions) > 1
+	for _, transaction := range entry.Transactions {
+		batch = batch && transaction.Amount != nil
+	}
+	if !batch {
+		transaction := &camtTransaction{}
+		if len(entry.Transactions) == 1 {
+			transaction = entry.Transactions[0]
+		}
+		line, err := camtLine(bookedAt, entry.Amount, entry.CreditDebit, bankReference, transaction, entry.AdditionalInfo)
+		if err != nil {
+			return nil, err
+		}
+		return []*entities.BankStatementLine{line}, nil
+	}
+
+	var lines []*entities.BankStatementLine
+	for i, transaction := range entry.Transactions {
+		creditDebit := transaction.CreditDebit
+		if creditDebit == "" {
+			creditDebit = entry.CreditDebit
+		}
+		reference := transaction.ServicerRef
+		if reference == "" && bankReference != "" {
+			reference = bankReference + "/" + strconv.Itoa(i+1)
+		}
+
+		line, err := camtLine(bookedAt, *transaction.Amount, creditDebit, reference, transaction, "")
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, line)
+	}
+
+	return lines, nil
+}
+
+func camtLine(bookedAt time.Time, amount camtAmount, creditDebit string, bankReference string, transaction *camtTransaction, additionalInfo string) (*entities.BankStatementLine, error) {
+	value, err := parseAmount(amount.Value)
+	if err != nil {
+		return nil, err
+	}
+	switch creditDebit {
+	case "CRDT":
+	case "DBIT":
+		value = -value
+	default:
+		return nil, fmt.Errorf("%w: credit debit indicator %q", ParseError, creditDebit)
+	}
+
+	reference := transaction.CreditorReference
+	if reference == "" && transaction.EndToEndID != "NOTPROVIDED" {
+		reference = transaction.EndToEndID
+	}
+
+	description := strings.Join(append(transaction.Unstructured, additionalInfo), " ")
+	if transaction.DebtorName != "" {
+		description = transaction.DebtorName + " " + description
+	}
+
+	return &entities.BankStatementLine{
+		BookedAt:      bookedAt,
+		Amount:        value,
+		Currency:      amount.Currency,
+		Reference:     strings.TrimSpace(reference),
+		Description:   strings.Join(strings.Fields(description), " "),
+		BankReference: bankReference,
+	}, nil
+}
+
+func (d camtDate) parse() (time.Time, error) {
+	if d.DateTime != "" {
+		// ISO date times may leave out the offset
+		for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05"} {
+			if bookedAt, err := time.Parse(layout, d.DateTime); err == nil {
+				return bookedAt, nil
+			}
+		}
+		return time.Time{}, fmt.Errorf("%w: booking date %q", ParseError, d.DateTime)
+	}
+
+	bookedAt, err := time.Parse("2006-01-02", d.Date)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: booking date %q", ParseError, d.Date)
+	}
+	return bookedAt, nil
+}
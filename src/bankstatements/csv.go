@@ -0,0 +1,73 @@
+package bankstatements
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"github.com/verizhang/billing-engine/src/entities"
+	"strings"
+	"time"
+)
+
+const CSV_DATE_LAYOUT = "2006-01-02"
+
+// parseCSV reads a header row naming the columns, date and amount are required and a negative amount is a debit.
+// The optional columns are currency, reference, description and bank_reference
+func parseCSV(data []byte) ([]*entities.BankStatementLine, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ParseError, err.Error())
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%w: missing header row", ParseError)
+	}
+
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"date", "amount"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("%w: missing %s column", ParseError, required)
+		}
+	}
+
+	column := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var lines []*entities.BankStatementLine
+	for row, record := range records[1:] {
+		bookedAt, err := time.Parse(CSV_DATE_LAYOUT, column(record, "date"))
+		if err != nil {
+			return nil, fmt.Errorf("%w: row %d: date %q", ParseError, row+2, column(record, "date"))
+		}
+
+		value := column(record, "amount")
+		sign := float64(1)
+		if strings.HasPrefix(value, "-") {
+			sign, value = -1, value[1:]
+		}
+		amount, err := parseAmount(value)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", row+2, err)
+		}
+
+		lines = append(lines, &entities.BankStatementLine{
+			BookedAt:      bookedAt,
+			Amount:        sign * amount,
+			Currency:      column(record, "currency"),
+			Reference:     column(record, "reference"),
+			Description:   column(record, "description"),
+			BankReference: column(record, "bank_reference"),
+		})
+	}
+
+	return lines, nil
+}
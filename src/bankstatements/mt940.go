@@ -0,0 +1,103 @@
+package bankstatements
+
+import (
+	"fmt"
+	"github.com/verizhang/billing-engine/src/entities"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	mt940Tag = regexp.MustCompile(`^:(\d{2}[A-Z]?):`)
+	// mt940Entry is the first line of a :61: field: value date, optional entry date, debit/credit mark, optional funds code,
+	// amount, transaction type, customer reference and the bank reference after //
+	mt940Entry = regexp.MustCompile(`^(\d{6})(\d{4})?(RC|RD|C|D)([A-Z])?(\d+,\d*)([NFS][A-Z0-9]{3})(.*?)(?://(.*))?$`)
+	// mt940Balance is an opening balance, its currency is the currency of the entries
+	mt940Balance = regexp.MustCompile(`^[CD]\d{6}([A-Z]{3})`)
+)
+
+type mt940Field struct {
+	tag   string
+	value string
+}
+
+// parseMT940 reads the :61: entries of every statement in the file, each described by the :86: field following it
+func parseMT940(data []byte) ([]*entities.BankStatementLine, error) {
+	var lines []*entities.BankStatementLine
+	currency := ""
+	for _, field := range mt940Fields(string(data)) {
+		switch field.tag {
+		case "60F", "60M":
+			if match := mt940Balance.FindStringSubmatch(field.value); match != nil {
+				currency = match[1]
+			}
+		case "61":
+			line, err := parseMT940Entry(field.value)
+			if err != nil {
+				return nil, err
+			}
+			line.Currency = currency
+			lines = append(lines, line)
+		case "86":
+			if len(lines) > 0 && lines[len(lines)-1].Description == "" {
+				lines[len(lines)-1].Description = strings.Join(strings.Fields(field.value), " ")
+			}
+		}
+	}
+
+	return lines, nil
+}
+
+// mt940Fields splits the message into its tagged fields, the lines up to the next tag continue a field
+func mt940Fields(message string) []*mt940Field {
+	var fields []*mt940Field
+	for _, raw := range strings.Split(strings.ReplaceAll(message, "\r\n", "\n"), "\n") {
+		if match := mt940Tag.FindStringSubmatch(raw); match != nil {
+			fields = append(fields, &mt940Field{tag: match[1], value: raw[len(match[0]):]})
+			continue
+		}
+		// the block markers of the SWIFT envelope
+		if strings.HasPrefix(raw, "{") || strings.HasPrefix(raw, "-}") || raw == "-" {
+			continue
+		}
+		if len(fields) > 0 {
+			fields[len(fields)-1].value += "\n" + raw
+		}
+	}
+
+	return fields
+}
+
+func parseMT940Entry(value string) (*entities.BankStatementLine, error) {
+	first, _, _ := strings.Cut(value, "\n")
+	match := mt940Entry.FindStringSubmatch(strings.TrimSpace(first))
+	if match == nil {
+		return nil, fmt.Errorf("%w: statement line %q", ParseError, first)
+	}
+
+	bookedAt, err := time.Parse("060102", match[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: value date %q", ParseError, match[1])
+	}
+	amount, err := parseAmount(match[5])
+	if err != nil {
+		return nil, err
+	}
+	// a debit, or the reversal of a credit, takes money out of the account
+	if match[3] == "D" || match[3] == "RC" {
+		amount = -amount
+	}
+
+	reference := strings.TrimSpace(match[7])
+	if reference == "NONREF" {
+		reference = ""
+	}
+
+	return &entities.BankStatementLine{
+		BookedAt:      bookedAt,
+		Amount:        amount,
+		Reference:     reference,
+		BankReference: strings.TrimSpace(match[8]),
+	}, nil
+}
@@ -0,0 +1,52 @@
+// Package bankstatements reads the statement files of the bank into statement lines, credits positive and debits negative
+package bankstatements
+
+import (
+	"errors"
+	"fmt"
+	"github.com/verizhang/billing-engine/src/entities"
+	"strconv"
+	"strings"
+)
+
+var (
+	FormatError = errors.New("unsupported bank statement format")
+	ParseError  = errors.New("invalid bank statement")
+)
+
+// Parse reads the lines of a statement file in format, numbered from 1 in file order
+func Parse(format string, data []byte) ([]*entities.BankStatementLine, error) {
+	var lines []*entities.BankStatementLine
+	var err error
+	switch format {
+	case entities.BANK_STATEMENT_FORMAT_CSV:
+		lines, err = parseCSV(data)
+	case entities.BANK_STATEMENT_FORMAT_MT940:
+		lines, err = parseMT940(data)
+	case entities.BANK_STATEMENT_FORMAT_CAMT053:
+		lines, err = parseCAMT053(data)
+	default:
+		return nil, fmt.Errorf("%w: %q", FormatError, format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("%w: no entries", ParseError)
+	}
+
+	for i, line := range lines {
+		line.LineNo = i + 1
+	}
+	return lines, nil
+}
+
+// parseAmount reads an unsigned decimal amount with a dot or, as in MT940, a comma as separator
+func parseAmount(value string) (float64, error) {
+	value = strings.ReplaceAll(strings.TrimSpace(value), ",", ".")
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: amount %q", ParseError, value)
+	}
+	return amount, nil
+}
@@ -0,0 +1,103 @@
+package bankstatements_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verizhang/billing-engine/src/bankstatements"
+	"github.com/verizhang/billing-engine/src/entities"
+)
+
+func TestParse(t *testing.T) {
+	parse := func(t *testing.T, format string, file string) []*entities.BankStatementLine {
+		data, err := os.ReadFile("testdata/" + file)
+		assert.NoError(t, err)
+		lines, err := bankstatements.Parse(format, data)
+		assert.NoError(t, err)
+		return lines
+	}
+	bookedAt := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("success parse csv", func(t *testing.T) {
+		lines := parse(t, entities.BANK_STATEMENT_FORMAT_CSV, "statement.csv")
+
+		assert.Len(t, lines, 2)
+		assert.Equal(t, &entities.BankStatementLine{
+			LineNo:        1,
+			BookedAt:      bookedAt,
+			Amount:        550000,
+			Currency:      "IDR",
+			Reference:     "8808123456789012",
+			Description:   "Transfer from Budi",
+			BankReference: "TRX001",
+		}, lines[0])
+		assert.Equal(t, float64(-25000), lines[1].Amount)
+		assert.Equal(t, 2, lines[1].LineNo)
+	})
+
+	t.Run("success parse mt940", func(t *testing.T) {
+		lines := parse(t, entities.BANK_STATEMENT_FORMAT_MT940, "statement.mt940")
+
+		assert.Len(t, lines, 2)
+		assert.Equal(t, &entities.BankStatementLine{
+			LineNo:        1,
+			BookedAt:      bookedAt,
+			Amount:        550000,
+			Currency:      "IDR",
+			Reference:     "8808123456789012",
+			Description:   "TRANSFER FROM BUDI INSTALLMENT 3",
+			BankReference: "TRX001",
+		}, lines[0])
+		assert.Equal(t, float64(-25000), lines[1].Amount)
+		assert.Equal(t, "", lines[1].Reference)
+		assert.Equal(t, "BANK CHARGES", lines[1].Description)
+	})
+
+	t.Run("success parse camt053 with batch entries", func(t *testing.T) {
+		lines := parse(t, entities.BANK_STATEMENT_FORMAT_CAMT053, "statement.camt053.xml")
+
+		assert.Len(t, lines, 4)
+		assert.Equal(t, &entities.BankStatementLine{
+			LineNo:        1,
+			BookedAt:      bookedAt,
+			Amount:        550000,
+			Currency:      "IDR",
+			Reference:     "8808123456789012",
+			Description:   "Budi",
+			BankReference: "TRX001",
+		}, lines[0])
+		assert.Equal(t, float64(550000), lines[1].Amount)
+		assert.Equal(t, "loan 8808000000000001", lines[1].Description)
+		assert.Equal(t, "BATCH9/1", lines[1].BankReference)
+		assert.Equal(t, "TRX010", lines[2].BankReference)
+		assert.Equal(t, float64(-25000), lines[3].Amount)
+		assert.Equal(t, "Bank charges", lines[3].Description)
+	})
+
+	t.Run("error when format is unsupported", func(t *testing.T) {
+		_, err := bankstatements.Parse("bai2", []byte("01,"))
+
+		assert.True(t, errors.Is(err, bankstatements.FormatError))
+	})
+
+	t.Run("error when file is malformed", func(t *testing.T) {
+		for format, data := range map[string]string{
+			entities.BANK_STATEMENT_FORMAT_CSV:     "date,amount\n02/03/2026,100\n",
+			entities.BANK_STATEMENT_FORMAT_MT940:   ":20:STMT\n:61:garbage\n",
+			entities.BANK_STATEMENT_FORMAT_CAMT053: "<Document><BkToCstmrStmt>",
+		} {
+			_, err := bankstatements.Parse(format, []byte(data))
+
+			assert.True(t, errors.Is(err, bankstatements.ParseError), format)
+		}
+	})
+
+	t.Run("error when file has no entries", func(t *testing.T) {
+		_, err := bankstatements.Parse(entities.BANK_STATEMENT_FORMAT_CSV, []byte("date,amount\n"))
+
+		assert.True(t, errors.Is(err, bankstatements.ParseError))
+	})
+}
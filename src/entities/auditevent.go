@@ -0,0 +1,64 @@
+package entities
+
+import "time"
+
+const (
+	AUDIT_ACTION_LOAN_CREATED          = "loan.created"
+	AUDIT_ACTION_LOAN_RESTRUCTURED     = "loan.restructured"
+	AUDIT_ACTION_INSTALLMENTS_DEFERRED = "loan.installments_deferred"
+	AUDIT_ACTION_LOAN_REFINANCED       = "loan.refinanced"
+	AUDIT_ACTION_LOAN_PAID_OFF         = "loan.paid_off"
+	AUDIT_ACTION_LOAN_WRITTEN_OFF      = "loan.written_off"
+	AUDIT_ACTION_LOAN_DELINQUENT       = "loan.delinquent"
+	AUDIT_ACTION_DELINQUENCY_CLEARED   = "loan.delinquency_cleared"
+	AUDIT_ACTION_PAYMENT_MADE          = "payment.made"
+	AUDIT_ACTION_LATE_FEE_CHARGED      = "payment.late_fee_charged"
+
+	AUDIT_ACTION_WEBHOOK_SUBSCRIPTION_CREATED    = "webhook_subscription.created"
+	AUDIT_ACTION_WEBHOOK_SUBSCRIPTION_UPDATED    = "webhook_subscription.updated"
+	AUDIT_ACTION_WEBHOOK_SUBSCRIPTION_DELETED    = "webhook_subscription.deleted"
+	AUDIT_ACTION_BANK_STATEMENT_IMPORTED         = "bank_statement.imported"
+	AUDIT_ACTION_BANK_STATEMENT_LINE_DISMISSED   = "bank_statement_line.dismissed"
+	AUDIT_ACTION_NOTIFICATION_PREFERENCE_UPDATED = "notification_preference.updated"
+)
+
+const (
+	AUDIT_ENTITY_LOAN             = "loan"
+	AUDIT_ENTITY_PAYMENT          = "payment"
+	AUDIT_ENTITY_PAYMENT_SCHEDULE = "payment_schedule"
+	AUDIT_ENTITY_PAYMENT_DEFERRAL = "payment_deferral"
+	AUDIT_ENTITY_LOAN_WRITE_OFF   = "loan_write_off"
+
+	AUDIT_ENTITY_WEBHOOK_SUBSCRIPTION    = "webhook_subscription"
+	AUDIT_ENTITY_BANK_STATEMENT_IMPORT   = "bank_statement_import"
+	AUDIT_ENTITY_BANK_STATEMENT_LINE     = "bank_statement_line"
+	AUDIT_ENTITY_NOTIFICATION_PREFERENCE = "notification_preference"
+)
+
+// AuditEvent is a link of the hash chain of a loan, or of its entity when the change is outside of a loan and LoanID is nil,
+// the hash covers the event and the hash of the previous one. Before and After hold the JSON of the entity around the change, nil when it did not exist
+type AuditEvent struct {
+	ID         string    `json:"id"`
+	LoanID     *string   `json:"loan_id"`
+	Sequence   int64     `json:"sequence"`
+	Actor      string    `json:"actor"`
+	Action     string    `json:"action"`
+	EntityType string    `json:"entity_type"`
+	EntityID   string    `json:"entity_id"`
+	Before     *string   `json:"before"`
+	After      *string   `json:"after"`
+	RequestID  string    `json:"request_id"`
+	PrevHash   string    `json:"prev_hash"`
+	Hash       string    `json:"hash"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// AuditTrailVerification is the result of recomputing the chain of a loan,
+// BrokenAtSequence is the first event whose link does not hold
+type AuditTrailVerification struct {
+	LoanID           string
+	Events           int
+	Valid            bool
+	BrokenAtSequence int64
+	Reason           string
+}
@@ -0,0 +1,95 @@
+package entities
+
+import "time"
+
+const (
+	BANK_STATEMENT_FORMAT_CSV     = "csv"
+	BANK_STATEMENT_FORMAT_MT940   = "mt940"
+	BANK_STATEMENT_FORMAT_CAMT053 = "camt053"
+)
+
+func IsValidBankStatementFormat(format string) bool {
+	switch format {
+	case BANK_STATEMENT_FORMAT_CSV, BANK_STATEMENT_FORMAT_MT940, BANK_STATEMENT_FORMAT_CAMT053:
+		return true
+	}
+	return false
+}
+
+const (
+	// BANK_STATEMENT_LINE_STATUS_PENDING is a line imported but not reconciled yet, left by an interrupted import
+	BANK_STATEMENT_LINE_STATUS_PENDING   = "pending"
+	BANK_STATEMENT_LINE_STATUS_POSTED    = "posted"
+	BANK_STATEMENT_LINE_STATUS_DUPLICATE = "duplicate"
+	// BANK_STATEMENT_LINE_STATUS_EXCEPTION is the exceptions queue, the line was unmatched, ambiguous or could not be posted
+	BANK_STATEMENT_LINE_STATUS_EXCEPTION = "exception"
+	BANK_STATEMENT_LINE_STATUS_RESOLVED  = "resolved"
+	BANK_STATEMENT_LINE_STATUS_DISMISSED = "dismissed"
+	// BANK_STATEMENT_LINE_STATUS_IGNORED is a debit, only incoming transfers repay loans
+	BANK_STATEMENT_LINE_STATUS_IGNORED = "ignored"
+)
+
+// BankStatementImport is a statement file imported once, FileHash is the SHA-256 of its content
+type BankStatementImport struct {
+	ID         string    `json:"id"`
+	Format     string    `json:"format"`
+	FileName   string    `json:"file_name"`
+	FileHash   string    `json:"file_hash"`
+	Lines      int       `json:"lines"`
+	ImportedBy string    `json:"imported_by"`
+	ImportedAt time.Time `json:"imported_at"`
+}
+
+// BankStatementLine is an entry of a statement, a negative amount is a debit.
+// BankReference is the id the bank gave the entry, empty when the format carries none
+type BankStatementLine struct {
+	ID            string     `json:"id"`
+	ImportID      string     `json:"import_id"`
+	LineNo        int        `json:"line_no"`
+	BookedAt      time.Time  `json:"booked_at"`
+	Amount        float64    `json:"amount"`
+	Currency      string     `json:"currency"`
+	Reference     string     `json:"reference"`
+	Description   string     `json:"description"`
+	BankReference string     `json:"bank_reference"`
+	Status        string     `json:"status"`
+	Reason        string     `json:"reason"`
+	LoanID        *string    `json:"loan_id"`
+	ResolvedBy    string     `json:"resolved_by"`
+	ResolvedAt    *time.Time `json:"resolved_at"`
+	Note          string     `json:"note"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// BankStatementResult is an import with its lines after reconciliation
+type BankStatementResult struct {
+	Import *BankStatementImport
+	Lines  []*BankStatementLine
+}
+
+type BankStatementLinePage struct {
+	Lines         []*BankStatementLine
+	NextPageToken string
+}
+
+// ReconciliationTotal counts the lines of a status and sums their amounts
+type ReconciliationTotal struct {
+	Status string
+	Lines  int
+	Amount float64
+}
+
+// ReconciliationReport covers the lines of the statements imported in [From, To)
+type ReconciliationReport struct {
+	From    time.Time
+	To      time.Time
+	Imports int
+	Totals  []*ReconciliationTotal
+}
+
+// BankStatementLineFilter narrows the lines listed, empty fields match every line
+type BankStatementLineFilter struct {
+	ImportID string
+	Status   string
+}
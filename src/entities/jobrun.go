@@ -0,0 +1,41 @@
+package entities
+
+import "time"
+
+const (
+	JOB_DELINQUENCY_SWEEP = "delinquency_sweep"
+	JOB_LATE_FEE_ACCRUAL  = "late_fee_accrual"
+	JOB_PAYMENT_REMINDERS = "payment_reminders"
+)
+
+const (
+	JOB_RUN_STATUS_RUNNING   = "running"
+	JOB_RUN_STATUS_SUCCEEDED = "succeeded"
+	JOB_RUN_STATUS_FAILED    = "failed"
+)
+
+// JobRun is one run of a scheduled job, a job runs once per ScheduledAt whichever replica runs it.
+// Items counts what the run processed, such as the loans marked delinquent
+type JobRun struct {
+	ID          string     `json:"id"`
+	Job         string     `json:"job"`
+	ScheduledAt time.Time  `json:"scheduled_at"`
+	StartedAt   time.Time  `json:"started_at"`
+	FinishedAt  *time.Time `json:"finished_at"`
+	DurationMs  int64      `json:"duration_ms"`
+	Status      string     `json:"status"`
+	Items       int        `json:"items"`
+	Error       *string    `json:"error"`
+	Instance    string     `json:"instance"`
+}
+
+type JobRunPage struct {
+	Runs          []*JobRun
+	NextPageToken string
+}
+
+// JobRunFilter narrows the runs listed, empty fields match every run
+type JobRunFilter struct {
+	Job    string
+	Status string
+}
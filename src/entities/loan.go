@@ -3,28 +3,90 @@ package entities
 import "time"
 
 const (
-	LOAN_AMOUNT        = 5000000
-	LOAN_INTEREST_RATE = 0.10
+	LOAN_AMOUNT          = 5000000
+	LOAN_INTEREST_RATE   = 0.10
+	LOAN_PRODUCT_DEFAULT = "default"
+	// LOAN_DELINQUENT_DAYS is how long the earliest unpaid installment may stay past its end before the loan is delinquent
+	LOAN_DELINQUENT_DAYS = 14
 )
 
+const (
+	LOAN_STATUS_ACTIVE      = "active"
+	LOAN_STATUS_PAID_OFF    = "paid_off"
+	LOAN_STATUS_WRITTEN_OFF = "written_off"
+	LOAN_STATUS_REFINANCED  = "refinanced"
+)
+
+func IsValidLoanStatus(status string) bool {
+	switch status {
+	case LOAN_STATUS_ACTIVE, LOAN_STATUS_PAID_OFF, LOAN_STATUS_WRITTEN_OFF, LOAN_STATUS_REFINANCED:
+		return true
+	}
+	return false
+}
+
 type Loan struct {
-	ID        string     `json:"id"`
-	UserID    string     `json:"user_id"`
-	Amount    float64    `json:"amount"`
-	Interest  float64    `json:"interest"`
-	IsActive  bool       `json:"is_active"`
-	CreatedAt *time.Time `json:"created_at"`
-	UpdatedAt *time.Time `json:"updated_at"`
-	DeletedAt *time.Time `json:"deleted_at"`
-	CreatedBy string     `json:"created_by"`
-	UpdatedBy string     `json:"updated_by"`
-	DeletedBy string     `json:"deleted_by"`
+	ID                 string     `json:"id"`
+	UserID             string     `json:"user_id"`
+	Product            string     `json:"product"`
+	Amount             float64    `json:"amount"`
+	Interest           float64    `json:"interest"`
+	Fee                float64    `json:"fee"`
+	IsActive           bool       `json:"is_active"`
+	Status             string     `json:"status"`
+	RefinancedByLoanID *string    `json:"refinanced_by_loan_id"`
+	PaymentReference   *string    `json:"payment_reference"`
+	DelinquentAt       *time.Time `json:"delinquent_at"`
+	CreatedAt          *time.Time `json:"created_at"`
+	UpdatedAt          *time.Time `json:"updated_at"`
+	DeletedAt          *time.Time `json:"deleted_at"`
+	CreatedBy          string     `json:"created_by"`
+	UpdatedBy          string     `json:"updated_by"`
+	DeletedBy          string     `json:"deleted_by"`
 }
 
 type Outstanding struct {
+	LoanID      string
 	Outstanding float64
+	Loans       []*Outstanding
 }
 
 type IsDelinquent struct {
+	LoanID       string
 	IsDelinquent bool
+	Loans        []*IsDelinquent
+}
+
+type TopUp struct {
+	Loan            *Loan
+	Schedule        *PaymentSchedule
+	RefinancedLoan  *Loan
+	PayoffAmount    float64
+	NetDisbursement float64
+}
+
+type LoanDetail struct {
+	Loan                *Loan
+	Schedule            *PaymentSchedule
+	Outstanding         float64
+	Paid                float64
+	PaidInstallments    int
+	TotalInstallments   int
+	APR                 float64
+	EffectiveAnnualRate float64
+}
+
+type LoanPage struct {
+	Loans         []*Loan
+	NextPageToken string
+}
+
+type LoanQuote struct {
+	Schedule            *PaymentSchedule
+	Installments        []*Installment
+	InstallmentAmount   float64
+	TotalInterest       float64
+	TotalRepayable      float64
+	APR                 float64
+	EffectiveAnnualRate float64
 }
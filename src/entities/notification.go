@@ -0,0 +1,67 @@
+package entities
+
+import "time"
+
+const (
+	// NOTIFICATION_KIND_PAYMENT_UPCOMING reminds the borrower of an installment ending in the next days
+	NOTIFICATION_KIND_PAYMENT_UPCOMING = "payment_upcoming"
+	NOTIFICATION_KIND_PAYMENT_DUE      = "payment_due"
+	NOTIFICATION_KIND_PAYMENT_OVERDUE  = "payment_overdue"
+)
+
+const (
+	// NOTIFICATION_STATUS_SENDING is logged before the message goes out, a delivery left sending by a crash is not sent again
+	NOTIFICATION_STATUS_SENDING = "sending"
+	NOTIFICATION_STATUS_SENT    = "sent"
+	NOTIFICATION_STATUS_FAILED  = "failed"
+)
+
+// NotificationPreference holds how a borrower is reached, a borrower without one gets the default locale
+// and only the channels needing no address. OptOuts lists the channels the borrower turned off
+type NotificationPreference struct {
+	UserID    string    `json:"user_id" gorm:"primaryKey"`
+	Locale    string    `json:"locale"`
+	Email     string    `json:"email"`
+	Phone     string    `json:"phone"`
+	OptOuts   []string  `json:"opt_outs" gorm:"serializer:json"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (p *NotificationPreference) OptedOut(channel string) bool {
+	for _, optOut := range p.OptOuts {
+		if optOut == channel {
+			return true
+		}
+	}
+	return false
+}
+
+// NotificationDelivery is the log of one message sent, or failed to send, to a borrower through one channel
+type NotificationDelivery struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	LoanID    string    `json:"loan_id"`
+	PaymentID string    `json:"payment_id"`
+	Kind      string    `json:"kind"`
+	Channel   string    `json:"channel"`
+	Locale    string    `json:"locale"`
+	Recipient string    `json:"recipient"`
+	Subject   string    `json:"subject"`
+	Body      string    `json:"body"`
+	Status    string    `json:"status"`
+	Error     *string   `json:"error"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type NotificationDeliveryPage struct {
+	Deliveries    []*NotificationDelivery
+	NextPageToken string
+}
+
+// NotificationDeliveryFilter narrows the deliveries listed, empty fields match every delivery
+type NotificationDeliveryFilter struct {
+	UserID string
+	Kind   string
+	Status string
+}
@@ -0,0 +1,91 @@
+package entities
+
+import "time"
+
+const (
+	EVENT_LOAN_CREATED           = "LoanCreated"
+	EVENT_PAYMENT_MADE           = "PaymentMade"
+	EVENT_LOAN_PAID_OFF          = "LoanPaidOff"
+	EVENT_LOAN_BECAME_DELINQUENT = "LoanBecameDelinquent"
+	EVENT_LATE_FEE_CHARGED       = "LateFeeCharged"
+	EVENT_PAYMENT_DUE            = "PaymentDue"
+)
+
+// OutboxEvent is a domain event written in the transaction of the change it announces and published later by the relay,
+// Sequence orders the events of a loan and Payload is the JSON of the event data, an event failing too often is parked
+type OutboxEvent struct {
+	ID          string     `json:"id"`
+	Position    int64      `json:"position" gorm:"->"`
+	LoanID      string     `json:"loan_id"`
+	Sequence    int64      `json:"sequence"`
+	Type        string     `json:"type"`
+	Payload     string     `json:"payload"`
+	RequestID   string     `json:"request_id"`
+	Attempts    int        `json:"attempts"`
+	LastError   *string    `json:"last_error"`
+	CreatedAt   time.Time  `json:"created_at"`
+	PublishedAt *time.Time `json:"published_at"`
+	// LockedUntil is the lease of the relay publishing the event, or the end of the backoff after a failure
+	LockedUntil *time.Time `json:"locked_until"`
+	ParkedAt    *time.Time `json:"parked_at"`
+	// FannedOutAt is set once the webhook deliveries of the event are created
+	FannedOutAt *time.Time `json:"fanned_out_at"`
+}
+
+type LoanCreatedEvent struct {
+	LoanID    string    `json:"loanId"`
+	UserID    string    `json:"userId"`
+	Product   string    `json:"product"`
+	Amount    float64   `json:"amount"`
+	Interest  float64   `json:"interest"`
+	Fee       float64   `json:"fee"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type PaymentMadeEvent struct {
+	LoanID    string    `json:"loanId"`
+	UserID    string    `json:"userId"`
+	PaymentID string    `json:"paymentId"`
+	Amount    float64   `json:"amount"`
+	Principal float64   `json:"principal"`
+	Interest  float64   `json:"interest"`
+	Fee       float64   `json:"fee"`
+	Channel   string    `json:"channel"`
+	Recovery  bool      `json:"recovery"`
+	PaidAt    time.Time `json:"paidAt"`
+}
+
+type LoanPaidOffEvent struct {
+	LoanID    string    `json:"loanId"`
+	UserID    string    `json:"userId"`
+	PaidOffAt time.Time `json:"paidOffAt"`
+}
+
+type LoanBecameDelinquentEvent struct {
+	LoanID string `json:"loanId"`
+	UserID string `json:"userId"`
+	// OverdueSince is the end of the earliest unpaid installment
+	OverdueSince time.Time `json:"overdueSince"`
+	DaysPastDue  int       `json:"daysPastDue"`
+}
+
+type LateFeeChargedEvent struct {
+	LoanID    string  `json:"loanId"`
+	UserID    string  `json:"userId"`
+	PaymentID string  `json:"paymentId"`
+	Fee       float64 `json:"fee"`
+	// Amount is the installment including the fee
+	Amount    float64   `json:"amount"`
+	DueAt     time.Time `json:"dueAt"`
+	ChargedAt time.Time `json:"chargedAt"`
+}
+
+// PaymentDueEvent reminds the borrower of an installment ending soon
+type PaymentDueEvent struct {
+	LoanID           string    `json:"loanId"`
+	UserID           string    `json:"userId"`
+	PaymentID        string    `json:"paymentId"`
+	Amount           float64   `json:"amount"`
+	DueAt            time.Time `json:"dueAt"`
+	PaymentReference string    `json:"paymentReference,omitempty"`
+}
@@ -2,19 +2,31 @@ package entities
 
 import "time"
 
-const PAYMENT_WEEKS = 50
+const (
+	PAYMENT_WEEKS  = 50
+	WEEKS_PER_YEAR = 52
+)
 
 type Payment struct {
-	ID        string     `json:"id"`
-	LoanID    string     `json:"loan_id"`
-	Amount    float64    `json:"amount"`
-	StartAt   *time.Time `json:"start_date"`
-	EndAt     *time.Time `json:"end_date"`
-	PaidAt    *time.Time `json:"paid_at"`
-	CreatedAt *time.Time `json:"created_at"`
-	UpdatedAt *time.Time `json:"updated_at"`
-	DeletedAt *time.Time `json:"deleted_at"`
-	CreatedBy *int64     `json:"created_by"`
-	UpdatedBy *int64     `json:"updated_by"`
-	DeletedBy *int64     `json:"deleted_by"`
+	ID                   string     `json:"id"`
+	LoanID               string     `json:"loan_id"`
+	ScheduleID           string     `json:"schedule_id"`
+	Amount               float64    `json:"amount"`
+	Principal            float64    `json:"principal"`
+	Interest             float64    `json:"interest"`
+	Fee                  float64    `json:"fee"`
+	DeferralID           *string    `json:"deferral_id"`
+	ReplacedByScheduleID *string    `json:"replaced_by_schedule_id"`
+	StartAt              *time.Time `json:"start_date"`
+	EndAt                *time.Time `json:"end_date"`
+	PaidAt               *time.Time `json:"paid_at"`
+	LateFee              float64    `json:"late_fee"`
+	LateFeeAt            *time.Time `json:"late_fee_at"`
+	RemindedAt           *time.Time `json:"reminded_at"`
+	CreatedAt            *time.Time `json:"created_at"`
+	UpdatedAt            *time.Time `json:"updated_at"`
+	DeletedAt            *time.Time `json:"deleted_at"`
+	CreatedBy            string     `json:"created_by"`
+	UpdatedBy            string     `json:"updated_by"`
+	DeletedBy            string     `json:"deleted_by"`
 }
@@ -0,0 +1,19 @@
+package entities
+
+import "time"
+
+type PaymentDeferral struct {
+	ID           string     `json:"id"`
+	LoanID       string     `json:"loan_id"`
+	Installments int        `json:"installments"`
+	StartAt      *time.Time `json:"start_at"`
+	EndAt        *time.Time `json:"end_at"`
+	Fee          float64    `json:"fee"`
+	Interest     float64    `json:"interest"`
+	CreatedAt    *time.Time `json:"created_at"`
+	UpdatedAt    *time.Time `json:"updated_at"`
+	DeletedAt    *time.Time `json:"deleted_at"`
+	CreatedBy    string     `json:"created_by"`
+	UpdatedBy    string     `json:"updated_by"`
+	DeletedBy    string     `json:"deleted_by"`
+}
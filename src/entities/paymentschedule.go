@@ -0,0 +1,26 @@
+package entities
+
+import "time"
+
+type PaymentSchedule struct {
+	ID                 string     `json:"id"`
+	LoanID             string     `json:"loan_id"`
+	Version            int        `json:"version"`
+	PreviousScheduleID *string    `json:"previous_schedule_id"`
+	Principal          float64    `json:"principal"`
+	Interest           float64    `json:"interest"`
+	InterestRate       float64    `json:"interest_rate"`
+	Tenor              int        `json:"tenor"`
+	CreatedAt          *time.Time `json:"created_at"`
+	UpdatedAt          *time.Time `json:"updated_at"`
+	DeletedAt          *time.Time `json:"deleted_at"`
+	CreatedBy          string     `json:"created_by"`
+	UpdatedBy          string     `json:"updated_by"`
+	DeletedBy          string     `json:"deleted_by"`
+}
+
+type RestructureTerms struct {
+	Tenor             int
+	InterestRate      *float64
+	CapitaliseArrears bool
+}
@@ -0,0 +1,65 @@
+package entities
+
+import "time"
+
+const (
+	PAYMENT_CHANNEL_API    = "api"
+	PAYMENT_CHANNEL_LEGACY = "legacy"
+	// PAYMENT_CHANNEL_PROVIDER is money notified by a payment provider, the reference is <provider>:<provider payment id>
+	PAYMENT_CHANNEL_PROVIDER = "provider"
+	// PAYMENT_CHANNEL_BANK_TRANSFER is money found on a bank statement, the reference is statement:<line key>
+	PAYMENT_CHANNEL_BANK_TRANSFER = "bank_transfer"
+)
+
+type PaymentTransaction struct {
+	ID          string               `json:"id"`
+	LoanID      string               `json:"loan_id"`
+	UserID      string               `json:"user_id"`
+	Amount      float64              `json:"amount"`
+	Channel     string               `json:"channel"`
+	Reference   *string              `json:"reference"`
+	PaidAt      *time.Time           `json:"paid_at"`
+	ReversedAt  *time.Time           `json:"reversed_at"`
+	Allocations []*PaymentAllocation `json:"allocations" gorm:"-"`
+	CreatedAt   *time.Time           `json:"created_at"`
+	UpdatedAt   *time.Time           `json:"updated_at"`
+	DeletedAt   *time.Time           `json:"deleted_at"`
+	CreatedBy   string               `json:"created_by"`
+	UpdatedBy   string               `json:"updated_by"`
+	DeletedBy   string               `json:"deleted_by"`
+}
+
+type PaymentAllocation struct {
+	ID            string     `json:"id"`
+	TransactionID string     `json:"transaction_id"`
+	PaymentID     string     `json:"payment_id"`
+	Amount        float64    `json:"amount"`
+	Principal     float64    `json:"principal"`
+	Interest      float64    `json:"interest"`
+	Fee           float64    `json:"fee"`
+	CreatedAt     *time.Time `json:"created_at"`
+	UpdatedAt     *time.Time `json:"updated_at"`
+	DeletedAt     *time.Time `json:"deleted_at"`
+	CreatedBy     string     `json:"created_by"`
+	UpdatedBy     string     `json:"updated_by"`
+	DeletedBy     string     `json:"deleted_by"`
+}
+
+// PaymentTransactionFilter selects the transactions of a loan or of every loan of a user paid within [From, To)
+type PaymentTransactionFilter struct {
+	LoanID string
+	UserID string
+	From   *time.Time
+	To     *time.Time
+}
+
+type PaymentTransactionPage struct {
+	Transactions  []*PaymentTransaction
+	NextPageToken string
+}
+
+// ExternalPaymentResult is the posting of money received outside the API, Duplicate when it was posted before
+type ExternalPaymentResult struct {
+	LoanID    string
+	Duplicate bool
+}
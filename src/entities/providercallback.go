@@ -0,0 +1,37 @@
+package entities
+
+import "time"
+
+const (
+	PROVIDER_CALLBACK_STATUS_POSTED    = "posted"
+	PROVIDER_CALLBACK_STATUS_DUPLICATE = "duplicate"
+	PROVIDER_CALLBACK_STATUS_UNMATCHED = "unmatched"
+	PROVIDER_CALLBACK_STATUS_REJECTED  = "rejected"
+)
+
+// ProviderPayment is a payment notified by a provider, ExternalID identifies it at the provider
+// and VirtualAccount or Reference tell the loan it pays
+type ProviderPayment struct {
+	Provider       string
+	ExternalID     string
+	VirtualAccount string
+	Reference      string
+	Amount         float64
+	PaidAt         time.Time
+}
+
+// ProviderCallback is a verified provider notification and what became of it
+type ProviderCallback struct {
+	ID             string    `json:"id"`
+	Provider       string    `json:"provider"`
+	ExternalID     string    `json:"external_id"`
+	LoanID         *string   `json:"loan_id"`
+	VirtualAccount string    `json:"virtual_account"`
+	Reference      string    `json:"reference"`
+	Amount         float64   `json:"amount"`
+	Status         string    `json:"status"`
+	Reason         string    `json:"reason"`
+	Payload        string    `json:"payload"`
+	RequestID      string    `json:"request_id"`
+	ReceivedAt     time.Time `json:"received_at"`
+}
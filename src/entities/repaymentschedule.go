@@ -0,0 +1,26 @@
+package entities
+
+import "time"
+
+const (
+	INSTALLMENT_STATUS_UPCOMING = "upcoming"
+	INSTALLMENT_STATUS_DUE      = "due"
+	INSTALLMENT_STATUS_OVERDUE  = "overdue"
+	INSTALLMENT_STATUS_PAID     = "paid"
+)
+
+type Installment struct {
+	Sequence   int
+	Payment    *Payment
+	DueAt      *time.Time
+	AmountPaid float64
+	Status     string
+}
+
+type RepaymentSchedule struct {
+	LoanID              string
+	Installments        []*Installment
+	PaidInstallments    int
+	OverdueInstallments int
+	NextDue             *Installment
+}
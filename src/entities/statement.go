@@ -0,0 +1,33 @@
+package entities
+
+import "time"
+
+const (
+	STATEMENT_ENTRY_CHARGE   = "charge"
+	STATEMENT_ENTRY_FEE      = "fee"
+	STATEMENT_ENTRY_PAYMENT  = "payment"
+	STATEMENT_ENTRY_REVERSAL = "reversal"
+)
+
+const (
+	STATEMENT_FORMAT_CSV = "csv"
+	STATEMENT_FORMAT_PDF = "pdf"
+)
+
+type StatementEntry struct {
+	Date        *time.Time
+	Type        string
+	Description string
+	Reference   string
+	Amount      float64
+	Balance     float64
+}
+
+type Statement struct {
+	Loan           *Loan
+	From           time.Time
+	To             time.Time
+	OpeningBalance float64
+	ClosingBalance float64
+	Entries        []*StatementEntry
+}
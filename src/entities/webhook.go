@@ -0,0 +1,75 @@
+package entities
+
+import "time"
+
+const (
+	WEBHOOK_DELIVERY_STATUS_PENDING   = "pending"
+	WEBHOOK_DELIVERY_STATUS_DELIVERED = "delivered"
+	// WEBHOOK_DELIVERY_STATUS_DEAD is the dead-letter queue, the delivery ran out of attempts and waits for a redelivery
+	WEBHOOK_DELIVERY_STATUS_DEAD = "dead"
+)
+
+func IsValidEventType(eventType string) bool {
+	switch eventType {
+	case EVENT_LOAN_CREATED, EVENT_PAYMENT_MADE, EVENT_LOAN_PAID_OFF, EVENT_LOAN_BECAME_DELINQUENT, EVENT_LATE_FEE_CHARGED, EVENT_PAYMENT_DUE:
+		return true
+	}
+	return false
+}
+
+type WebhookSubscription struct {
+	ID         string     `json:"id"`
+	URL        string     `json:"url"`
+	EventTypes []string   `json:"event_types" gorm:"serializer:json"`
+	Secret     string     `json:"-"`
+	IsActive   bool       `json:"is_active"`
+	CreatedAt  *time.Time `json:"created_at"`
+	UpdatedAt  *time.Time `json:"updated_at"`
+	DeletedAt  *time.Time `json:"deleted_at"`
+	CreatedBy  string     `json:"created_by"`
+	UpdatedBy  string     `json:"updated_by"`
+	DeletedBy  string     `json:"deleted_by"`
+}
+
+func (s *WebhookSubscription) Subscribes(eventType string) bool {
+	for _, subscribed := range s.EventTypes {
+		if subscribed == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookSubscriptionUpdate holds the fields to change, nil keeps the current value
+type WebhookSubscriptionUpdate struct {
+	URL        *string
+	EventTypes []string
+	Secret     *string
+	IsActive   *bool
+}
+
+// WebhookDelivery is the delivery of one outbox event to one subscription, retried until NextAttemptAt while pending
+type WebhookDelivery struct {
+	ID             string     `json:"id"`
+	SubscriptionID string     `json:"subscription_id"`
+	EventID        string     `json:"event_id"`
+	EventType      string     `json:"event_type"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  time.Time  `json:"next_attempt_at"`
+	LastStatusCode *int       `json:"last_status_code"`
+	LastError      *string    `json:"last_error"`
+	DeliveredAt    *time.Time `json:"delivered_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+type WebhookDeliveryFilter struct {
+	SubscriptionID string
+	Status         string
+}
+
+type WebhookDeliveryPage struct {
+	Deliveries    []*WebhookDelivery
+	NextPageToken string
+}
@@ -0,0 +1,49 @@
+package entities
+
+import "time"
+
+const (
+	WRITE_OFF_REASON_MANUAL = "manual"
+	WRITE_OFF_REASON_POLICY = "policy"
+)
+
+type LoanWriteOff struct {
+	ID           string     `json:"id"`
+	LoanID       string     `json:"loan_id"`
+	Principal    float64    `json:"principal"`
+	Interest     float64    `json:"interest"`
+	Fee          float64    `json:"fee"`
+	DaysPastDue  int        `json:"days_past_due"`
+	Reason       string     `json:"reason"`
+	WrittenOffAt *time.Time `json:"written_off_at"`
+	CreatedAt    *time.Time `json:"created_at"`
+	UpdatedAt    *time.Time `json:"updated_at"`
+	DeletedAt    *time.Time `json:"deleted_at"`
+	CreatedBy    string     `json:"created_by"`
+	UpdatedBy    string     `json:"updated_by"`
+	DeletedBy    string     `json:"deleted_by"`
+}
+
+type LoanRecovery struct {
+	ID          string     `json:"id"`
+	LoanID      string     `json:"loan_id"`
+	WriteOffID  string     `json:"write_off_id"`
+	PaymentID   string     `json:"payment_id"`
+	Amount      float64    `json:"amount"`
+	RecoveredAt *time.Time `json:"recovered_at"`
+	CreatedAt   *time.Time `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at"`
+	CreatedBy   string     `json:"created_by"`
+	UpdatedBy   string     `json:"updated_by"`
+	DeletedBy   string     `json:"deleted_by"`
+}
+
+type RecoveriesReport struct {
+	WrittenOffPrincipal float64
+	WrittenOffInterest  float64
+	WrittenOffFee       float64
+	Recovered           float64
+	WriteOffs           []*LoanWriteOff
+	Recoveries          []*LoanRecovery
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	auditv1 "github.com/verizhang/billing-engine/contracts/pb/billing/audit/v1"
+	"github.com/verizhang/billing-engine/src/services"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AuditHandler struct {
+	auditv1.UnimplementedAuditServiceServer
+	svc services.AuditService
+}
+
+func NewAuditHandler(svc services.AuditService) *AuditHandler {
+	return &AuditHandler{
+		svc: svc,
+	}
+}
+
+func (h *AuditHandler) ListAuditEvents(ctx context.Context, req *auditv1.ListAuditEventsRequest) (*auditv1.ListAuditEventsResponse, error) {
+	resp, err := h.svc.ListAuditEvents(ctx, req.LoanId)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	result := &auditv1.ListAuditEventsResponse{}
+	for _, event := range resp {
+		item := &auditv1.AuditEvent{
+			Id:         event.ID,
+			Sequence:   event.Sequence,
+			Actor:      event.Actor,
+			Action:     event.Action,
+			EntityType: event.EntityType,
+			EntityId:   event.EntityID,
+			RequestId:  event.RequestID,
+			PrevHash:   event.PrevHash,
+			Hash:       event.Hash,
+			CreatedAt:  timestamppb.New(event.CreatedAt),
+		}
+		if event.LoanID != nil {
+			item.LoanId = *event.LoanID
+		}
+		if event.Before != nil {
+			item.Before = *event.Before
+		}
+		if event.After != nil {
+			item.After = *event.After
+		}
+		result.Events = append(result.Events, item)
+	}
+
+	return result, nil
+}
+
+func (h *AuditHandler) VerifyAuditTrail(ctx context.Context, req *auditv1.VerifyAuditTrailRequest) (*auditv1.VerifyAuditTrailResponse, error) {
+	resp, err := h.svc.VerifyAuditTrail(ctx, req.LoanId)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	return &auditv1.VerifyAuditTrailResponse{
+		LoanId:           resp.LoanID,
+		Valid:            resp.Valid,
+		Events:           int32(resp.Events),
+		BrokenAtSequence: resp.BrokenAtSequence,
+		Reason:           resp.Reason,
+	}, nil
+}
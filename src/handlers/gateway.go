@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/verizhang/billing-engine/src/utils/requestid"
+	"net/textproto"
+)
+
+// IncomingHeader forwards the X-Request-Id header to the gRPC metadata next to the headers the gateway forwards by default
+func IncomingHeader(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == textproto.CanonicalMIMEHeaderKey(requestid.HEADER) {
+		return requestid.HEADER, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// OutgoingHeader returns the request id as the X-Request-Id header, other metadata keeps the Grpc-Metadata- prefix
+func OutgoingHeader(key string) (string, bool) {
+	if key == requestid.HEADER {
+		return textproto.CanonicalMIMEHeaderKey(requestid.HEADER), true
+	}
+
+	return runtime.MetadataHeaderPrefix + key, true
+}
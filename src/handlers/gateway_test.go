@@ -0,0 +1,29 @@
+package handlers_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verizhang/billing-engine/src/handlers"
+)
+
+func TestGatewayHeaders(t *testing.T) {
+	t.Run("forwards the request id both ways", func(t *testing.T) {
+		key, ok := handlers.IncomingHeader("X-Request-Id")
+		assert.True(t, ok)
+		assert.Equal(t, "x-request-id", key)
+
+		key, ok = handlers.OutgoingHeader("x-request-id")
+		assert.True(t, ok)
+		assert.Equal(t, "X-Request-Id", key)
+	})
+
+	t.Run("keeps the default mapping of other headers", func(t *testing.T) {
+		_, ok := handlers.IncomingHeader("X-Custom")
+		assert.False(t, ok)
+
+		key, ok := handlers.OutgoingHeader("custom")
+		assert.True(t, ok)
+		assert.Equal(t, "Grpc-Metadata-custom", key)
+	})
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+	jobv1 "github.com/verizhang/billing-engine/contracts/pb/billing/job/v1"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/services"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type JobHandler struct {
+	jobv1.UnimplementedJobServiceServer
+	svc services.JobService
+}
+
+func NewJobHandler(svc services.JobService) *JobHandler {
+	return &JobHandler{
+		svc: svc,
+	}
+}
+
+func (h *JobHandler) ListJobRuns(ctx context.Context, req *jobv1.ListJobRunsRequest) (*jobv1.ListJobRunsResponse, error) {
+	filter := &entities.JobRunFilter{
+		Job:    req.Job,
+		Status: req.Status,
+	}
+
+	resp, err := h.svc.ListJobRuns(ctx, filter, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	result := &jobv1.ListJobRunsResponse{NextPageToken: resp.NextPageToken}
+	for _, run := range resp.Runs {
+		result.Runs = append(result.Runs, toJobRun(run))
+	}
+
+	return result, nil
+}
+
+func toJobRun(run *entities.JobRun) *jobv1.JobRun {
+	result := &jobv1.JobRun{
+		RunId:       run.ID,
+		Job:         run.Job,
+		ScheduledAt: timestamppb.New(run.ScheduledAt),
+		StartedAt:   timestamppb.New(run.StartedAt),
+		DurationMs:  run.DurationMs,
+		Status:      run.Status,
+		Items:       int32(run.Items),
+		Instance:    run.Instance,
+	}
+	if run.FinishedAt != nil {
+		result.FinishedAt = timestamppb.New(*run.FinishedAt)
+	}
+	if run.Error != nil {
+		result.Error = *run.Error
+	}
+
+	return result
+}
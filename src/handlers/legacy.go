@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strings"
+)
+
+// legacyRoutePrefixes are the REST roots served before the API was versioned
+var legacyRoutePrefixes = []string{"/loan", "/payment", "/write-off"}
+
+// LegacyRoutes serves the unversioned REST routes by forwarding them to their /v1 successor,
+// marking the response as deprecated so clients know to migrate
+func LegacyRoutes(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, prefix := range legacyRoutePrefixes {
+			if r.URL.Path != prefix && !strings.HasPrefix(r.URL.Path, prefix+"/") {
+				continue
+			}
+
+			r.URL.Path = "/v1" + r.URL.Path
+			if r.URL.RawPath != "" {
+				r.URL.RawPath = "/v1" + r.URL.RawPath
+			}
+			w.Header().Set("Deprecation", "true")
+			w.Header().Set("Link", "<"+r.URL.Path+">; rel=\"successor-version\"")
+			break
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+type legacyService struct {
+	desc *grpc.ServiceDesc
+	impl any
+}
+
+// LegacyServices serves the gRPC service names of the unversioned contracts with the v1 handlers,
+// the messages kept their field numbers so the old requests decode into the v1 types
+type LegacyServices struct {
+	interceptor grpc.UnaryServerInterceptor
+	services    map[string]legacyService
+}
+
+func NewLegacyServices(interceptor grpc.UnaryServerInterceptor) *LegacyServices {
+	return &LegacyServices{
+		interceptor: interceptor,
+		services:    map[string]legacyService{},
+	}
+}
+
+// Register serves the legacy service name with the methods of the v1 service desc
+func (l *LegacyServices) Register(name string, desc *grpc.ServiceDesc, impl any) {
+	l.services[name] = legacyService{desc: desc, impl: impl}
+}
+
+// Handle is the unknown service handler of the gRPC server
+func (l *LegacyServices) Handle(_ any, stream grpc.ServerStream) error {
+	fullMethod, ok := grpc.MethodFromServerStream(stream)
+	if !ok {
+		return status.Error(codes.Internal, "method not found in stream")
+	}
+
+	name, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	service, ok := l.services[name]
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "unknown service %s", name)
+	}
+
+	for _, desc := range service.desc.Methods {
+		if desc.MethodName != method {
+			continue
+		}
+
+		resp, err := desc.Handler(service.impl, stream.Context(), stream.RecvMsg, l.interceptor)
+		if err != nil {
+			return err
+		}
+
+		return stream.SendMsg(resp)
+	}
+
+	return status.Errorf(codes.Unimplemented, "unknown method %s for service %s", method, name)
+}
@@ -0,0 +1,102 @@
+package handlers_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	loanv1 "github.com/verizhang/billing-engine/contracts/pb/billing/loan/v1"
+	"github.com/verizhang/billing-engine/src/handlers"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type quoteServer struct {
+	loanv1.UnimplementedLoanServiceServer
+}
+
+func (s *quoteServer) QuoteLoan(ctx context.Context, req *loanv1.QuoteLoanRequest) (*loanv1.QuoteLoanResponse, error) {
+	return &loanv1.QuoteLoanResponse{Principal: req.Amount, Tenor: req.Tenor}, nil
+}
+
+func TestLegacyRoutes(t *testing.T) {
+	var path string
+	handler := handlers.LegacyRoutes(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+	}))
+
+	t.Run("forwards legacy route to v1", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/loan/quote?amount=1000", nil))
+
+		assert.Equal(t, "/v1/loan/quote", path)
+		assert.Equal(t, "true", rec.Header().Get("Deprecation"))
+	})
+
+	t.Run("forwards legacy root", func(t *testing.T) {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/payment", nil))
+
+		assert.Equal(t, "/v1/payment", path)
+	})
+
+	t.Run("leaves v1 and unrelated routes untouched", func(t *testing.T) {
+		for _, route := range []string{"/v1/loan/quote", "/loans", "/write-offs"} {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, route, nil))
+
+			assert.Equal(t, route, path)
+			assert.Empty(t, rec.Header().Get("Deprecation"))
+		}
+	})
+}
+
+func TestLegacyServices(t *testing.T) {
+	var fullMethod string
+	legacy := handlers.NewLegacyServices(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		fullMethod = info.FullMethod
+		return handler(ctx, req)
+	})
+	server := &quoteServer{}
+	legacy.Register("loan.loan", &loanv1.LoanService_ServiceDesc, server)
+
+	grpcServer := grpc.NewServer(grpc.UnknownServiceHandler(legacy.Handle))
+	loanv1.RegisterLoanServiceServer(grpcServer, server)
+	lis := bufconn.Listen(1024 * 1024)
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	t.Run("serves legacy method with v1 handler", func(t *testing.T) {
+		resp := &loanv1.QuoteLoanResponse{}
+		err := conn.Invoke(context.Background(), "/loan.loan/QuoteLoan", &loanv1.QuoteLoanRequest{Amount: 1000, Tenor: 10}, resp)
+
+		assert.NoError(t, err)
+		assert.Equal(t, float32(1000), resp.Principal)
+		assert.Equal(t, int32(10), resp.Tenor)
+		assert.Equal(t, loanv1.LoanService_QuoteLoan_FullMethodName, fullMethod)
+	})
+
+	t.Run("unknown legacy method", func(t *testing.T) {
+		err := conn.Invoke(context.Background(), "/loan.loan/Unknown", &loanv1.QuoteLoanRequest{}, &loanv1.QuoteLoanResponse{})
+
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	t.Run("unknown legacy service", func(t *testing.T) {
+		err := conn.Invoke(context.Background(), "/loan.unknown/QuoteLoan", &loanv1.QuoteLoanRequest{}, &loanv1.QuoteLoanResponse{})
+
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
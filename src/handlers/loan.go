@@ -2,14 +2,16 @@ package handlers
 
 import (
 	"context"
-	loanpb "github.com/verizhang/billing-engine/contracts/pb/loan"
+	loanv1 "github.com/verizhang/billing-engine/contracts/pb/billing/loan/v1"
+	"github.com/verizhang/billing-engine/src/entities"
 	"github.com/verizhang/billing-engine/src/services"
 	"github.com/verizhang/billing-engine/src/utils/errorhandler"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type LoanHandler struct {
-	loanpb.UnimplementedLoanServer
+	loanv1.UnimplementedLoanServiceServer
 	svc services.LoanService
 }
 
@@ -19,7 +21,7 @@ func NewLoanHandler(svc services.LoanService) *LoanHandler {
 	}
 }
 
-func (h *LoanHandler) CreateLoan(ctx context.Context, req *loanpb.CreateLoanRequest) (*emptypb.Empty, error) {
+func (h *LoanHandler) CreateLoan(ctx context.Context, req *loanv1.CreateLoanRequest) (*emptypb.Empty, error) {
 	err := h.svc.CreateLoan(ctx, req.UserId)
 	if err != nil {
 		return nil, errorhandler.TranslateTogRPCError(err)
@@ -28,20 +30,251 @@ func (h *LoanHandler) CreateLoan(ctx context.Context, req *loanpb.CreateLoanRequ
 	return &emptypb.Empty{}, nil
 }
 
-func (h *LoanHandler) GetOutstanding(ctx context.Context, req *loanpb.GetOutstandingRequest) (*loanpb.GetOutstandingResponse, error) {
+func (h *LoanHandler) QuoteLoan(ctx context.Context, req *loanv1.QuoteLoanRequest) (*loanv1.QuoteLoanResponse, error) {
+	resp, err := h.svc.QuoteLoan(ctx, float64(req.Amount), int(req.Tenor))
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	result := &loanv1.QuoteLoanResponse{
+		Principal:           float32(resp.Schedule.Principal),
+		Tenor:               int32(resp.Schedule.Tenor),
+		InterestRate:        resp.Schedule.InterestRate,
+		InstallmentAmount:   float32(resp.InstallmentAmount),
+		TotalInterest:       float32(resp.TotalInterest),
+		TotalRepayable:      float32(resp.TotalRepayable),
+		Apr:                 resp.APR,
+		EffectiveAnnualRate: resp.EffectiveAnnualRate,
+	}
+	for _, installment := range resp.Installments {
+		result.Installments = append(result.Installments, toInstallment(installment))
+	}
+
+	return result, nil
+}
+
+func (h *LoanHandler) GetLoan(ctx context.Context, req *loanv1.GetLoanRequest) (*loanv1.GetLoanResponse, error) {
+	resp, err := h.svc.GetLoan(ctx, req.UserId, req.LoanId)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	return &loanv1.GetLoanResponse{
+		Loan:                toLoan(resp.Loan),
+		Outstanding:         float32(resp.Outstanding),
+		Paid:                float32(resp.Paid),
+		PaidInstallments:    int32(resp.PaidInstallments),
+		TotalInstallments:   int32(resp.TotalInstallments),
+		ScheduleVersion:     int32(resp.Schedule.Version),
+		InterestRate:        resp.Schedule.InterestRate,
+		Apr:                 resp.APR,
+		EffectiveAnnualRate: resp.EffectiveAnnualRate,
+	}, nil
+}
+
+func (h *LoanHandler) ListLoans(ctx context.Context, req *loanv1.ListLoansRequest) (*loanv1.ListLoansResponse, error) {
+	resp, err := h.svc.ListLoans(ctx, req.UserId, req.Status, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	result := &loanv1.ListLoansResponse{NextPageToken: resp.NextPageToken}
+	for _, loan := range resp.Loans {
+		result.Loans = append(result.Loans, toLoan(loan))
+	}
+
+	return result, nil
+}
+
+func (h *LoanHandler) GetOutstanding(ctx context.Context, req *loanv1.GetOutstandingRequest) (*loanv1.GetOutstandingResponse, error) {
 	resp, err := h.svc.GetOutstanding(ctx, req.UserId)
 	if err != nil {
 		return nil, errorhandler.TranslateTogRPCError(err)
 	}
 
-	return &loanpb.GetOutstandingResponse{Outstanding: float32(resp.Outstanding)}, nil
+	return toGetOutstandingResponse(resp), nil
 }
 
-func (h *LoanHandler) IsDelinquent(ctx context.Context, req *loanpb.GetIsDelinquentRequest) (*loanpb.GetIsDelinquentResponse, error) {
+func (h *LoanHandler) GetLoanOutstanding(ctx context.Context, req *loanv1.GetLoanOutstandingRequest) (*loanv1.GetOutstandingResponse, error) {
+	resp, err := h.svc.GetOutstandingByLoanID(ctx, req.UserId, req.LoanId)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	return toGetOutstandingResponse(&entities.Outstanding{
+		Outstanding: resp.Outstanding,
+		Loans:       []*entities.Outstanding{resp},
+	}), nil
+}
+
+func (h *LoanHandler) GetRepaymentSchedule(ctx context.Context, req *loanv1.GetRepaymentScheduleRequest) (*loanv1.GetRepaymentScheduleResponse, error) {
+	resp, err := h.svc.GetRepaymentSchedule(ctx, req.UserId, req.LoanId)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	result := &loanv1.GetRepaymentScheduleResponse{
+		LoanId: resp.LoanID,
+		Summary: &loanv1.RepaymentScheduleSummary{
+			TotalInstallments:   int32(len(resp.Installments)),
+			PaidInstallments:    int32(resp.PaidInstallments),
+			OverdueInstallments: int32(resp.OverdueInstallments),
+		},
+	}
+	for _, installment := range resp.Installments {
+		result.Installments = append(result.Installments, toInstallment(installment))
+	}
+	if resp.NextDue != nil {
+		result.Summary.NextDue = toInstallment(resp.NextDue)
+	}
+
+	return result, nil
+}
+
+func (h *LoanHandler) IsDelinquent(ctx context.Context, req *loanv1.GetIsDelinquentRequest) (*loanv1.GetIsDelinquentResponse, error) {
 	resp, err := h.svc.IsDelinquent(ctx, req.UserId)
 	if err != nil {
 		return nil, errorhandler.TranslateTogRPCError(err)
 	}
 
-	return &loanpb.GetIsDelinquentResponse{IsDelinquent: resp.IsDelinquent}, nil
+	return toGetIsDelinquentResponse(resp), nil
+}
+
+func (h *LoanHandler) IsLoanDelinquent(ctx context.Context, req *loanv1.GetLoanIsDelinquentRequest) (*loanv1.GetIsDelinquentResponse, error) {
+	resp, err := h.svc.IsDelinquentByLoanID(ctx, req.UserId, req.LoanId)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	return toGetIsDelinquentResponse(&entities.IsDelinquent{
+		IsDelinquent: resp.IsDelinquent,
+		Loans:        []*entities.IsDelinquent{resp},
+	}), nil
+}
+
+func (h *LoanHandler) RestructureLoan(ctx context.Context, req *loanv1.RestructureLoanRequest) (*loanv1.RestructureLoanResponse, error) {
+	resp, err := h.svc.RestructureLoan(ctx, req.UserId, req.LoanId, &entities.RestructureTerms{
+		Tenor:             int(req.Tenor),
+		InterestRate:      req.InterestRate,
+		CapitaliseArrears: req.CapitaliseArrears,
+	})
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	previousScheduleID := ""
+	if resp.PreviousScheduleID != nil {
+		previousScheduleID = *resp.PreviousScheduleID
+	}
+
+	return &loanv1.RestructureLoanResponse{
+		ScheduleId:         resp.ID,
+		Version:            int32(resp.Version),
+		PreviousScheduleId: previousScheduleID,
+		Principal:          float32(resp.Principal),
+		Interest:           float32(resp.Interest),
+		InterestRate:       resp.InterestRate,
+		Tenor:              int32(resp.Tenor),
+		InstallmentAmount:  float32((resp.Principal + resp.Interest) / float64(resp.Tenor)),
+	}, nil
+}
+
+func (h *LoanHandler) DeferInstallments(ctx context.Context, req *loanv1.DeferInstallmentsRequest) (*loanv1.DeferInstallmentsResponse, error) {
+	resp, err := h.svc.DeferInstallments(ctx, req.UserId, req.LoanId, int(req.Installments))
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	return &loanv1.DeferInstallmentsResponse{
+		DeferralId:   resp.ID,
+		Installments: int32(resp.Installments),
+		StartAt:      timestamppb.New(*resp.StartAt),
+		EndAt:        timestamppb.New(*resp.EndAt),
+		Fee:          float32(resp.Fee),
+		Interest:     float32(resp.Interest),
+	}, nil
+}
+
+func (h *LoanHandler) TopUpLoan(ctx context.Context, req *loanv1.TopUpLoanRequest) (*loanv1.TopUpLoanResponse, error) {
+	resp, err := h.svc.TopUpLoan(ctx, req.UserId, req.LoanId, float64(req.Amount))
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	return &loanv1.TopUpLoanResponse{
+		LoanId:            resp.Loan.ID,
+		RefinancedLoanId:  resp.RefinancedLoan.ID,
+		Principal:         float32(resp.Loan.Amount),
+		Interest:          float32(resp.Loan.Interest),
+		PayoffAmount:      float32(resp.PayoffAmount),
+		NetDisbursement:   float32(resp.NetDisbursement),
+		Tenor:             int32(resp.Schedule.Tenor),
+		InstallmentAmount: float32((resp.Schedule.Principal + resp.Schedule.Interest) / float64(resp.Schedule.Tenor)),
+	}, nil
+}
+
+func toLoan(loan *entities.Loan) *loanv1.Loan {
+	result := &loanv1.Loan{
+		LoanId:   loan.ID,
+		UserId:   loan.UserID,
+		Product:  loan.Product,
+		Status:   loan.Status,
+		Amount:   float32(loan.Amount),
+		Interest: float32(loan.Interest),
+		Fee:      float32(loan.Fee),
+	}
+	if loan.RefinancedByLoanID != nil {
+		result.RefinancedByLoanId = *loan.RefinancedByLoanID
+	}
+	if loan.PaymentReference != nil {
+		result.PaymentReference = *loan.PaymentReference
+	}
+	if loan.CreatedAt != nil {
+		result.CreatedAt = timestamppb.New(*loan.CreatedAt)
+	}
+
+	return result
+}
+
+func toInstallment(installment *entities.Installment) *loanv1.Installment {
+	payment := installment.Payment
+	result := &loanv1.Installment{
+		Sequence:   int32(installment.Sequence),
+		PaymentId:  payment.ID,
+		StartAt:    timestamppb.New(*payment.StartAt),
+		EndAt:      timestamppb.New(*payment.EndAt),
+		DueAt:      timestamppb.New(*installment.DueAt),
+		Amount:     float32(payment.Amount),
+		AmountPaid: float32(installment.AmountPaid),
+		Status:     installment.Status,
+	}
+	if payment.PaidAt != nil {
+		result.PaidAt = timestamppb.New(*payment.PaidAt)
+	}
+
+	return result
+}
+
+func toGetOutstandingResponse(outstanding *entities.Outstanding) *loanv1.GetOutstandingResponse {
+	resp := &loanv1.GetOutstandingResponse{Outstanding: float32(outstanding.Outstanding)}
+	for _, loan := range outstanding.Loans {
+		resp.Loans = append(resp.Loans, &loanv1.LoanOutstanding{
+			LoanId:      loan.LoanID,
+			Outstanding: float32(loan.Outstanding),
+		})
+	}
+
+	return resp
+}
+
+func toGetIsDelinquentResponse(isDelinquent *entities.IsDelinquent) *loanv1.GetIsDelinquentResponse {
+	resp := &loanv1.GetIsDelinquentResponse{IsDelinquent: isDelinquent.IsDelinquent}
+	for _, loan := range isDelinquent.Loans {
+		resp.Loans = append(resp.Loans, &loanv1.LoanIsDelinquent{
+			LoanId:       loan.LoanID,
+			IsDelinquent: loan.IsDelinquent,
+		})
+	}
+
+	return resp
 }
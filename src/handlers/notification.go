@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"context"
+	notificationv1 "github.com/verizhang/billing-engine/contracts/pb/billing/notification/v1"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/services"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type NotificationHandler struct {
+	notificationv1.UnimplementedNotificationServiceServer
+	svc services.NotificationService
+}
+
+func NewNotificationHandler(svc services.NotificationService) *NotificationHandler {
+	return &NotificationHandler{
+		svc: svc,
+	}
+}
+
+func (h *NotificationHandler) GetNotificationPreferences(ctx context.Context, req *notificationv1.GetNotificationPreferencesRequest) (*notificationv1.NotificationPreferences, error) {
+	resp, err := h.svc.GetNotificationPreference(ctx, req.UserId)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	return toNotificationPreferences(resp), nil
+}
+
+func (h *NotificationHandler) UpdateNotificationPreferences(ctx context.Context, req *notificationv1.UpdateNotificationPreferencesRequest) (*notificationv1.NotificationPreferences, error) {
+	resp, err := h.svc.UpdateNotificationPreference(ctx, &entities.NotificationPreference{
+		UserID:  req.UserId,
+		Locale:  req.Locale,
+		Email:   req.Email,
+		Phone:   req.Phone,
+		OptOuts: req.OptOuts,
+	})
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	return toNotificationPreferences(resp), nil
+}
+
+func (h *NotificationHandler) ListNotificationDeliveries(ctx context.Context, req *notificationv1.ListNotificationDeliveriesRequest) (*notificationv1.ListNotificationDeliveriesResponse, error) {
+	filter := &entities.NotificationDeliveryFilter{
+		UserID: req.UserId,
+		Kind:   req.Kind,
+		Status: req.Status,
+	}
+
+	resp, err := h.svc.ListNotificationDeliveries(ctx, filter, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	result := &notificationv1.ListNotificationDeliveriesResponse{NextPageToken: resp.NextPageToken}
+	for _, delivery := range resp.Deliveries {
+		result.Deliveries = append(result.Deliveries, toNotificationDelivery(delivery))
+	}
+
+	return result, nil
+}
+
+func toNotificationPreferences(preference *entities.NotificationPreference) *notificationv1.NotificationPreferences {
+	result := &notificationv1.NotificationPreferences{
+		UserId:  preference.UserID,
+		Locale:  preference.Locale,
+		Email:   preference.Email,
+		Phone:   preference.Phone,
+		OptOuts: preference.OptOuts,
+	}
+	// the default preference was never saved
+	if !preference.UpdatedAt.IsZero() {
+		result.UpdatedAt = timestamppb.New(preference.UpdatedAt)
+	}
+
+	return result
+}
+
+func toNotificationDelivery(delivery *entities.NotificationDelivery) *notificationv1.NotificationDelivery {
+	result := &notificationv1.NotificationDelivery{
+		DeliveryId: delivery.ID,
+		UserId:     delivery.UserID,
+		LoanId:     delivery.LoanID,
+		PaymentId:  delivery.PaymentID,
+		Kind:       delivery.Kind,
+		Channel:    delivery.Channel,
+		Locale:     delivery.Locale,
+		Recipient:  delivery.Recipient,
+		Subject:    delivery.Subject,
+		Body:       delivery.Body,
+		Status:     delivery.Status,
+		CreatedAt:  timestamppb.New(delivery.CreatedAt),
+	}
+	if delivery.Error != nil {
+		result.Error = *delivery.Error
+	}
+
+	return result
+}
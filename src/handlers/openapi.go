@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	swaggerfiles "github.com/swaggo/files/v2"
+	"net/http"
+)
+
+const (
+	OPENAPI_SPEC_PATH = "/openapi.json"
+	OPENAPI_DOCS_PATH = "/docs/"
+)
+
+// swaggerInitializer replaces the petstore initializer of the swagger ui bundle to load our own spec
+const swaggerInitializer = `window.onload = function() {
+  window.ui = SwaggerUIBundle({
+    url: "` + OPENAPI_SPEC_PATH + `",
+    dom_id: "#swagger-ui",
+    deepLinking: true,
+    presets: [SwaggerUIBundle.presets.apis, SwaggerUIStandalonePreset],
+    layout: "StandaloneLayout"
+  });
+};
+`
+
+// OpenAPI serves the spec and a swagger ui bundled into the binary, so the docs work without internet access
+func OpenAPI(spec []byte) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+OPENAPI_SPEC_PATH, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(spec)
+	})
+	mux.HandleFunc("GET "+OPENAPI_DOCS_PATH+"swagger-initializer.js", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/javascript")
+		w.Write([]byte(swaggerInitializer))
+	})
+	mux.Handle("GET "+OPENAPI_DOCS_PATH, http.StripPrefix(OPENAPI_DOCS_PATH, http.FileServerFS(swaggerfiles.FS)))
+
+	return mux
+}
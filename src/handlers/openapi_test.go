@@ -0,0 +1,55 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verizhang/billing-engine/contracts/openapi"
+	"github.com/verizhang/billing-engine/src/handlers"
+)
+
+func TestOpenAPI(t *testing.T) {
+	handler := handlers.OpenAPI(openapi.Spec)
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	t.Run("serves the generated spec", func(t *testing.T) {
+		rec := get("/openapi.json")
+
+		var spec struct {
+			Swagger string         `json:"swagger"`
+			Paths   map[string]any `json:"paths"`
+		}
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &spec))
+		assert.Equal(t, "2.0", spec.Swagger)
+		assert.Contains(t, spec.Paths, "/v1/loan/{loanId}")
+		assert.Contains(t, spec.Paths, "/v1/payment")
+	})
+
+	t.Run("serves the bundled swagger ui", func(t *testing.T) {
+		rec := get("/docs/")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "swagger-ui-bundle.js")
+
+		rec = get("/docs/swagger-ui-bundle.js")
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("points the swagger ui at the spec", func(t *testing.T) {
+		rec := get("/docs/swagger-initializer.js")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `url: "/openapi.json"`)
+		assert.NotContains(t, rec.Body.String(), "petstore")
+	})
+}
@@ -2,14 +2,16 @@ package handlers
 
 import (
 	"context"
-	paymentpb "github.com/verizhang/billing-engine/contracts/pb/payment"
+	paymentv1 "github.com/verizhang/billing-engine/contracts/pb/billing/payment/v1"
+	"github.com/verizhang/billing-engine/src/entities"
 	"github.com/verizhang/billing-engine/src/services"
 	"github.com/verizhang/billing-engine/src/utils/errorhandler"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type PaymentHandler struct {
-	paymentpb.UnimplementedPaymentServer
+	paymentv1.UnimplementedPaymentServiceServer
 	svc services.PaymentService
 }
 
@@ -19,7 +21,7 @@ func NewPaymentHandler(svc services.PaymentService) *PaymentHandler {
 	}
 }
 
-func (h *PaymentHandler) MakePayment(ctx context.Context, req *paymentpb.MakePaymentRequest) (*emptypb.Empty, error) {
+func (h *PaymentHandler) MakePayment(ctx context.Context, req *paymentv1.MakePaymentRequest) (*emptypb.Empty, error) {
 	err := h.svc.MakePayment(ctx, req.UserId)
 	if err != nil {
 		return nil, errorhandler.TranslateTogRPCError(err)
@@ -27,3 +29,67 @@ func (h *PaymentHandler) MakePayment(ctx context.Context, req *paymentpb.MakePay
 
 	return &emptypb.Empty{}, nil
 }
+
+func (h *PaymentHandler) MakeLoanPayment(ctx context.Context, req *paymentv1.MakeLoanPaymentRequest) (*emptypb.Empty, error) {
+	err := h.svc.MakePaymentByLoanID(ctx, req.UserId, req.LoanId)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *PaymentHandler) ListPayments(ctx context.Context, req *paymentv1.ListPaymentsRequest) (*paymentv1.ListPaymentsResponse, error) {
+	filter := &entities.PaymentTransactionFilter{
+		LoanID: req.LoanId,
+		UserID: req.UserId,
+	}
+	if req.From != nil {
+		from := req.From.AsTime()
+		filter.From = &from
+	}
+	if req.To != nil {
+		to := req.To.AsTime()
+		filter.To = &to
+	}
+
+	resp, err := h.svc.ListPayments(ctx, filter, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	result := &paymentv1.ListPaymentsResponse{NextPageToken: resp.NextPageToken}
+	for _, transaction := range resp.Transactions {
+		result.Payments = append(result.Payments, toPaymentTransaction(transaction))
+	}
+
+	return result, nil
+}
+
+func toPaymentTransaction(transaction *entities.PaymentTransaction) *paymentv1.PaymentTransaction {
+	result := &paymentv1.PaymentTransaction{
+		TransactionId: transaction.ID,
+		LoanId:        transaction.LoanID,
+		Amount:        float32(transaction.Amount),
+		Channel:       transaction.Channel,
+		PaidAt:        timestamppb.New(*transaction.PaidAt),
+		Reversed:      transaction.ReversedAt != nil,
+	}
+	if transaction.Reference != nil {
+		result.Reference = *transaction.Reference
+	}
+	if transaction.ReversedAt != nil {
+		result.ReversedAt = timestamppb.New(*transaction.ReversedAt)
+	}
+	for _, allocation := range transaction.Allocations {
+		result.Allocations = append(result.Allocations, &paymentv1.PaymentAllocation{
+			PaymentId: allocation.PaymentID,
+			Amount:    float32(allocation.Amount),
+			Principal: float32(allocation.Principal),
+			Interest:  float32(allocation.Interest),
+			Fee:       float32(allocation.Fee),
+		})
+	}
+
+	return result
+}
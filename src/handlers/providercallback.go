@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/verizhang/billing-engine/src/providers"
+	"github.com/verizhang/billing-engine/src/services"
+	"github.com/verizhang/billing-engine/src/utils/requestid"
+	"io"
+	"net/http"
+)
+
+const (
+	PROVIDER_CALLBACK_PATH = "/v1/providers/"
+	// providerCallbackMaxBytes bounds the notification body, providers send a single payment per call
+	providerCallbackMaxBytes = 1 << 20
+)
+
+type providerCallbackResponse struct {
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// ProviderCallbacks receives the payment notifications of the providers at /v1/providers/{provider}/callbacks.
+// Providers authenticate with their own signature instead of a bearer token, so this is served next to the gateway.
+// A verified notification is acknowledged with 200 whatever became of the payment, a 5xx makes the provider retry
+func ProviderCallbacks(adapters map[string]providers.Adapter, callbackService services.ProviderCallbackService) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST "+PROVIDER_CALLBACK_PATH+"{provider}/callbacks", func(w http.ResponseWriter, r *http.Request) {
+		adapter, ok := adapters[r.PathValue("provider")]
+		if !ok {
+			http.Error(w, "unknown provider", http.StatusNotFound)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, providerCallbackMaxBytes))
+		if err != nil {
+			http.Error(w, "unreadable body", http.StatusBadRequest)
+			return
+		}
+
+		if err := adapter.Verify(r.Header, body); err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		payment, err := adapter.Parse(body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		id := r.Header.Get(requestid.HEADER)
+		if id == "" {
+			id = uuid.NewString()
+		}
+		w.Header().Set(requestid.HEADER, id)
+
+		callback, err := callbackService.HandleProviderPayment(requestid.WithRequestID(r.Context(), id), payment, body)
+		if err != nil {
+			http.Error(w, "callback not processed", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(providerCallbackResponse{Status: callback.Status, Reason: callback.Reason})
+	})
+
+	return mux
+}
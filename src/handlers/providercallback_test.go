@@ -0,0 +1,120 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/handlers"
+	"github.com/verizhang/billing-engine/src/providers"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"github.com/verizhang/billing-engine/src/utils/requestid"
+	"github.com/verizhang/billing-engine/src/utils/signature"
+)
+
+// callbackService records the payments it handles and answers with the same callback or error
+type callbackService struct {
+	payments  []*entities.ProviderPayment
+	requestID string
+	callback  *entities.ProviderCallback
+	err       error
+}
+
+func (s *callbackService) HandleProviderPayment(ctx context.Context, payment *entities.ProviderPayment, payload []byte) (*entities.ProviderCallback, error) {
+	s.payments = append(s.payments, payment)
+	s.requestID = requestid.FromContext(ctx)
+	return s.callback, s.err
+}
+
+func TestProviderCallbacks(t *testing.T) {
+	const secret = "secret"
+	body := `{"id":"pay1","reference":"loan1","amount":110,"paidAt":"2026-01-02T03:04:05Z"}`
+	adapters := map[string]providers.Adapter{
+		providers.PROVIDER_GENERIC: providers.NewGenericAdapter(secret, time.Minute),
+		providers.PROVIDER_XENDIT:  providers.NewXenditAdapter("token"),
+	}
+
+	newRequest := func(provider string, body string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, handlers.PROVIDER_CALLBACK_PATH+provider+"/callbacks", strings.NewReader(body))
+		r.Header.Set(signature.HEADER, signature.Sign(secret, time.Now(), []byte(body)))
+		return r
+	}
+
+	t.Run("success acknowledge signed notification", func(t *testing.T) {
+		service := &callbackService{callback: &entities.ProviderCallback{Status: entities.PROVIDER_CALLBACK_STATUS_POSTED}}
+		w := httptest.NewRecorder()
+		r := newRequest(providers.PROVIDER_GENERIC, body)
+		r.Header.Set(requestid.HEADER, "request1")
+		handlers.ProviderCallbacks(adapters, service).ServeHTTP(w, r)
+
+		var response map[string]string
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, entities.PROVIDER_CALLBACK_STATUS_POSTED, response["status"])
+		assert.Equal(t, "request1", service.requestID)
+		assert.Len(t, service.payments, 1)
+		assert.Equal(t, "pay1", service.payments[0].ExternalID)
+		assert.Equal(t, "loan1", service.payments[0].Reference)
+		assert.Equal(t, float64(110), service.payments[0].Amount)
+	})
+
+	t.Run("success parse xendit notification", func(t *testing.T) {
+		service := &callbackService{callback: &entities.ProviderCallback{Status: entities.PROVIDER_CALLBACK_STATUS_UNMATCHED, Reason: errorhandler.REASON_PAYMENT_UNMATCHED}}
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, handlers.PROVIDER_CALLBACK_PATH+"xendit/callbacks", strings.NewReader(
+			`{"payment_id":"xp1","external_id":"loan1","account_number":"9999000001","amount":110,"transaction_timestamp":"2026-01-02T03:04:05Z"}`,
+		))
+		r.Header.Set(providers.HEADER_XENDIT_CALLBACK_TOKEN, "token")
+		handlers.ProviderCallbacks(adapters, service).ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), errorhandler.REASON_PAYMENT_UNMATCHED)
+		assert.NotEmpty(t, service.requestID)
+		assert.Equal(t, "xp1", service.payments[0].ExternalID)
+		assert.Equal(t, "9999000001", service.payments[0].VirtualAccount)
+	})
+
+	tests := []struct {
+		name     string
+		request  func() *http.Request
+		err      error
+		expected int
+	}{
+		{name: "unknown provider", request: func() *http.Request { return newRequest("other", body) }, expected: http.StatusNotFound},
+		{
+			name: "invalid signature",
+			request: func() *http.Request {
+				r := newRequest(providers.PROVIDER_GENERIC, body)
+				r.Header.Set(signature.HEADER, signature.Sign("other", time.Now(), []byte(body)))
+				return r
+			},
+			expected: http.StatusUnauthorized,
+		},
+		{name: "malformed notification", request: func() *http.Request { return newRequest(providers.PROVIDER_GENERIC, `{"id":""}`) }, expected: http.StatusBadRequest},
+		{name: "method not allowed", request: func() *http.Request {
+			return httptest.NewRequest(http.MethodGet, "/v1/providers/generic/callbacks", nil)
+		}, expected: http.StatusMethodNotAllowed},
+		{
+			name:     "internal failure",
+			request:  func() *http.Request { return newRequest(providers.PROVIDER_GENERIC, body) },
+			err:      fmt.Errorf("%w: connection reset", errorhandler.InternalServerError),
+			expected: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run("error when "+tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			handlers.ProviderCallbacks(adapters, &callbackService{err: tt.err}).ServeHTTP(w, tt.request())
+
+			assert.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"context"
+	reconciliationv1 "github.com/verizhang/billing-engine/contracts/pb/billing/reconciliation/v1"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/services"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ReconciliationHandler struct {
+	reconciliationv1.UnimplementedReconciliationServiceServer
+	svc services.ReconciliationService
+}
+
+func NewReconciliationHandler(svc services.ReconciliationService) *ReconciliationHandler {
+	return &ReconciliationHandler{
+		svc: svc,
+	}
+}
+
+func (h *ReconciliationHandler) ImportBankStatement(ctx context.Context, req *reconciliationv1.ImportBankStatementRequest) (*reconciliationv1.ImportBankStatementResponse, error) {
+	resp, err := h.svc.ImportBankStatement(ctx, req.Format, req.FileName, req.Content)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	result := &reconciliationv1.ImportBankStatementResponse{
+		Import: &reconciliationv1.BankStatementImport{
+			ImportId:   resp.Import.ID,
+			Format:     resp.Import.Format,
+			FileName:   resp.Import.FileName,
+			FileHash:   resp.Import.FileHash,
+			Lines:      int32(resp.Import.Lines),
+			ImportedBy: resp.Import.ImportedBy,
+			ImportedAt: timestamppb.New(resp.Import.ImportedAt),
+		},
+	}
+	for _, line := range resp.Lines {
+		result.Lines = append(result.Lines, toBankStatementLine(line))
+	}
+
+	return result, nil
+}
+
+func (h *ReconciliationHandler) ListBankStatementLines(ctx context.Context, req *reconciliationv1.ListBankStatementLinesRequest) (*reconciliationv1.ListBankStatementLinesResponse, error) {
+	filter := &entities.BankStatementLineFilter{
+		ImportID: req.ImportId,
+		Status:   req.Status,
+	}
+
+	resp, err := h.svc.ListBankStatementLines(ctx, filter, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	result := &reconciliationv1.ListBankStatementLinesResponse{NextPageToken: resp.NextPageToken}
+	for _, line := range resp.Lines {
+		result.Lines = append(result.Lines, toBankStatementLine(line))
+	}
+
+	return result, nil
+}
+
+func (h *ReconciliationHandler) ResolveBankStatementLine(ctx context.Context, req *reconciliationv1.ResolveBankStatementLineRequest) (*reconciliationv1.BankStatementLine, error) {
+	resp, err := h.svc.ResolveBankStatementLine(ctx, req.LineId, req.LoanId, req.Note)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	return toBankStatementLine(resp), nil
+}
+
+func (h *ReconciliationHandler) DismissBankStatementLine(ctx context.Context, req *reconciliationv1.DismissBankStatementLineRequest) (*reconciliationv1.BankStatementLine, error) {
+	resp, err := h.svc.DismissBankStatementLine(ctx, req.LineId, req.Note)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	return toBankStatementLine(resp), nil
+}
+
+func (h *ReconciliationHandler) GetReconciliationReport(ctx context.Context, req *reconciliationv1.GetReconciliationReportRequest) (*reconciliationv1.GetReconciliationReportResponse, error) {
+	resp, err := h.svc.GetReconciliationReport(ctx, req.From.AsTime(), req.To.AsTime())
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	result := &reconciliationv1.GetReconciliationReportResponse{Imports: int32(resp.Imports)}
+	for _, total := range resp.Totals {
+		result.Totals = append(result.Totals, &reconciliationv1.ReconciliationTotal{
+			Status: total.Status,
+			Lines:  int32(total.Lines),
+			Amount: float32(total.Amount),
+		})
+	}
+
+	return result, nil
+}
+
+func toBankStatementLine(line *entities.BankStatementLine) *reconciliationv1.BankStatementLine {
+	result := &reconciliationv1.BankStatementLine{
+		LineId:        line.ID,
+		ImportId:      line.ImportID,
+		LineNo:        int32(line.LineNo),
+		BookedAt:      timestamppb.New(line.BookedAt),
+		Amount:        float32(line.Amount),
+		Currency:      line.Currency,
+		Reference:     line.Reference,
+		Description:   line.Description,
+		BankReference: line.BankReference,
+		Status:        line.Status,
+		Reason:        line.Reason,
+		ResolvedBy:    line.ResolvedBy,
+		Note:          line.Note,
+	}
+	if line.LoanID != nil {
+		result.LoanId = *line.LoanID
+	}
+	if line.ResolvedAt != nil {
+		result.ResolvedAt = timestamppb.New(*line.ResolvedAt)
+	}
+
+	return result
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	statementv1 "github.com/verizhang/billing-engine/contracts/pb/billing/statement/v1"
+	"github.com/verizhang/billing-engine/src/services"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+type StatementHandler struct {
+	statementv1.UnimplementedStatementServiceServer
+	svc services.StatementService
+}
+
+func NewStatementHandler(svc services.StatementService) *StatementHandler {
+	return &StatementHandler{
+		svc: svc,
+	}
+}
+
+func (h *StatementHandler) GenerateStatement(ctx context.Context, req *statementv1.GenerateStatementRequest) (*statementv1.Statement, error) {
+	resp, err := h.svc.GenerateStatement(ctx, req.LoanId, req.From.AsTime(), toStatementEnd(req.To))
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	result := &statementv1.Statement{
+		LoanId:         resp.Loan.ID,
+		UserId:         resp.Loan.UserID,
+		From:           timestamppb.New(resp.From),
+		To:             timestamppb.New(resp.To),
+		OpeningBalance: float32(resp.OpeningBalance),
+		ClosingBalance: float32(resp.ClosingBalance),
+	}
+	for _, entry := range resp.Entries {
+		result.Entries = append(result.Entries, &statementv1.StatementEntry{
+			Date:        timestamppb.New(*entry.Date),
+			Type:        entry.Type,
+			Description: entry.Description,
+			Reference:   entry.Reference,
+			Amount:      float32(entry.Amount),
+			Balance:     float32(entry.Balance),
+		})
+	}
+
+	return result, nil
+}
+
+func (h *StatementHandler) RenderStatement(ctx context.Context, req *statementv1.RenderStatementRequest) (*httpbody.HttpBody, error) {
+	data, contentType, err := h.svc.RenderStatement(ctx, req.LoanId, req.From.AsTime(), toStatementEnd(req.To), req.Format)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: contentType,
+		Data:        data,
+	}, nil
+}
+
+func toStatementEnd(to *timestamppb.Timestamp) time.Time {
+	if to == nil {
+		return time.Now()
+	}
+
+	return to.AsTime()
+}
@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"context"
+	webhookv1 "github.com/verizhang/billing-engine/contracts/pb/billing/webhook/v1"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/services"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type WebhookHandler struct {
+	webhookv1.UnimplementedWebhookServiceServer
+	svc services.WebhookService
+}
+
+func NewWebhookHandler(svc services.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		svc: svc,
+	}
+}
+
+func (h *WebhookHandler) CreateWebhookSubscription(ctx context.Context, req *webhookv1.CreateWebhookSubscriptionRequest) (*webhookv1.WebhookSubscription, error) {
+	resp, err := h.svc.CreateWebhookSubscription(ctx, req.Url, req.EventTypes, req.Secret)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	result := toWebhookSubscription(resp)
+	result.Secret = resp.Secret
+	return result, nil
+}
+
+func (h *WebhookHandler) ListWebhookSubscriptions(ctx context.Context, req *emptypb.Empty) (*webhookv1.ListWebhookSubscriptionsResponse, error) {
+	resp, err := h.svc.ListWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	result := &webhookv1.ListWebhookSubscriptionsResponse{}
+	for _, subscription := range resp {
+		result.Subscriptions = append(result.Subscriptions, toWebhookSubscription(subscription))
+	}
+
+	return result, nil
+}
+
+func (h *WebhookHandler) UpdateWebhookSubscription(ctx context.Context, req *webhookv1.UpdateWebhookSubscriptionRequest) (*webhookv1.WebhookSubscription, error) {
+	update := &entities.WebhookSubscriptionUpdate{
+		URL:      req.Url,
+		Secret:   req.Secret,
+		IsActive: req.IsActive,
+	}
+	if len(req.EventTypes) > 0 {
+		update.EventTypes = req.EventTypes
+	}
+
+	resp, err := h.svc.UpdateWebhookSubscription(ctx, req.SubscriptionId, update)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	return toWebhookSubscription(resp), nil
+}
+
+func (h *WebhookHandler) DeleteWebhookSubscription(ctx context.Context, req *webhookv1.DeleteWebhookSubscriptionRequest) (*emptypb.Empty, error) {
+	err := h.svc.DeleteWebhookSubscription(ctx, req.SubscriptionId)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *WebhookHandler) ListWebhookDeliveries(ctx context.Context, req *webhookv1.ListWebhookDeliveriesRequest) (*webhookv1.ListWebhookDeliveriesResponse, error) {
+	filter := &entities.WebhookDeliveryFilter{
+		SubscriptionID: req.SubscriptionId,
+		Status:         req.Status,
+	}
+
+	resp, err := h.svc.ListWebhookDeliveries(ctx, filter, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	result := &webhookv1.ListWebhookDeliveriesResponse{NextPageToken: resp.NextPageToken}
+	for _, delivery := range resp.Deliveries {
+		result.Deliveries = append(result.Deliveries, toWebhookDelivery(delivery))
+	}
+
+	return result, nil
+}
+
+func (h *WebhookHandler) RedeliverWebhook(ctx context.Context, req *webhookv1.RedeliverWebhookRequest) (*webhookv1.WebhookDelivery, error) {
+	resp, err := h.svc.RedeliverWebhook(ctx, req.DeliveryId)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	return toWebhookDelivery(resp), nil
+}
+
+// toWebhookSubscription leaves the secret out, it is only returned when the subscription is created
+func toWebhookSubscription(subscription *entities.WebhookSubscription) *webhookv1.WebhookSubscription {
+	result := &webhookv1.WebhookSubscription{
+		SubscriptionId: subscription.ID,
+		Url:            subscription.URL,
+		EventTypes:     subscription.EventTypes,
+		IsActive:       subscription.IsActive,
+	}
+	if subscription.CreatedAt != nil {
+		result.CreatedAt = timestamppb.New(*subscription.CreatedAt)
+	}
+
+	return result
+}
+
+func toWebhookDelivery(delivery *entities.WebhookDelivery) *webhookv1.WebhookDelivery {
+	result := &webhookv1.WebhookDelivery{
+		DeliveryId:     delivery.ID,
+		SubscriptionId: delivery.SubscriptionID,
+		EventId:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Status:         delivery.Status,
+		Attempts:       int32(delivery.Attempts),
+		NextAttemptAt:  timestamppb.New(delivery.NextAttemptAt),
+		CreatedAt:      timestamppb.New(delivery.CreatedAt),
+	}
+	if delivery.LastStatusCode != nil {
+		result.LastStatusCode = int32(*delivery.LastStatusCode)
+	}
+	if delivery.LastError != nil {
+		result.LastError = *delivery.LastError
+	}
+	if delivery.DeliveredAt != nil {
+		result.DeliveredAt = timestamppb.New(*delivery.DeliveredAt)
+	}
+
+	return result
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"context"
+	writeoffv1 "github.com/verizhang/billing-engine/contracts/pb/billing/writeoff/v1"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/services"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type WriteOffHandler struct {
+	writeoffv1.UnimplementedWriteOffServiceServer
+	svc services.WriteOffService
+}
+
+func NewWriteOffHandler(svc services.WriteOffService) *WriteOffHandler {
+	return &WriteOffHandler{
+		svc: svc,
+	}
+}
+
+func (h *WriteOffHandler) WriteOffLoan(ctx context.Context, req *writeoffv1.WriteOffLoanRequest) (*writeoffv1.LoanWriteOff, error) {
+	resp, err := h.svc.WriteOffLoan(ctx, req.LoanId)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	return toLoanWriteOff(resp), nil
+}
+
+func (h *WriteOffHandler) ApplyWriteOffPolicy(ctx context.Context, req *emptypb.Empty) (*writeoffv1.ApplyWriteOffPolicyResponse, error) {
+	resp, err := h.svc.ApplyWriteOffPolicy(ctx)
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	result := &writeoffv1.ApplyWriteOffPolicyResponse{}
+	for _, writeOff := range resp {
+		result.WriteOffs = append(result.WriteOffs, toLoanWriteOff(writeOff))
+	}
+
+	return result, nil
+}
+
+func (h *WriteOffHandler) GetRecoveriesReport(ctx context.Context, req *writeoffv1.GetRecoveriesReportRequest) (*writeoffv1.GetRecoveriesReportResponse, error) {
+	resp, err := h.svc.GetRecoveriesReport(ctx, req.From.AsTime(), req.To.AsTime())
+	if err != nil {
+		return nil, errorhandler.TranslateTogRPCError(err)
+	}
+
+	result := &writeoffv1.GetRecoveriesReportResponse{
+		WrittenOffPrincipal: float32(resp.WrittenOffPrincipal),
+		WrittenOffInterest:  float32(resp.WrittenOffInterest),
+		WrittenOffFee:       float32(resp.WrittenOffFee),
+		Recovered:           float32(resp.Recovered),
+	}
+	for _, writeOff := range resp.WriteOffs {
+		result.WriteOffs = append(result.WriteOffs, toLoanWriteOff(writeOff))
+	}
+	for _, recovery := range resp.Recoveries {
+		result.Recoveries = append(result.Recoveries, &writeoffv1.LoanRecovery{
+			RecoveryId:  recovery.ID,
+			LoanId:      recovery.LoanID,
+			WriteOffId:  recovery.WriteOffID,
+			PaymentId:   recovery.PaymentID,
+			Amount:      float32(recovery.Amount),
+			RecoveredAt: timestamppb.New(*recovery.RecoveredAt),
+		})
+	}
+
+	return result, nil
+}
+
+func toLoanWriteOff(writeOff *entities.LoanWriteOff) *writeoffv1.LoanWriteOff {
+	return &writeoffv1.LoanWriteOff{
+		WriteOffId:   writeOff.ID,
+		LoanId:       writeOff.LoanID,
+		Principal:    float32(writeOff.Principal),
+		Interest:     float32(writeOff.Interest),
+		Fee:          float32(writeOff.Fee),
+		DaysPastDue:  int32(writeOff.DaysPastDue),
+		Reason:       writeOff.Reason,
+		WrittenOffAt: timestamppb.New(*writeOff.WrittenOffAt),
+	}
+}
@@ -0,0 +1,77 @@
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"github.com/verizhang/billing-engine/src/utils/auth"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"strings"
+)
+
+// Authentication verifies the bearer token of every call and passes its principal to the handler through the context.
+// Methods listed in roles require at least that role. Borrowers only act on themselves, an empty userId
+// is filled with the subject of the token and a different one is rejected, staff may pass any userId
+func Authentication(verifier *auth.Verifier, roles map[string]auth.Role) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		principal, err := authenticate(ctx, verifier)
+		if err != nil {
+			return nil, errorhandler.TranslateTogRPCError(err)
+		}
+
+		if required, ok := roles[info.FullMethod]; ok && !principal.Role.Allows(required) {
+			return nil, errorhandler.TranslateTogRPCError(errorhandler.PermissionDenied(errorhandler.REASON_ROLE_REQUIRED, fmt.Sprintf("%s role is required", required)))
+		}
+
+		if msg, ok := req.(proto.Message); ok && !principal.IsStaff() {
+			err = bindUserID(msg, principal.Subject)
+			if err != nil {
+				return nil, errorhandler.TranslateTogRPCError(err)
+			}
+		}
+
+		return handler(auth.WithPrincipal(ctx, principal), req)
+	}
+}
+
+func authenticate(ctx context.Context, verifier *auth.Verifier) (*auth.Principal, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, errorhandler.Unauthenticated(errorhandler.REASON_TOKEN_MISSING, "bearer token is required")
+	}
+
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") || token == "" {
+		return nil, errorhandler.Unauthenticated(errorhandler.REASON_TOKEN_MISSING, "bearer token is required")
+	}
+
+	principal, err := verifier.Verify(token)
+	if err != nil {
+		return nil, errorhandler.Unauthenticated(errorhandler.REASON_TOKEN_INVALID, err.Error())
+	}
+
+	return principal, nil
+}
+
+func bindUserID(msg proto.Message, subject string) error {
+	reflected := msg.ProtoReflect()
+	field := reflected.Descriptor().Fields().ByName("userId")
+	if field == nil || field.Kind() != protoreflect.StringKind {
+		return nil
+	}
+
+	userID := reflected.Get(field).String()
+	if userID == "" {
+		reflected.Set(field, protoreflect.ValueOfString(subject))
+		return nil
+	}
+	if userID != subject {
+		return errorhandler.PermissionDenied(errorhandler.REASON_USER_MISMATCH, "userId does not match the authenticated user")
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package interceptors_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	loanv1 "github.com/verizhang/billing-engine/contracts/pb/billing/loan/v1"
+	writeoffv1 "github.com/verizhang/billing-engine/contracts/pb/billing/writeoff/v1"
+	"github.com/verizhang/billing-engine/src/interceptors"
+	"github.com/verizhang/billing-engine/src/utils/auth"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthentication(t *testing.T) {
+	verifier, err := auth.NewVerifier("secret", "", "", "")
+	assert.NoError(t, err)
+	interceptor := interceptors.Authentication(verifier, map[string]auth.Role{
+		writeoffv1.WriteOffService_WriteOffLoan_FullMethodName: auth.ROLE_OPERATOR,
+	})
+
+	token := func(subject string, role auth.Role) string {
+		signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &auth.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject:   subject,
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+			Role: role,
+		}).SignedString([]byte("secret"))
+		assert.NoError(t, err)
+		return "Bearer " + signed
+	}
+
+	call := func(authorization string, method string, req any) (*auth.Principal, error) {
+		ctx := context.Background()
+		if authorization != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", authorization))
+		}
+
+		var principal *auth.Principal
+		_, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req any) (any, error) {
+			principal, _ = auth.PrincipalFromContext(ctx)
+			return nil, nil
+		})
+		return principal, err
+	}
+
+	reason := func(err error) string {
+		for _, detail := range status.Convert(err).Details() {
+			if info, ok := detail.(*errdetails.ErrorInfo); ok {
+				return info.Reason
+			}
+		}
+		return ""
+	}
+
+	t.Run("rejects missing token", func(t *testing.T) {
+		_, err := call("", loanv1.LoanService_CreateLoan_FullMethodName, &loanv1.CreateLoanRequest{})
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Equal(t, errorhandler.REASON_TOKEN_MISSING, reason(err))
+	})
+
+	t.Run("rejects invalid token", func(t *testing.T) {
+		_, err := call("Bearer token", loanv1.LoanService_CreateLoan_FullMethodName, &loanv1.CreateLoanRequest{})
+
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Equal(t, errorhandler.REASON_TOKEN_INVALID, reason(err))
+	})
+
+	t.Run("fills userId of borrower with the subject", func(t *testing.T) {
+		req := &loanv1.CreateLoanRequest{}
+		principal, err := call(token("user1", ""), loanv1.LoanService_CreateLoan_FullMethodName, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "user1", req.UserId)
+		assert.Equal(t, &auth.Principal{Subject: "user1", Role: auth.ROLE_BORROWER}, principal)
+	})
+
+	t.Run("rejects borrower acting on another user", func(t *testing.T) {
+		_, err := call(token("user1", ""), loanv1.LoanService_CreateLoan_FullMethodName, &loanv1.CreateLoanRequest{UserId: "user2"})
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Equal(t, errorhandler.REASON_USER_MISMATCH, reason(err))
+	})
+
+	t.Run("lets staff act on any user", func(t *testing.T) {
+		req := &loanv1.CreateLoanRequest{UserId: "user2"}
+		_, err := call(token("ops1", auth.ROLE_OPERATOR), loanv1.LoanService_CreateLoan_FullMethodName, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "user2", req.UserId)
+	})
+
+	t.Run("gates staff methods by role", func(t *testing.T) {
+		req := &writeoffv1.WriteOffLoanRequest{LoanId: "loan1"}
+
+		_, err := call(token("user1", ""), writeoffv1.WriteOffService_WriteOffLoan_FullMethodName, req)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Equal(t, errorhandler.REASON_ROLE_REQUIRED, reason(err))
+
+		_, err = call(token("admin1", auth.ROLE_ADMIN), writeoffv1.WriteOffService_WriteOffLoan_FullMethodName, req)
+		assert.NoError(t, err)
+	})
+}
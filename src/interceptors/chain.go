@@ -0,0 +1,22 @@
+package interceptors
+
+import (
+	"context"
+	"google.golang.org/grpc"
+)
+
+// Chain combines the interceptors into one, running them in the given order,
+// so the same chain can guard both the registered services and the legacy shim
+func Chain(interceptors ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, inner := interceptors[i], next
+			next = func(ctx context.Context, req any) (any, error) {
+				return interceptor(ctx, req, info, inner)
+			}
+		}
+
+		return next(ctx, req)
+	}
+}
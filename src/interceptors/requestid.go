@@ -0,0 +1,29 @@
+package interceptors
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/verizhang/billing-engine/src/utils/requestid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestID keeps the request id sent by the caller or assigns a new one,
+// passes it to the handler through the context and echoes it in the response header
+func RequestID() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get(requestid.HEADER)
+
+		requestID := ""
+		if len(values) > 0 && len(values[0]) <= 100 {
+			requestID = values[0]
+		}
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+
+		grpc.SetHeader(ctx, metadata.Pairs(requestid.HEADER, requestID))
+		return handler(requestid.WithRequestID(ctx, requestID), req)
+	}
+}
@@ -0,0 +1,41 @@
+package interceptors_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verizhang/billing-engine/src/interceptors"
+	"github.com/verizhang/billing-engine/src/utils/requestid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestRequestID(t *testing.T) {
+	call := func(ctx context.Context) string {
+		var requestID string
+		_, err := interceptors.RequestID()(ctx, "req", &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+			requestID = requestid.FromContext(ctx)
+			return req, nil
+		})
+		assert.NoError(t, err)
+		return requestID
+	}
+
+	t.Run("keeps the id sent by the caller", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(requestid.HEADER, "request1"))
+
+		assert.Equal(t, "request1", call(ctx))
+	})
+
+	t.Run("assigns an id when missing", func(t *testing.T) {
+		assert.Len(t, call(context.Background()), 36)
+	})
+
+	t.Run("replaces an id that is too long", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(requestid.HEADER, strings.Repeat("a", 101)))
+
+		assert.Len(t, call(ctx), 36)
+	})
+}
@@ -0,0 +1,45 @@
+package interceptors
+
+import (
+	"buf.build/go/protovalidate"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// Validation rejects requests breaking their buf.validate rules with InvalidArgument
+// and a field violation for every broken rule, before the handler runs
+func Validation(validator protovalidate.Validator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		err := validator.Validate(msg)
+		var validationErr *protovalidate.ValidationError
+		if errors.As(err, &validationErr) {
+			return nil, errorhandler.TranslateTogRPCError(toInvalidFields(validationErr))
+		}
+		if err != nil {
+			return nil, errorhandler.TranslateTogRPCError(fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error()))
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func toInvalidFields(err *protovalidate.ValidationError) error {
+	var violations []*errorhandler.FieldViolation
+	for _, violation := range err.Violations {
+		violations = append(violations, &errorhandler.FieldViolation{
+			Field:       protovalidate.FieldPathString(violation.Proto.GetField()),
+			Description: violation.Proto.GetMessage(),
+		})
+	}
+
+	return errorhandler.InvalidFields(violations)
+}
@@ -0,0 +1,83 @@
+package interceptors_test
+
+import (
+	"context"
+	"testing"
+
+	"buf.build/go/protovalidate"
+	"github.com/stretchr/testify/assert"
+	loanv1 "github.com/verizhang/billing-engine/contracts/pb/billing/loan/v1"
+	paymentv1 "github.com/verizhang/billing-engine/contracts/pb/billing/payment/v1"
+	"github.com/verizhang/billing-engine/src/interceptors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestValidation(t *testing.T) {
+	validator, err := protovalidate.New()
+	assert.NoError(t, err)
+	interceptor := interceptors.Validation(validator)
+
+	call := func(req proto.Message) (bool, error) {
+		called := false
+		_, err := interceptor(context.Background(), req, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+			called = true
+			return nil, nil
+		})
+		return called, err
+	}
+
+	fields := func(err error) []string {
+		var result []string
+		for _, detail := range status.Convert(err).Details() {
+			if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+				for _, violation := range badRequest.FieldViolations {
+					result = append(result, violation.Field)
+				}
+			}
+		}
+		return result
+	}
+
+	t.Run("valid request reaches the handler", func(t *testing.T) {
+		called, err := call(&loanv1.GetLoanRequest{UserId: "user1", LoanId: "0b9a3e52-2f7c-11f0-9cd2-0242ac120002"})
+
+		assert.NoError(t, err)
+		assert.True(t, called)
+	})
+
+	t.Run("rejects empty user id and malformed loan id", func(t *testing.T) {
+		called, err := call(&loanv1.GetLoanRequest{LoanId: "loan1"})
+
+		assert.False(t, called)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.ElementsMatch(t, []string{"userId", "loanId"}, fields(err))
+	})
+
+	t.Run("rejects amounts out of range", func(t *testing.T) {
+		_, err := call(&loanv1.TopUpLoanRequest{UserId: "user1", LoanId: "0b9a3e52-2f7c-11f0-9cd2-0242ac120002", Amount: -1})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, []string{"amount"}, fields(err))
+	})
+
+	t.Run("rejects unknown enum values", func(t *testing.T) {
+		_, err := call(&loanv1.ListLoansRequest{UserId: "user1", Status: []string{"active", "closed"}})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, []string{"status[1]"}, fields(err))
+	})
+
+	t.Run("requires a user or a loan to list payments", func(t *testing.T) {
+		called, err := call(&paymentv1.ListPaymentsRequest{})
+		assert.False(t, called)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		called, err = call(&paymentv1.ListPaymentsRequest{UserId: "user1"})
+		assert.NoError(t, err)
+		assert.True(t, called)
+	})
+}
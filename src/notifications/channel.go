@@ -0,0 +1,66 @@
+// Package notifications renders the messages of the borrowers and sends them through the configured channels
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/verizhang/billing-engine/config"
+	"net/http"
+	"os"
+)
+
+const (
+	CHANNEL_SMTP = "smtp"
+	CHANNEL_SMS  = "sms"
+	CHANNEL_LOG  = "log"
+)
+
+// Message is a rendered notification for the recipient of a channel, an email address for smtp,
+// a phone number for sms and the user id for log
+type Message struct {
+	Recipient string
+	Subject   string
+	Body      string
+}
+
+// Channel sends messages one way, an error means the message was not accepted
+type Channel interface {
+	Name() string
+	Send(ctx context.Context, msg *Message) error
+}
+
+// New returns the channels listed in NOTIFICATION_CHANNELS keyed by name
+func New(cfg config.Config) (map[string]Channel, error) {
+	channels := map[string]Channel{}
+	for _, name := range cfg.NotificationChannels {
+		var channel Channel
+		switch name {
+		case CHANNEL_SMTP:
+			if cfg.SMTPHost == "" || cfg.SMTPFrom == "" {
+				return nil, errors.New("SMTP_HOST and SMTP_FROM are required by the smtp channel")
+			}
+			channel = NewSMTPChannel(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPFrom, cfg.SMTPTimeout)
+		case CHANNEL_SMS:
+			if cfg.SMSGatewayURL == "" {
+				return nil, errors.New("SMS_GATEWAY_URL is required by the sms channel")
+			}
+			channel = NewSMSChannel(&http.Client{Timeout: cfg.SMSGatewayTimeout}, cfg.SMSGatewayURL, cfg.SMSGatewayToken)
+		case CHANNEL_LOG:
+			channel = NewWriterChannel(os.Stdout)
+		default:
+			return nil, fmt.Errorf("unknown notification channel %q", name)
+		}
+		channels[name] = channel
+	}
+
+	return channels, nil
+}
+
+func IsValidChannel(name string) bool {
+	switch name {
+	case CHANNEL_SMTP, CHANNEL_SMS, CHANNEL_LOG:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+type writerChannel struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriterChannel writes every message as a line of JSON, the log channel writes to os.Stdout
+func NewWriterChannel(w io.Writer) Channel {
+	return &writerChannel{
+		w: w,
+	}
+}
+
+func (c *writerChannel) Name() string {
+	return CHANNEL_LOG
+}
+
+func (c *writerChannel) Send(ctx context.Context, msg *Message) error {
+	data, err := json.Marshal(map[string]string{
+		"channel":   CHANNEL_LOG,
+		"recipient": msg.Recipient,
+		"subject":   msg.Subject,
+		"body":      msg.Body,
+	})
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, err = c.w.Write(append(data, '\n'))
+	return err
+}
@@ -0,0 +1,218 @@
+package notifications_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verizhang/billing-engine/config"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/notifications"
+)
+
+func TestTemplates(t *testing.T) {
+	templates, err := notifications.LoadTemplates("", "en")
+	assert.NoError(t, err)
+
+	reminder := notifications.PaymentReminder{
+		LoanID:           "loan1",
+		PaymentReference: "8808123456789012",
+		Amount:           1100000,
+		DueAt:            time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC),
+		Days:             3,
+	}
+
+	t.Run("renders every kind in every bundled locale", func(t *testing.T) {
+		for _, locale := range []string{"en", "id"} {
+			for _, kind := range []string{entities.NOTIFICATION_KIND_PAYMENT_UPCOMING, entities.NOTIFICATION_KIND_PAYMENT_DUE, entities.NOTIFICATION_KIND_PAYMENT_OVERDUE} {
+				rendered, err := templates.Render(locale, kind, reminder)
+				assert.NoError(t, err, locale+"/"+kind)
+				assert.Equal(t, locale, rendered.Locale)
+				assert.NotEmpty(t, rendered.Subject)
+				assert.Contains(t, rendered.Body, "8808123456789012")
+			}
+		}
+	})
+
+	t.Run("formats money and dates in the locale", func(t *testing.T) {
+		rendered, err := templates.Render("en", entities.NOTIFICATION_KIND_PAYMENT_UPCOMING, reminder)
+		assert.NoError(t, err)
+		assert.Equal(t, "Your installment of IDR 1,100,000 is due on 9 March 2026", rendered.Subject)
+		assert.Contains(t, rendered.Body, "due in 3 days")
+
+		rendered, err = templates.Render("id", entities.NOTIFICATION_KIND_PAYMENT_UPCOMING, reminder)
+		assert.NoError(t, err)
+		assert.Equal(t, "Cicilan Anda sebesar Rp1.100.000 jatuh tempo pada 9 Maret 2026", rendered.Subject)
+	})
+
+	t.Run("falls back to the default locale", func(t *testing.T) {
+		rendered, err := templates.Render("fr", entities.NOTIFICATION_KIND_PAYMENT_DUE, reminder)
+		assert.NoError(t, err)
+		assert.Equal(t, "en", rendered.Locale)
+	})
+
+	t.Run("error on a template without subject or body", func(t *testing.T) {
+		_, err := notifications.ParseTemplates(fstest.MapFS{
+			"en/payment_due.tmpl": {Data: []byte(`{{define "body"}}due{{end}}`)},
+		}, "en")
+		assert.Error(t, err)
+	})
+
+	t.Run("error without templates for the default locale", func(t *testing.T) {
+		_, err := notifications.LoadTemplates("", "fr")
+		assert.Error(t, err)
+	})
+}
+
+func TestSMSChannel(t *testing.T) {
+	t.Run("posts the message with the token", func(t *testing.T) {
+		var body map[string]string
+		var authorization string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization = r.Header.Get("Authorization")
+			data, _ := io.ReadAll(r.Body)
+			json.Unmarshal(data, &body)
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		channel := notifications.NewSMSChannel(server.Client(), server.URL, "token1")
+		err := channel.Send(context.Background(), &notifications.Message{Recipient: "+6281234567890", Subject: "due", Body: "pay today"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Bearer token1", authorization)
+		assert.Equal(t, map[string]string{"to": "+6281234567890", "message": "pay today"}, body)
+	})
+
+	t.Run("error when the gateway refuses the message", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer server.Close()
+
+		channel := notifications.NewSMSChannel(server.Client(), server.URL, "")
+		err := channel.Send(context.Background(), &notifications.Message{Recipient: "+6281234567890", Body: "pay today"})
+
+		assert.Error(t, err)
+	})
+}
+
+// serveSMTP answers one SMTP conversation with the replies a server accepting the message gives, or stays silent
+func serveSMTP(t *testing.T, silent bool) (string, *bytes.Buffer) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	var received bytes.Buffer
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if silent {
+			io.Copy(io.Discard, conn)
+			return
+		}
+
+		reader := bufio.NewReader(conn)
+		fmt.Fprint(conn, "220 localhost ready\r\n")
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			switch command := strings.ToUpper(strings.Fields(line + " ")[0]); command {
+			case "EHLO":
+				fmt.Fprint(conn, "250 localhost\r\n")
+			case "DATA":
+				fmt.Fprint(conn, "354 go ahead\r\n")
+				for {
+					line, err = reader.ReadString('\n')
+					if err != nil || line == ".\r\n" {
+						break
+					}
+					received.WriteString(line)
+				}
+				fmt.Fprint(conn, "250 queued\r\n")
+			case "QUIT":
+				fmt.Fprint(conn, "221 bye\r\n")
+				return
+			default:
+				fmt.Fprint(conn, "250 ok\r\n")
+			}
+		}
+	}()
+
+	return listener.Addr().String(), &received
+}
+
+func TestSMTPChannel(t *testing.T) {
+	msg := &notifications.Message{Recipient: "borrower@example.com", Subject: "Payment due", Body: "Please pay"}
+
+	t.Run("success mails the message", func(t *testing.T) {
+		addr, received := serveSMTP(t, false)
+		host, port, _ := net.SplitHostPort(addr)
+
+		channel := notifications.NewSMTPChannel(host, port, "", "", "billing@example.com", time.Second)
+		err := channel.Send(context.Background(), msg)
+
+		assert.NoError(t, err)
+		assert.Contains(t, received.String(), "Subject: Payment due")
+		assert.Contains(t, received.String(), "Please pay")
+	})
+
+	t.Run("error when the server does not answer before the context ends", func(t *testing.T) {
+		addr, _ := serveSMTP(t, true)
+		host, port, _ := net.SplitHostPort(addr)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		channel := notifications.NewSMTPChannel(host, port, "", "", "billing@example.com", time.Minute)
+		err := channel.Send(ctx, msg)
+
+		assert.Error(t, err)
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+}
+
+func TestWriterChannel(t *testing.T) {
+	var buf bytes.Buffer
+	channel := notifications.NewWriterChannel(&buf)
+
+	err := channel.Send(context.Background(), &notifications.Message{Recipient: "user1", Subject: "due", Body: "pay today"})
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"channel":"log","recipient":"user1","subject":"due","body":"pay today"}`, buf.String())
+}
+
+func TestNew(t *testing.T) {
+	t.Run("returns the configured channels", func(t *testing.T) {
+		channels, err := notifications.New(config.Config{NotificationChannels: []string{"log", "sms"}, SMSGatewayURL: "http://sms.local"})
+		assert.NoError(t, err)
+		assert.Len(t, channels, 2)
+		assert.Equal(t, notifications.CHANNEL_SMS, channels["sms"].Name())
+	})
+
+	t.Run("error on an unconfigured or unknown channel", func(t *testing.T) {
+		_, err := notifications.New(config.Config{NotificationChannels: []string{"smtp"}})
+		assert.Error(t, err)
+
+		_, err = notifications.New(config.Config{NotificationChannels: []string{"pigeon"}})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,62 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type smsChannel struct {
+	client *http.Client
+	url    string
+	token  string
+}
+
+// NewSMSChannel posts every message as {"to", "message"} to the gateway url, with the token as a bearer token when set.
+// Any status but 2xx fails the message
+func NewSMSChannel(client *http.Client, url string, token string) Channel {
+	return &smsChannel{
+		client: client,
+		url:    url,
+		token:  token,
+	}
+}
+
+func (c *smsChannel) Name() string {
+	return CHANNEL_SMS
+}
+
+func (c *smsChannel) Send(ctx context.Context, msg *Message) error {
+	data, err := json.Marshal(map[string]string{
+		"to":      msg.Recipient,
+		"message": msg.Body,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("sms gateway responded %s", resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+type smtpChannel struct {
+	host    string
+	addr    string
+	auth    smtp.Auth
+	from    string
+	timeout time.Duration
+}
+
+// NewSMTPChannel mails every message as plain UTF-8 text from the from address, over TLS when the server offers STARTTLS
+// and authenticating with username and password when a username is set. A message is abandoned after timeout
+func NewSMTPChannel(host string, port string, username string, password string, from string, timeout time.Duration) Channel {
+	channel := &smtpChannel{
+		host:    host,
+		addr:    net.JoinHostPort(host, port),
+		from:    from,
+		timeout: timeout,
+	}
+	if username != "" {
+		channel.auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return channel
+}
+
+func (c *smtpChannel) Name() string {
+	return CHANNEL_SMTP
+}
+
+func (c *smtpChannel) Send(ctx context.Context, msg *Message) error {
+	to, err := mail.ParseAddress(msg.Recipient)
+	if err != nil {
+		return fmt.Errorf("invalid recipient %q: %w", msg.Recipient, err)
+	}
+
+	dialer := net.Dialer{Timeout: c.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", c.addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	deadline := time.Now().Add(c.timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	if err = conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+	// a cancelled context interrupts the conversation at once
+	stop := context.AfterFunc(ctx, func() {
+		conn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	client, err := smtp.NewClient(conn, c.host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err = client.StartTLS(&tls.Config{ServerName: c.host}); err != nil {
+			return err
+		}
+	}
+	if c.auth != nil {
+		if err = client.Auth(c.auth); err != nil {
+			return err
+		}
+	}
+	if err = client.Mail(c.from); err != nil {
+		return err
+	}
+	if err = client.Rcpt(to.Address); err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(c.compose(to, msg)); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+func (c *smtpChannel) compose(to *mail.Address, msg *Message) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", c.from)
+	fmt.Fprintf(&buf, "To: %s\r\n", to.String())
+	// the subject is rendered from a template, a line break would start a header of its own
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", strings.Join(strings.Fields(msg.Subject), " ")))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(strings.ReplaceAll(msg.Body, "\r\n", "\n"), "\n", "\r\n"))
+	return buf.Bytes()
+}
@@ -0,0 +1,160 @@
+package notifications
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path"
+	"strings"
+	"text/template"
+	"time"
+)
+
+//go:embed templates
+var embedded embed.FS
+
+// PaymentReminder is the data of the payment reminder templates, Days counts the days until the due date
+// of an upcoming installment and the days past it of an overdue one
+type PaymentReminder struct {
+	LoanID           string
+	PaymentReference string
+	Amount           float64
+	DueAt            time.Time
+	Days             int
+}
+
+// Templates renders the message of a kind in the locale of the borrower. A template file <locale>/<kind>.tmpl
+// defines a "subject" and a "body" template, a locale missing a kind falls back to the default locale
+type Templates struct {
+	defaultLocale string
+	templates     map[string]*template.Template
+}
+
+// LoadTemplates reads the templates of dir, or the bundled ones when dir is empty
+func LoadTemplates(dir string, defaultLocale string) (*Templates, error) {
+	var fsys fs.FS = os.DirFS(dir)
+	if dir == "" {
+		sub, err := fs.Sub(embedded, "templates")
+		if err != nil {
+			return nil, err
+		}
+		fsys = sub
+	}
+
+	return ParseTemplates(fsys, defaultLocale)
+}
+
+func ParseTemplates(fsys fs.FS, defaultLocale string) (*Templates, error) {
+	files, err := fs.Glob(fsys, "*/*.tmpl")
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Templates{defaultLocale: defaultLocale, templates: map[string]*template.Template{}}
+	for _, file := range files {
+		locale := path.Dir(file)
+		kind := strings.TrimSuffix(path.Base(file), ".tmpl")
+
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+
+		tmpl, err := template.New(kind).Option("missingkey=error").Funcs(funcs(locale)).Parse(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("template %s: %w", file, err)
+		}
+		if tmpl.Lookup("subject") == nil || tmpl.Lookup("body") == nil {
+			return nil, fmt.Errorf("template %s must define subject and body", file)
+		}
+		t.templates[locale+"/"+kind] = tmpl
+	}
+
+	if !t.HasLocale(defaultLocale) {
+		return nil, fmt.Errorf("no templates for the default locale %q", defaultLocale)
+	}
+
+	return t, nil
+}
+
+func (t *Templates) HasLocale(locale string) bool {
+	for key := range t.templates {
+		if strings.HasPrefix(key, locale+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// Rendered is a message rendered in Locale, the default locale when the one asked for lacks the kind
+type Rendered struct {
+	Locale  string
+	Subject string
+	Body    string
+}
+
+func (t *Templates) Render(locale string, kind string, data any) (*Rendered, error) {
+	tmpl, ok := t.templates[locale+"/"+kind]
+	if !ok {
+		locale = t.defaultLocale
+		tmpl, ok = t.templates[locale+"/"+kind]
+	}
+	if !ok {
+		return nil, fmt.Errorf("no template for %s", kind)
+	}
+
+	var subject, body bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, err
+	}
+	if err := tmpl.ExecuteTemplate(&body, "body", data); err != nil {
+		return nil, err
+	}
+
+	return &Rendered{
+		Locale:  locale,
+		Subject: strings.TrimSpace(subject.String()),
+		Body:    strings.TrimSpace(body.String()),
+	}, nil
+}
+
+var monthsID = []string{"Januari", "Februari", "Maret", "April", "Mei", "Juni", "Juli", "Agustus", "September", "Oktober", "November", "Desember"}
+
+// funcs formats money and dates the way the locale writes them
+func funcs(locale string) template.FuncMap {
+	separator := ","
+	if locale == "id" {
+		separator = "."
+	}
+
+	return template.FuncMap{
+		"money": func(amount float64) string {
+			return groupThousands(int64(math.Round(amount)), separator)
+		},
+		"date": func(t time.Time) string {
+			if locale == "id" {
+				return fmt.Sprintf("%d %s %d", t.Day(), monthsID[t.Month()-1], t.Year())
+			}
+			return t.Format("2 January 2006")
+		},
+	}
+}
+
+func groupThousands(n int64, separator string) string {
+	sign := ""
+	if n < 0 {
+		sign, n = "-", -n
+	}
+
+	digits := fmt.Sprint(n)
+	var groups []string
+	for len(digits) > 3 {
+		groups = append([]string{digits[len(digits)-3:]}, groups...)
+		digits = digits[:len(digits)-3]
+	}
+
+	return sign + strings.Join(append([]string{digits}, groups...), separator)
+}
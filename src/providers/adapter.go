@@ -0,0 +1,38 @@
+// Package providers turns the payment notifications of each payment provider into provider payments
+package providers
+
+import (
+	"errors"
+	"github.com/verizhang/billing-engine/config"
+	"github.com/verizhang/billing-engine/src/entities"
+	"net/http"
+)
+
+var (
+	SignatureError = errors.New("invalid provider signature")
+	PayloadError   = errors.New("invalid provider payload")
+)
+
+// Adapter speaks the callback protocol of one provider
+type Adapter interface {
+	Name() string
+	// Verify checks the notification was sent by the provider, it fails with SignatureError
+	Verify(header http.Header, body []byte) error
+	// Parse reads the payment of a verified notification, it fails with PayloadError
+	Parse(body []byte) (*entities.ProviderPayment, error)
+}
+
+// New returns the adapters of the providers configured with a secret, keyed by name
+func New(cfg config.Config) map[string]Adapter {
+	adapters := map[string]Adapter{}
+	if cfg.ProviderGenericSecret != "" {
+		adapter := NewGenericAdapter(cfg.ProviderGenericSecret, cfg.ProviderSignatureTolerance)
+		adapters[adapter.Name()] = adapter
+	}
+	if cfg.ProviderXenditCallbackToken != "" {
+		adapter := NewXenditAdapter(cfg.ProviderXenditCallbackToken)
+		adapters[adapter.Name()] = adapter
+	}
+
+	return adapters
+}
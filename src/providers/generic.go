@@ -0,0 +1,65 @@
+package providers
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/utils/signature"
+	"net/http"
+	"time"
+)
+
+const PROVIDER_GENERIC = "generic"
+
+type genericAdapter struct {
+	secret    string
+	tolerance time.Duration
+}
+
+// NewGenericAdapter reads notifications signed like the outgoing webhooks, HMAC-SHA256 in the X-Billing-Signature header
+func NewGenericAdapter(secret string, tolerance time.Duration) Adapter {
+	return &genericAdapter{
+		secret:    secret,
+		tolerance: tolerance,
+	}
+}
+
+type genericNotification struct {
+	ID             string    `json:"id"`
+	VirtualAccount string    `json:"virtualAccount"`
+	Reference      string    `json:"reference"`
+	Amount         float64   `json:"amount"`
+	PaidAt         time.Time `json:"paidAt"`
+}
+
+func (a *genericAdapter) Name() string {
+	return PROVIDER_GENERIC
+}
+
+func (a *genericAdapter) Verify(header http.Header, body []byte) error {
+	err := signature.Verify(a.secret, header.Get(signature.HEADER), body, a.tolerance, time.Now())
+	if err != nil {
+		return fmt.Errorf("%w: %s", SignatureError, err.Error())
+	}
+
+	return nil
+}
+
+func (a *genericAdapter) Parse(body []byte) (*entities.ProviderPayment, error) {
+	var notification genericNotification
+	if err := json.Unmarshal(body, &notification); err != nil {
+		return nil, fmt.Errorf("%w: %s", PayloadError, err.Error())
+	}
+	if notification.ID == "" || notification.Amount <= 0 {
+		return nil, fmt.Errorf("%w: id and a positive amount are required", PayloadError)
+	}
+
+	return &entities.ProviderPayment{
+		Provider:       PROVIDER_GENERIC,
+		ExternalID:     notification.ID,
+		VirtualAccount: notification.VirtualAccount,
+		Reference:      notification.Reference,
+		Amount:         notification.Amount,
+		PaidAt:         notification.PaidAt,
+	}, nil
+}
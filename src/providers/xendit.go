@@ -0,0 +1,68 @@
+package providers
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"github.com/verizhang/billing-engine/src/entities"
+	"net/http"
+	"time"
+)
+
+const (
+	PROVIDER_XENDIT = "xendit"
+	// HEADER_XENDIT_CALLBACK_TOKEN carries the verification token of the Xendit account
+	HEADER_XENDIT_CALLBACK_TOKEN = "X-Callback-Token"
+)
+
+type xenditAdapter struct {
+	token string
+}
+
+// NewXenditAdapter reads the fixed virtual account payment callbacks of Xendit,
+// the external id of a virtual account is the loan it collects for
+func NewXenditAdapter(token string) Adapter {
+	return &xenditAdapter{
+		token: token,
+	}
+}
+
+type xenditNotification struct {
+	PaymentID            string    `json:"payment_id"`
+	ExternalID           string    `json:"external_id"`
+	AccountNumber        string    `json:"account_number"`
+	Amount               float64   `json:"amount"`
+	TransactionTimestamp time.Time `json:"transaction_timestamp"`
+}
+
+func (a *xenditAdapter) Name() string {
+	return PROVIDER_XENDIT
+}
+
+func (a *xenditAdapter) Verify(header http.Header, body []byte) error {
+	token := header.Get(HEADER_XENDIT_CALLBACK_TOKEN)
+	if subtle.ConstantTimeCompare([]byte(token), []byte(a.token)) != 1 {
+		return fmt.Errorf("%w: callback token does not match", SignatureError)
+	}
+
+	return nil
+}
+
+func (a *xenditAdapter) Parse(body []byte) (*entities.ProviderPayment, error) {
+	var notification xenditNotification
+	if err := json.Unmarshal(body, &notification); err != nil {
+		return nil, fmt.Errorf("%w: %s", PayloadError, err.Error())
+	}
+	if notification.PaymentID == "" || notification.Amount <= 0 {
+		return nil, fmt.Errorf("%w: payment_id and a positive amount are required", PayloadError)
+	}
+
+	return &entities.ProviderPayment{
+		Provider:       PROVIDER_XENDIT,
+		ExternalID:     notification.PaymentID,
+		VirtualAccount: notification.AccountNumber,
+		Reference:      notification.ExternalID,
+		Amount:         notification.Amount,
+		PaidAt:         notification.TransactionTimestamp,
+	}, nil
+}
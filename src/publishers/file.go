@@ -0,0 +1,50 @@
+package publishers
+
+import (
+	"context"
+	"github.com/verizhang/billing-engine/src/entities"
+	"os"
+	"sync"
+)
+
+type filePublisher struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFilePublisher appends every event as a line of JSON to the file at path
+func NewFilePublisher(path string) EventPublisher {
+	return &filePublisher{
+		path: path,
+	}
+}
+
+func (p *filePublisher) Publish(ctx context.Context, event *entities.OutboxEvent) error {
+	data, err := Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	file, err := os.OpenFile(p.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(append(data, '\n'))
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	// the event is marked published right after, it has to be on disk by then
+	err = file.Sync()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
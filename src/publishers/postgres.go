@@ -0,0 +1,30 @@
+package publishers
+
+import (
+	"context"
+	"github.com/verizhang/billing-engine/src/entities"
+	"gorm.io/gorm"
+)
+
+type postgresPublisher struct {
+	db      *gorm.DB
+	channel string
+}
+
+// NewPostgresPublisher sends every event with NOTIFY on channel, consumers receive them with LISTEN.
+// NOTIFY reaches only the listeners connected at the time, a consumer catching up reads the outbox_events table
+func NewPostgresPublisher(db *gorm.DB, channel string) EventPublisher {
+	return &postgresPublisher{
+		db:      db,
+		channel: channel,
+	}
+}
+
+func (p *postgresPublisher) Publish(ctx context.Context, event *entities.OutboxEvent) error {
+	data, err := Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return p.db.WithContext(ctx).Exec("SELECT pg_notify(?, ?)", p.channel, string(data)).Error
+}
@@ -0,0 +1,75 @@
+// Package publishers delivers the domain events of the outbox to the systems downstream
+package publishers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/verizhang/billing-engine/config"
+	"github.com/verizhang/billing-engine/src/entities"
+	"gorm.io/gorm"
+	"net/http"
+	"os"
+	"time"
+)
+
+const (
+	PUBLISHER_STDOUT   = "stdout"
+	PUBLISHER_FILE     = "file"
+	PUBLISHER_WEBHOOK  = "webhook"
+	PUBLISHER_POSTGRES = "postgres"
+)
+
+// EventPublisher delivers one event, an error leaves the event in the outbox to be published again.
+// Delivery is at least once, consumers deduplicate by the event id
+type EventPublisher interface {
+	Publish(ctx context.Context, event *entities.OutboxEvent) error
+}
+
+// Envelope is the message published for an event, Data is the event of its Type
+type Envelope struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	LoanID     string          `json:"loanId"`
+	Sequence   int64           `json:"sequence"`
+	RequestID  string          `json:"requestId,omitempty"`
+	OccurredAt time.Time       `json:"occurredAt"`
+	Data       json.RawMessage `json:"data"`
+}
+
+func Marshal(event *entities.OutboxEvent) ([]byte, error) {
+	data, err := json.Marshal(&Envelope{
+		ID:         event.ID,
+		Type:       event.Type,
+		LoanID:     event.LoanID,
+		Sequence:   event.Sequence,
+		RequestID:  event.RequestID,
+		OccurredAt: event.CreatedAt,
+		Data:       json.RawMessage(event.Payload),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal event %s: %w", event.ID, err)
+	}
+
+	return data, nil
+}
+
+// New returns the publisher selected by EVENT_PUBLISHER
+func New(cfg config.Config, db *gorm.DB) (EventPublisher, error) {
+	switch cfg.EventPublisher {
+	case PUBLISHER_STDOUT:
+		return NewWriterPublisher(os.Stdout), nil
+	case PUBLISHER_FILE:
+		return NewFilePublisher(cfg.EventFilePath), nil
+	case PUBLISHER_WEBHOOK:
+		if cfg.EventWebhookURL == "" {
+			return nil, errors.New("EVENT_WEBHOOK_URL is required by the webhook publisher")
+		}
+		return NewWebhookPublisher(&http.Client{Timeout: cfg.EventWebhookTimeout}, cfg.EventWebhookURL, cfg.EventWebhookSecret), nil
+	case PUBLISHER_POSTGRES:
+		return NewPostgresPublisher(db, cfg.EventNotifyChannel), nil
+	}
+
+	return nil, fmt.Errorf("unknown event publisher %q", cfg.EventPublisher)
+}
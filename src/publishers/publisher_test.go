@@ -0,0 +1,109 @@
+package publishers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/publishers"
+	"github.com/verizhang/billing-engine/src/utils/signature"
+)
+
+func newEvent(id string) *entities.OutboxEvent {
+	return &entities.OutboxEvent{
+		ID:        id,
+		LoanID:    "loan1",
+		Sequence:  1,
+		Type:      entities.EVENT_LOAN_CREATED,
+		Payload:   `{"loanId":"loan1"}`,
+		RequestID: "request1",
+		CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestWriterPublisher(t *testing.T) {
+	var buf bytes.Buffer
+	publisher := publishers.NewWriterPublisher(&buf)
+
+	assert.NoError(t, publisher.Publish(context.Background(), newEvent("event1")))
+	assert.NoError(t, publisher.Publish(context.Background(), newEvent("event2")))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 2)
+
+	var envelope publishers.Envelope
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &envelope))
+	assert.Equal(t, "event1", envelope.ID)
+	assert.Equal(t, entities.EVENT_LOAN_CREATED, envelope.Type)
+	assert.Equal(t, "request1", envelope.RequestID)
+	assert.JSONEq(t, `{"loanId":"loan1"}`, string(envelope.Data))
+}
+
+func TestFilePublisher(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	publisher := publishers.NewFilePublisher(path)
+
+	assert.NoError(t, publisher.Publish(context.Background(), newEvent("event1")))
+	assert.NoError(t, publisher.Publish(context.Background(), newEvent("event2")))
+
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(string(data), "\n"))
+}
+
+func TestWebhookPublisher(t *testing.T) {
+	t.Run("success posts the envelope", func(t *testing.T) {
+		var received *http.Request
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		publisher := publishers.NewWebhookPublisher(server.Client(), server.URL, "")
+		err := publisher.Publish(context.Background(), newEvent("event1"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.MethodPost, received.Method)
+		assert.Equal(t, "event1", received.Header.Get(publishers.HEADER_EVENT_ID))
+		assert.Equal(t, entities.EVENT_LOAN_CREATED, received.Header.Get(publishers.HEADER_EVENT_TYPE))
+	})
+
+	t.Run("success signs the body with the secret", func(t *testing.T) {
+		var header string
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header = r.Header.Get(signature.HEADER)
+			body, _ = io.ReadAll(r.Body)
+		}))
+		defer server.Close()
+
+		publisher := publishers.NewWebhookPublisher(server.Client(), server.URL, "secret")
+		err := publisher.Publish(context.Background(), newEvent("event1"))
+
+		assert.NoError(t, err)
+		assert.NoError(t, signature.Verify("secret", header, body, time.Minute, time.Now()))
+	})
+
+	t.Run("error when the receiver does not accept it", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		publisher := publishers.NewWebhookPublisher(server.Client(), server.URL, "")
+		err := publisher.Publish(context.Background(), newEvent("event1"))
+
+		assert.ErrorContains(t, err, "503")
+	})
+}
@@ -0,0 +1,32 @@
+package publishers
+
+import (
+	"context"
+	"github.com/verizhang/billing-engine/src/entities"
+	"io"
+	"sync"
+)
+
+type writerPublisher struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriterPublisher writes every event as a line of JSON, the stdout publisher writes to os.Stdout
+func NewWriterPublisher(w io.Writer) EventPublisher {
+	return &writerPublisher{
+		w: w,
+	}
+}
+
+func (p *writerPublisher) Publish(ctx context.Context, event *entities.OutboxEvent) error {
+	data, err := Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, err = p.w.Write(append(data, '\n'))
+	return err
+}
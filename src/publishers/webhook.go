@@ -0,0 +1,74 @@
+package publishers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/utils/signature"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	HEADER_EVENT_ID    = "X-Event-Id"
+	HEADER_EVENT_TYPE  = "X-Event-Type"
+	HEADER_DELIVERY_ID = "X-Delivery-Id"
+)
+
+type webhookPublisher struct {
+	client *http.Client
+	url    string
+	secret string
+}
+
+// NewWebhookPublisher posts every event to url, signed with secret when set, any status but 2xx fails the delivery
+func NewWebhookPublisher(client *http.Client, url string, secret string) EventPublisher {
+	return &webhookPublisher{
+		client: client,
+		url:    url,
+		secret: secret,
+	}
+}
+
+func (p *webhookPublisher) Publish(ctx context.Context, event *entities.OutboxEvent) error {
+	_, err := PostEvent(ctx, p.client, p.url, p.secret, "", event)
+	return err
+}
+
+// PostEvent posts the envelope of event to url and returns the response status, 0 when no response arrived.
+// With a secret the body is signed in the X-Billing-Signature header, deliveryID is sent when set
+func PostEvent(ctx context.Context, client *http.Client, url string, secret string, deliveryID string, event *entities.OutboxEvent) (int, error) {
+	data, err := Marshal(event)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HEADER_EVENT_ID, event.ID)
+	req.Header.Set(HEADER_EVENT_TYPE, event.Type)
+	if deliveryID != "" {
+		req.Header.Set(HEADER_DELIVERY_ID, deliveryID)
+	}
+	if secret != "" {
+		req.Header.Set(signature.HEADER, signature.Sign(secret, time.Now(), data))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook responded %s", resp.Status)
+	}
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,97 @@
+package repositories
+
+import (
+	"github.com/verizhang/billing-engine/src/utils/auth"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+	"reflect"
+	"slices"
+)
+
+const (
+	COLUMN_CREATED_BY = "created_by"
+	COLUMN_UPDATED_BY = "updated_by"
+	COLUMN_DELETED_BY = "deleted_by"
+	COLUMN_DELETED_AT = "deleted_at"
+)
+
+// RegisterAuditCallbacks stamps the audit columns of every write with the actor of the statement context,
+// repositories pass the request context with WithContext so the principal reaches the callbacks
+func RegisterAuditCallbacks(db *gorm.DB) error {
+	err := db.Callback().Create().Before("gorm:create").Register("audit:create", auditCreate)
+	if err != nil {
+		return err
+	}
+
+	return db.Callback().Update().Before("gorm:update").Register("audit:update", auditUpdate)
+}
+
+// auditCreate fills created_by and updated_by of the created rows unless they were set explicitly
+func auditCreate(db *gorm.DB) {
+	if db.Statement.Schema == nil {
+		return
+	}
+
+	actor := auth.Actor(db.Statement.Context)
+	for _, column := range []string{COLUMN_CREATED_BY, COLUMN_UPDATED_BY} {
+		field := db.Statement.Schema.LookUpField(column)
+		if field == nil {
+			continue
+		}
+
+		switch db.Statement.ReflectValue.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < db.Statement.ReflectValue.Len(); i++ {
+				setIfZero(db, field, reflect.Indirect(db.Statement.ReflectValue.Index(i)), actor)
+			}
+		case reflect.Struct:
+			setIfZero(db, field, db.Statement.ReflectValue, actor)
+		}
+	}
+}
+
+func setIfZero(db *gorm.DB, field *schema.Field, value reflect.Value, actor string) {
+	if _, isZero := field.ValueOf(db.Statement.Context, value); !isZero {
+		return
+	}
+
+	err := field.Set(db.Statement.Context, value, actor)
+	if err != nil {
+		db.AddError(err)
+	}
+}
+
+// auditUpdate records the actor in updated_by, and in deleted_by when the update soft deletes the rows
+func auditUpdate(db *gorm.DB) {
+	if db.Statement.Schema == nil || db.Statement.Schema.LookUpField(COLUMN_UPDATED_BY) == nil {
+		return
+	}
+
+	actor := auth.Actor(db.Statement.Context)
+	columns := []string{COLUMN_UPDATED_BY}
+	if isSoftDelete(db) && db.Statement.Schema.LookUpField(COLUMN_DELETED_BY) != nil {
+		columns = append(columns, COLUMN_DELETED_BY)
+	}
+
+	for _, column := range columns {
+		db.Statement.SetColumn(column, actor, true)
+		if len(db.Statement.Selects) > 0 && !slices.Contains(db.Statement.Selects, "*") {
+			db.Statement.Selects = append(db.Statement.Selects, column)
+		}
+	}
+}
+
+func isSoftDelete(db *gorm.DB) bool {
+	values, ok := db.Statement.Dest.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	deletedAt, ok := values[COLUMN_DELETED_AT]
+	if !ok || deletedAt == nil {
+		return false
+	}
+
+	value := reflect.ValueOf(deletedAt)
+	return value.Kind() != reflect.Pointer || !value.IsNil()
+}
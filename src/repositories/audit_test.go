@@ -0,0 +1,106 @@
+package repositories_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/repositories"
+	"github.com/verizhang/billing-engine/src/utils/auth"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type statement struct {
+	sql  string
+	vars []any
+}
+
+// dryRunDB builds the statements without a database and records them
+func dryRunDB(t *testing.T) (*gorm.DB, *[]statement) {
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true})
+	assert.NoError(t, err)
+	assert.NoError(t, repositories.RegisterAuditCallbacks(db))
+
+	var statements []statement
+	record := func(db *gorm.DB) {
+		statements = append(statements, statement{sql: db.Statement.SQL.String(), vars: db.Statement.Vars})
+	}
+	assert.NoError(t, db.Callback().Create().After("gorm:create").Register("test:record", record))
+	assert.NoError(t, db.Callback().Update().After("gorm:update").Register("test:record", record))
+
+	return db, &statements
+}
+
+func TestAuditCallbacks(t *testing.T) {
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "user1", Role: auth.ROLE_BORROWER})
+	now := time.Now()
+
+	t.Run("create stamps created_by and updated_by of every row", func(t *testing.T) {
+		db, statements := dryRunDB(t)
+		payments := []*entities.Payment{{ID: "payment1"}, {ID: "payment2"}}
+
+		err := repositories.NewPaymentRepository(db).CreatePayments(ctx, payments)
+
+		assert.NoError(t, err)
+		assert.Len(t, *statements, 1)
+		for _, payment := range payments {
+			assert.Equal(t, "user1", payment.CreatedBy)
+			assert.Equal(t, "user1", payment.UpdatedBy)
+		}
+	})
+
+	t.Run("create keeps an explicit actor", func(t *testing.T) {
+		db, _ := dryRunDB(t)
+		loan := &entities.Loan{ID: "loan1", CreatedBy: "ops1"}
+
+		err := repositories.NewLoanRepository(db).CreateLoan(ctx, loan)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ops1", loan.CreatedBy)
+		assert.Equal(t, "user1", loan.UpdatedBy)
+	})
+
+	t.Run("create without principal records the system", func(t *testing.T) {
+		db, _ := dryRunDB(t)
+		loan := &entities.Loan{ID: "loan1"}
+
+		err := repositories.NewLoanRepository(db).CreateLoan(context.Background(), loan)
+
+		assert.NoError(t, err)
+		assert.Equal(t, auth.ACTOR_SYSTEM, loan.CreatedBy)
+	})
+
+	t.Run("update stamps updated_by", func(t *testing.T) {
+		db, statements := dryRunDB(t)
+
+		_, err := repositories.NewPaymentRepository(db).UpdatePaidAtPayment(ctx, "payment1", &now)
+
+		assert.NoError(t, err)
+		assert.Contains(t, (*statements)[0].sql, `"updated_by"=`)
+		assert.NotContains(t, (*statements)[0].sql, `"deleted_by"`)
+		assert.Contains(t, (*statements)[0].vars, "user1")
+	})
+
+	t.Run("update with selected columns stamps updated_by", func(t *testing.T) {
+		db, statements := dryRunDB(t)
+
+		err := repositories.NewPaymentRepository(db).UpdatePayments(ctx, []*entities.Payment{{ID: "payment1", Amount: 100}})
+
+		assert.NoError(t, err)
+		assert.Contains(t, (*statements)[0].sql, `"updated_by"=`)
+		assert.Contains(t, (*statements)[0].vars, "user1")
+	})
+
+	t.Run("soft delete stamps deleted_by", func(t *testing.T) {
+		db, statements := dryRunDB(t)
+
+		_, err := repositories.NewPaymentRepository(db).SoftDeletePayments(ctx, []string{"payment1"}, &now)
+
+		assert.NoError(t, err)
+		assert.Contains(t, (*statements)[0].sql, `"deleted_by"=`)
+		assert.Contains(t, (*statements)[0].sql, `"updated_by"=`)
+	})
+}
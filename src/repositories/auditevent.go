@@ -0,0 +1,59 @@
+package repositories
+
+import (
+	"context"
+	"github.com/verizhang/billing-engine/src/entities"
+	"gorm.io/gorm"
+)
+
+type AuditEventRepository interface {
+	CreateAuditEvent(ctx context.Context, event *entities.AuditEvent) error
+	GetLastAuditEventByLoanID(ctx context.Context, loanID string) (*entities.AuditEvent, error)
+	GetLastAuditEventByEntity(ctx context.Context, entityType string, entityID string) (*entities.AuditEvent, error)
+	GetAuditEventsByLoanID(ctx context.Context, loanID string) ([]*entities.AuditEvent, error)
+}
+
+type auditEventRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditEventRepository(db *gorm.DB) AuditEventRepository {
+	return &auditEventRepository{
+		db: db,
+	}
+}
+
+func (r *auditEventRepository) CreateAuditEvent(ctx context.Context, event *entities.AuditEvent) error {
+	if err := r.db.WithContext(ctx).Create(event).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *auditEventRepository) GetLastAuditEventByLoanID(ctx context.Context, loanID string) (*entities.AuditEvent, error) {
+	var event entities.AuditEvent
+	if err := r.db.WithContext(ctx).Where("loan_id = ?", loanID).Order("sequence DESC").First(&event).Error; err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+// GetLastAuditEventByEntity returns the last event of the chain of an entity outside of a loan
+func (r *auditEventRepository) GetLastAuditEventByEntity(ctx context.Context, entityType string, entityID string) (*entities.AuditEvent, error) {
+	var event entities.AuditEvent
+	if err := r.db.WithContext(ctx).Where("loan_id IS NULL AND entity_type = ? AND entity_id = ?", entityType, entityID).Order("sequence DESC").First(&event).Error; err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+func (r *auditEventRepository) GetAuditEventsByLoanID(ctx context.Context, loanID string) ([]*entities.AuditEvent, error) {
+	var events []*entities.AuditEvent
+	if err := r.db.WithContext(ctx).Where("loan_id = ?", loanID).Order("sequence ASC").Find(&events).Error; err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
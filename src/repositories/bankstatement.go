@@ -0,0 +1,153 @@
+package repositories
+
+import (
+	"context"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/utils/pagination"
+	"gorm.io/gorm"
+	"time"
+)
+
+type BankStatementImportRepository interface {
+	CreateBankStatementImport(ctx context.Context, statementImport *entities.BankStatementImport) error
+	GetBankStatementImportByFileHash(ctx context.Context, fileHash string) (*entities.BankStatementImport, error)
+	CountBankStatementImportsByDate(ctx context.Context, from time.Time, to time.Time) (int64, error)
+}
+
+type bankStatementImportRepository struct {
+	db *gorm.DB
+}
+
+func NewBankStatementImportRepository(db *gorm.DB) BankStatementImportRepository {
+	return &bankStatementImportRepository{
+		db: db,
+	}
+}
+
+func (r *bankStatementImportRepository) CreateBankStatementImport(ctx context.Context, statementImport *entities.BankStatementImport) error {
+	if err := r.db.WithContext(ctx).Create(statementImport).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *bankStatementImportRepository) GetBankStatementImportByFileHash(ctx context.Context, fileHash string) (*entities.BankStatementImport, error) {
+	var statementImport entities.BankStatementImport
+	if err := r.db.WithContext(ctx).Where("file_hash = ?", fileHash).First(&statementImport).Error; err != nil {
+		return nil, err
+	}
+
+	return &statementImport, nil
+}
+
+func (r *bankStatementImportRepository) CountBankStatementImportsByDate(ctx context.Context, from time.Time, to time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&entities.BankStatementImport{}).
+		Where("imported_at >= ? AND imported_at < ?", from, to).
+		Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+type BankStatementLineRepository interface {
+	CreateBankStatementLines(ctx context.Context, lines []*entities.BankStatementLine) error
+	GetBankStatementLineByID(ctx context.Context, ID string) (*entities.BankStatementLine, error)
+	GetBankStatementLinesByImportID(ctx context.Context, importID string) ([]*entities.BankStatementLine, error)
+	GetBankStatementLines(ctx context.Context, filter *entities.BankStatementLineFilter, cursor *pagination.Cursor, limit int) ([]*entities.BankStatementLine, error)
+	GetReconciliationTotalsByDate(ctx context.Context, from time.Time, to time.Time) ([]*entities.ReconciliationTotal, error)
+	UpdateBankStatementLine(ctx context.Context, line *entities.BankStatementLine) error
+}
+
+type bankStatementLineRepository struct {
+	db *gorm.DB
+}
+
+func NewBankStatementLineRepository(db *gorm.DB) BankStatementLineRepository {
+	return &bankStatementLineRepository{
+		db: db,
+	}
+}
+
+func (r *bankStatementLineRepository) CreateBankStatementLines(ctx context.Context, lines []*entities.BankStatementLine) error {
+	if err := r.db.WithContext(ctx).Create(lines).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *bankStatementLineRepository) GetBankStatementLineByID(ctx context.Context, ID string) (*entities.BankStatementLine, error) {
+	var line entities.BankStatementLine
+	if err := r.db.WithContext(ctx).Where("id = ?", ID).First(&line).Error; err != nil {
+		return nil, err
+	}
+
+	return &line, nil
+}
+
+func (r *bankStatementLineRepository) GetBankStatementLinesByImportID(ctx context.Context, importID string) ([]*entities.BankStatementLine, error) {
+	var lines []*entities.BankStatementLine
+	if err := r.db.WithContext(ctx).Where("import_id = ?", importID).Order("line_no ASC").Find(&lines).Error; err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
+
+// GetBankStatementLines returns lines ordered by created_at and id descending
+func (r *bankStatementLineRepository) GetBankStatementLines(ctx context.Context, filter *entities.BankStatementLineFilter, cursor *pagination.Cursor, limit int) ([]*entities.BankStatementLine, error) {
+	var lines []*entities.BankStatementLine
+	query := r.db.WithContext(ctx)
+	if filter.ImportID != "" {
+		query = query.Where("import_id = ?", filter.ImportID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", cursor.Time, cursor.ID)
+	}
+
+	err := query.Order("created_at DESC, id DESC").Limit(limit).Find(&lines).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return lines, nil
+}
+
+// GetReconciliationTotalsByDate counts and sums the lines of the statements imported in [from, to) by status
+func (r *bankStatementLineRepository) GetReconciliationTotalsByDate(ctx context.Context, from time.Time, to time.Time) ([]*entities.ReconciliationTotal, error) {
+	var totals []*entities.ReconciliationTotal
+	err := r.db.WithContext(ctx).Table("bank_statement_lines AS l").
+		Select("l.status AS status, COUNT(*) AS lines, COALESCE(SUM(l.amount), 0) AS amount").
+		Joins("JOIN bank_statement_imports AS i ON i.id = l.import_id").
+		Where("i.imported_at >= ? AND i.imported_at < ?", from, to).
+		Group("l.status").
+		Order("l.status").
+		Scan(&totals).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return totals, nil
+}
+
+func (r *bankStatementLineRepository) UpdateBankStatementLine(ctx context.Context, line *entities.BankStatementLine) error {
+	err := r.db.WithContext(ctx).Model(&entities.BankStatementLine{}).Where("id = ?", line.ID).Updates(map[string]interface{}{
+		"status":      line.Status,
+		"reason":      line.Reason,
+		"loan_id":     line.LoanID,
+		"resolved_by": line.ResolvedBy,
+		"resolved_at": line.ResolvedAt,
+		"note":        line.Note,
+		"updated_at":  line.UpdatedAt,
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
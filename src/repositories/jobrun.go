@@ -0,0 +1,112 @@
+package repositories
+
+import (
+	"context"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/utils/pagination"
+	"gorm.io/gorm"
+	"time"
+)
+
+// JOB_LOCK is the advisory lock class of the scheduled jobs, each job locks its own key within it
+const JOB_LOCK = 7340003
+
+type JobRunRepository interface {
+	CreateJobRun(ctx context.Context, run *entities.JobRun) error
+	UpdateJobRun(ctx context.Context, run *entities.JobRun) error
+	GetJobRuns(ctx context.Context, filter *entities.JobRunFilter, cursor *pagination.Cursor, limit int) ([]*entities.JobRun, error)
+	FailRunningJobRuns(ctx context.Context, job string, finishedAt time.Time, reason string) error
+	WithJobLock(ctx context.Context, job string, fn func() error) (bool, error)
+}
+
+type jobRunRepository struct {
+	db *gorm.DB
+}
+
+func NewJobRunRepository(db *gorm.DB) JobRunRepository {
+	return &jobRunRepository{
+		db: db,
+	}
+}
+
+func (r *jobRunRepository) CreateJobRun(ctx context.Context, run *entities.JobRun) error {
+	if err := r.db.WithContext(ctx).Create(run).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *jobRunRepository) UpdateJobRun(ctx context.Context, run *entities.JobRun) error {
+	err := r.db.WithContext(ctx).Model(&entities.JobRun{}).Where("id = ?", run.ID).Updates(map[string]interface{}{
+		"finished_at": run.FinishedAt,
+		"duration_ms": run.DurationMs,
+		"status":      run.Status,
+		"items":       run.Items,
+		"error":       run.Error,
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetJobRuns returns runs ordered by started_at and id descending
+func (r *jobRunRepository) GetJobRuns(ctx context.Context, filter *entities.JobRunFilter, cursor *pagination.Cursor, limit int) ([]*entities.JobRun, error) {
+	var runs []*entities.JobRun
+	query := r.db.WithContext(ctx)
+	if filter.Job != "" {
+		query = query.Where("job = ?", filter.Job)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if cursor != nil {
+		query = query.Where("(started_at, id) < (?, ?)", cursor.Time, cursor.ID)
+	}
+
+	err := query.Order("started_at DESC, id DESC").Limit(limit).Find(&runs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return runs, nil
+}
+
+// FailRunningJobRuns marks the runs of the job left running as failed, called under the lock of the job they can only be
+// runs of a replica that stopped before finishing
+func (r *jobRunRepository) FailRunningJobRuns(ctx context.Context, job string, finishedAt time.Time, reason string) error {
+	err := r.db.WithContext(ctx).Model(&entities.JobRun{}).Where("job = ? AND status = ?", job, entities.JOB_RUN_STATUS_RUNNING).Updates(map[string]interface{}{
+		"finished_at": finishedAt,
+		"status":      entities.JOB_RUN_STATUS_FAILED,
+		"error":       reason,
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WithJobLock runs fn holding the session lock of the job on a connection of its own, no transaction stays open meanwhile.
+// It returns false without running fn when another replica holds the lock, a replica that dies releases it with its connection
+func (r *jobRunRepository) WithJobLock(ctx context.Context, job string, fn func() error) (bool, error) {
+	locked := false
+	err := r.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		if err := conn.Raw("SELECT pg_try_advisory_lock(?, hashtext(?))", JOB_LOCK, job).Scan(&locked).Error; err != nil {
+			return err
+		}
+		if !locked {
+			return nil
+		}
+		// unlocked even when the run was cancelled, the connection goes back to the pool
+		defer conn.WithContext(context.Background()).Exec("SELECT pg_advisory_unlock(?, hashtext(?))", JOB_LOCK, job)
+
+		return fn()
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return locked, nil
+}
@@ -3,13 +3,30 @@ package repositories
 import (
 	"context"
 	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/utils/pagination"
 	"gorm.io/gorm"
+	"time"
 )
 
+// USER_LOANS_LOCK is the advisory lock class serialising the borrowing of a user, each user locks its own key within it
+const USER_LOANS_LOCK = 7340004
+
 type LoanRepository interface {
 	CreateLoan(ctx context.Context, loan *entities.Loan) error
+	GetLoanByID(ctx context.Context, ID string) (*entities.Loan, error)
+	GetLoanByPaymentReference(ctx context.Context, paymentReference string) (*entities.Loan, error)
 	GetActiveLoansByUserID(ctx context.Context, userID string) ([]*entities.Loan, error)
+	GetLoansByUserID(ctx context.Context, userID string, statuses []string, cursor *pagination.Cursor, limit int) ([]*entities.Loan, error)
 	UpdateIsActiveLoanByID(ctx context.Context, ID string, isActive bool) error
+	UpdateInterestLoanByID(ctx context.Context, ID string, interest float64) error
+	AddInterestLoanByID(ctx context.Context, ID string, interest float64) error
+	AddFeeLoanByID(ctx context.Context, ID string, fee float64) error
+	UpdateStatusLoanByID(ctx context.Context, ID string, status string) error
+	UpdateRefinancedLoanByID(ctx context.Context, ID string, refinancedByLoanID string) (bool, error)
+	GetPastDueLoans(ctx context.Context, dueBefore time.Time) ([]*entities.Loan, error)
+	GetDelinquentLoans(ctx context.Context) ([]*entities.Loan, error)
+	UpdateDelinquentAtLoanByID(ctx context.Context, ID string, delinquentAt *time.Time) error
+	LockUserLoans(ctx context.Context, userID string) error
 }
 
 type loanRepository struct {
@@ -23,16 +40,53 @@ func NewLoanRepository(db *gorm.DB) LoanRepository {
 }
 
 func (r *loanRepository) CreateLoan(ctx context.Context, loan *entities.Loan) error {
-	if err := r.db.Create(loan).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(loan).Error; err != nil {
 		return err
 	}
 
 	return nil
 }
 
+func (r *loanRepository) GetLoanByID(ctx context.Context, ID string) (*entities.Loan, error) {
+	var loan entities.Loan
+	if err := r.db.WithContext(ctx).Where("id = ?", ID).First(&loan).Error; err != nil {
+		return nil, err
+	}
+
+	return &loan, nil
+}
+
+func (r *loanRepository) GetLoanByPaymentReference(ctx context.Context, paymentReference string) (*entities.Loan, error) {
+	var loan entities.Loan
+	if err := r.db.WithContext(ctx).Where("payment_reference = ?", paymentReference).First(&loan).Error; err != nil {
+		return nil, err
+	}
+
+	return &loan, nil
+}
+
 func (r *loanRepository) GetActiveLoansByUserID(ctx context.Context, userID string) ([]*entities.Loan, error) {
 	var loans []*entities.Loan
-	err := r.db.Where("user_id = ? AND is_active = true", userID).Find(&loans).Error
+	err := r.db.WithContext(ctx).Where("user_id = ? AND is_active = true", userID).Order("created_at ASC").Find(&loans).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return loans, nil
+}
+
+// GetLoansByUserID returns the loans of a user newest first, starting after the cursor when given
+func (r *loanRepository) GetLoansByUserID(ctx context.Context, userID string, statuses []string, cursor *pagination.Cursor, limit int) ([]*entities.Loan, error) {
+	var loans []*entities.Loan
+	query := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if len(statuses) > 0 {
+		query = query.Where("status IN ?", statuses)
+	}
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", cursor.Time, cursor.ID)
+	}
+
+	err := query.Order("created_at DESC, id DESC").Limit(limit).Find(&loans).Error
 	if err != nil {
 		return nil, err
 	}
@@ -41,7 +95,108 @@ func (r *loanRepository) GetActiveLoansByUserID(ctx context.Context, userID stri
 }
 
 func (r *loanRepository) UpdateIsActiveLoanByID(ctx context.Context, ID string, isActive bool) error {
-	if err := r.db.Model(&entities.Loan{}).Where("id = ?", ID).Update("is_active", isActive).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&entities.Loan{}).Where("id = ?", ID).Update("is_active", isActive).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *loanRepository) UpdateInterestLoanByID(ctx context.Context, ID string, interest float64) error {
+	if err := r.db.WithContext(ctx).Model(&entities.Loan{}).Where("id = ?", ID).Update("interest", interest).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AddFeeLoanByID adds the fee in the update itself, so concurrent fees are not lost
+func (r *loanRepository) AddInterestLoanByID(ctx context.Context, ID string, interest float64) error {
+	if err := r.db.WithContext(ctx).Model(&entities.Loan{}).Where("id = ?", ID).Update("interest", gorm.Expr("interest + ?", interest)).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *loanRepository) AddFeeLoanByID(ctx context.Context, ID string, fee float64) error {
+	if err := r.db.WithContext(ctx).Model(&entities.Loan{}).Where("id = ?", ID).Update("fee", gorm.Expr("fee + ?", fee)).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateStatusLoanByID sets the loan status, a loan stays active only while its status is active
+func (r *loanRepository) UpdateStatusLoanByID(ctx context.Context, ID string, status string) error {
+	err := r.db.WithContext(ctx).Model(&entities.Loan{}).Where("id = ?", ID).Updates(map[string]interface{}{
+		"status":    status,
+		"is_active": status == entities.LOAN_STATUS_ACTIVE,
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateRefinancedLoanByID closes an active loan as refinanced, false when it was no longer active
+func (r *loanRepository) UpdateRefinancedLoanByID(ctx context.Context, ID string, refinancedByLoanID string) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&entities.Loan{}).Where("id = ? AND status = ?", ID, entities.LOAN_STATUS_ACTIVE).Updates(map[string]interface{}{
+		"status":                entities.LOAN_STATUS_REFINANCED,
+		"is_active":             false,
+		"refinanced_by_loan_id": refinancedByLoanID,
+	})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func (r *loanRepository) GetPastDueLoans(ctx context.Context, dueBefore time.Time) ([]*entities.Loan, error) {
+	var loans []*entities.Loan
+	err := r.db.WithContext(ctx).Where("status = ?", entities.LOAN_STATUS_ACTIVE).
+		Where("EXISTS (SELECT 1 FROM payments WHERE payments.loan_id = loans.id AND payments.paid_at IS NULL AND payments.deleted_at IS NULL AND payments.end_at < ?)", dueBefore).
+		Order("created_at ASC").
+		Find(&loans).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return loans, nil
+}
+
+// GetDelinquentLoans returns the active loans marked delinquent by the last sweep
+func (r *loanRepository) GetDelinquentLoans(ctx context.Context) ([]*entities.Loan, error) {
+	var loans []*entities.Loan
+	err := r.db.WithContext(ctx).Where("status = ?", entities.LOAN_STATUS_ACTIVE).
+		Where("delinquent_at IS NOT NULL").
+		Order("created_at ASC").
+		Find(&loans).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return loans, nil
+}
+
+func (r *loanRepository) UpdateDelinquentAtLoanByID(ctx context.Context, ID string, delinquentAt *time.Time) error {
+	err := r.db.WithContext(ctx).Model(&entities.Loan{}).Where("id = ?", ID).Updates(map[string]interface{}{
+		"delinquent_at": delinquentAt,
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// LockUserLoans waits for the lock of the loans of the user until the end of the transaction, the changes to the loans
+// of the user read and write under it so concurrent requests can not both pass their checks or overwrite each other
+func (r *loanRepository) LockUserLoans(ctx context.Context, userID string) error {
+	err := r.db.WithContext(ctx).Exec("SELECT pg_advisory_xact_lock(?, hashtext(?))", USER_LOANS_LOCK, userID).Error
+	if err != nil {
 		return err
 	}
 
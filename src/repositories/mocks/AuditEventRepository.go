@@ -0,0 +1,262 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package repositories
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	entities "github.com/verizhang/billing-engine/src/entities"
+)
+
+// AuditEventRepository is an autogenerated mock type for the AuditEventRepository type
+type AuditEventRepository struct {
+	mock.Mock
+}
+
+type AuditEventRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AuditEventRepository) EXPECT() *AuditEventRepository_Expecter {
+	return &AuditEventRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateAuditEvent provides a mock function with given fields: ctx, event
+func (_m *AuditEventRepository) CreateAuditEvent(ctx context.Context, event *entities.AuditEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAuditEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.AuditEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuditEventRepository_CreateAuditEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuditEvent'
+type AuditEventRepository_CreateAuditEvent_Call struct {
+	*mock.Call
+}
+
+// CreateAuditEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *entities.AuditEvent
+func (_e *AuditEventRepository_Expecter) CreateAuditEvent(ctx interface{}, event interface{}) *AuditEventRepository_CreateAuditEvent_Call {
+	return &AuditEventRepository_CreateAuditEvent_Call{Call: _e.mock.On("CreateAuditEvent", ctx, event)}
+}
+
+func (_c *AuditEventRepository_CreateAuditEvent_Call) Run(run func(ctx context.Context, event *entities.AuditEvent)) *AuditEventRepository_CreateAuditEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.AuditEvent))
+	})
+	return _c
+}
+
+func (_c *AuditEventRepository_CreateAuditEvent_Call) Return(_a0 error) *AuditEventRepository_CreateAuditEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuditEventRepository_CreateAuditEvent_Call) RunAndReturn(run func(context.Context, *entities.AuditEvent) error) *AuditEventRepository_CreateAuditEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuditEventsByLoanID provides a mock function with given fields: ctx, loanID
+func (_m *AuditEventRepository) GetAuditEventsByLoanID(ctx context.Context, loanID string) ([]*entities.AuditEvent, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuditEventsByLoanID")
+	}
+
+	var r0 []*entities.AuditEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*entities.AuditEvent, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*entities.AuditEvent); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuditEventRepository_GetAuditEventsByLoanID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuditEventsByLoanID'
+type AuditEventRepository_GetAuditEventsByLoanID_Call struct {
+	*mock.Call
+}
+
+// GetAuditEventsByLoanID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID string
+func (_e *AuditEventRepository_Expecter) GetAuditEventsByLoanID(ctx interface{}, loanID interface{}) *AuditEventRepository_GetAuditEventsByLoanID_Call {
+	return &AuditEventRepository_GetAuditEventsByLoanID_Call{Call: _e.mock.On("GetAuditEventsByLoanID", ctx, loanID)}
+}
+
+func (_c *AuditEventRepository_GetAuditEventsByLoanID_Call) Run(run func(ctx context.Context, loanID string)) *AuditEventRepository_GetAuditEventsByLoanID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuditEventRepository_GetAuditEventsByLoanID_Call) Return(_a0 []*entities.AuditEvent, _a1 error) *AuditEventRepository_GetAuditEventsByLoanID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuditEventRepository_GetAuditEventsByLoanID_Call) RunAndReturn(run func(context.Context, string) ([]*entities.AuditEvent, error)) *AuditEventRepository_GetAuditEventsByLoanID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLastAuditEventByEntity provides a mock function with given fields: ctx, entityType, entityID
+func (_m *AuditEventRepository) GetLastAuditEventByEntity(ctx context.Context, entityType string, entityID string) (*entities.AuditEvent, error) {
+	ret := _m.Called(ctx, entityType, entityID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastAuditEventByEntity")
+	}
+
+	var r0 *entities.AuditEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entities.AuditEvent, error)); ok {
+		return rf(ctx, entityType, entityID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *entities.AuditEvent); ok {
+		r0 = rf(ctx, entityType, entityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, entityType, entityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuditEventRepository_GetLastAuditEventByEntity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastAuditEventByEntity'
+type AuditEventRepository_GetLastAuditEventByEntity_Call struct {
+	*mock.Call
+}
+
+// GetLastAuditEventByEntity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entityType string
+//   - entityID string
+func (_e *AuditEventRepository_Expecter) GetLastAuditEventByEntity(ctx interface{}, entityType interface{}, entityID interface{}) *AuditEventRepository_GetLastAuditEventByEntity_Call {
+	return &AuditEventRepository_GetLastAuditEventByEntity_Call{Call: _e.mock.On("GetLastAuditEventByEntity", ctx, entityType, entityID)}
+}
+
+func (_c *AuditEventRepository_GetLastAuditEventByEntity_Call) Run(run func(ctx context.Context, entityType string, entityID string)) *AuditEventRepository_GetLastAuditEventByEntity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AuditEventRepository_GetLastAuditEventByEntity_Call) Return(_a0 *entities.AuditEvent, _a1 error) *AuditEventRepository_GetLastAuditEventByEntity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuditEventRepository_GetLastAuditEventByEntity_Call) RunAndReturn(run func(context.Context, string, string) (*entities.AuditEvent, error)) *AuditEventRepository_GetLastAuditEventByEntity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLastAuditEventByLoanID provides a mock function with given fields: ctx, loanID
+func (_m *AuditEventRepository) GetLastAuditEventByLoanID(ctx context.Context, loanID string) (*entities.AuditEvent, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastAuditEventByLoanID")
+	}
+
+	var r0 *entities.AuditEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.AuditEvent, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.AuditEvent); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuditEventRepository_GetLastAuditEventByLoanID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastAuditEventByLoanID'
+type AuditEventRepository_GetLastAuditEventByLoanID_Call struct {
+	*mock.Call
+}
+
+// GetLastAuditEventByLoanID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID string
+func (_e *AuditEventRepository_Expecter) GetLastAuditEventByLoanID(ctx interface{}, loanID interface{}) *AuditEventRepository_GetLastAuditEventByLoanID_Call {
+	return &AuditEventRepository_GetLastAuditEventByLoanID_Call{Call: _e.mock.On("GetLastAuditEventByLoanID", ctx, loanID)}
+}
+
+func (_c *AuditEventRepository_GetLastAuditEventByLoanID_Call) Run(run func(ctx context.Context, loanID string)) *AuditEventRepository_GetLastAuditEventByLoanID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuditEventRepository_GetLastAuditEventByLoanID_Call) Return(_a0 *entities.AuditEvent, _a1 error) *AuditEventRepository_GetLastAuditEventByLoanID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuditEventRepository_GetLastAuditEventByLoanID_Call) RunAndReturn(run func(context.Context, string) (*entities.AuditEvent, error)) *AuditEventRepository_GetLastAuditEventByLoanID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAuditEventRepository creates a new instance of AuditEventRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuditEventRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuditEventRepository {
+	mock := &AuditEventRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
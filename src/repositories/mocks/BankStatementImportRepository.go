@@ -0,0 +1,203 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package repositories
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	entities "github.com/verizhang/billing-engine/src/entities"
+
+	time "time"
+)
+
+// BankStatementImportRepository is an autogenerated mock type for the BankStatementImportRepository type
+type BankStatementImportRepository struct {
+	mock.Mock
+}
+
+type BankStatementImportRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BankStatementImportRepository) EXPECT() *BankStatementImportRepository_Expecter {
+	return &BankStatementImportRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountBankStatementImportsByDate provides a mock function with given fields: ctx, from, to
+func (_m *BankStatementImportRepository) CountBankStatementImportsByDate(ctx context.Context, from time.Time, to time.Time) (int64, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountBankStatementImportsByDate")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) (int64, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) int64); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BankStatementImportRepository_CountBankStatementImportsByDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountBankStatementImportsByDate'
+type BankStatementImportRepository_CountBankStatementImportsByDate_Call struct {
+	*mock.Call
+}
+
+// CountBankStatementImportsByDate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Time
+//   - to time.Time
+func (_e *BankStatementImportRepository_Expecter) CountBankStatementImportsByDate(ctx interface{}, from interface{}, to interface{}) *BankStatementImportRepository_CountBankStatementImportsByDate_Call {
+	return &BankStatementImportRepository_CountBankStatementImportsByDate_Call{Call: _e.mock.On("CountBankStatementImportsByDate", ctx, from, to)}
+}
+
+func (_c *BankStatementImportRepository_CountBankStatementImportsByDate_Call) Run(run func(ctx context.Context, from time.Time, to time.Time)) *BankStatementImportRepository_CountBankStatementImportsByDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *BankStatementImportRepository_CountBankStatementImportsByDate_Call) Return(_a0 int64, _a1 error) *BankStatementImportRepository_CountBankStatementImportsByDate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BankStatementImportRepository_CountBankStatementImportsByDate_Call) RunAndReturn(run func(context.Context, time.Time, time.Time) (int64, error)) *BankStatementImportRepository_CountBankStatementImportsByDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateBankStatementImport provides a mock function with given fields: ctx, statementImport
+func (_m *BankStatementImportRepository) CreateBankStatementImport(ctx context.Context, statementImport *entities.BankStatementImport) error {
+	ret := _m.Called(ctx, statementImport)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBankStatementImport")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.BankStatementImport) error); ok {
+		r0 = rf(ctx, statementImport)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BankStatementImportRepository_CreateBankStatementImport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBankStatementImport'
+type BankStatementImportRepository_CreateBankStatementImport_Call struct {
+	*mock.Call
+}
+
+// CreateBankStatementImport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - statementImport *entities.BankStatementImport
+func (_e *BankStatementImportRepository_Expecter) CreateBankStatementImport(ctx interface{}, statementImport interface{}) *BankStatementImportRepository_CreateBankStatementImport_Call {
+	return &BankStatementImportRepository_CreateBankStatementImport_Call{Call: _e.mock.On("CreateBankStatementImport", ctx, statementImport)}
+}
+
+func (_c *BankStatementImportRepository_CreateBankStatementImport_Call) Run(run func(ctx context.Context, statementImport *entities.BankStatementImport)) *BankStatementImportRepository_CreateBankStatementImport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.BankStatementImport))
+	})
+	return _c
+}
+
+func (_c *BankStatementImportRepository_CreateBankStatementImport_Call) Return(_a0 error) *BankStatementImportRepository_CreateBankStatementImport_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BankStatementImportRepository_CreateBankStatementImport_Call) RunAndReturn(run func(context.Context, *entities.BankStatementImport) error) *BankStatementImportRepository_CreateBankStatementImport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBankStatementImportByFileHash provides a mock function with given fields: ctx, fileHash
+func (_m *BankStatementImportRepository) GetBankStatementImportByFileHash(ctx context.Context, fileHash string) (*entities.BankStatementImport, error) {
+	ret := _m.Called(ctx, fileHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBankStatementImportByFileHash")
+	}
+
+	var r0 *entities.BankStatementImport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.BankStatementImport, error)); ok {
+		return rf(ctx, fileHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.BankStatementImport); ok {
+		r0 = rf(ctx, fileHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.BankStatementImport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, fileHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BankStatementImportRepository_GetBankStatementImportByFileHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBankStatementImportByFileHash'
+type BankStatementImportRepository_GetBankStatementImportByFileHash_Call struct {
+	*mock.Call
+}
+
+// GetBankStatementImportByFileHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fileHash string
+func (_e *BankStatementImportRepository_Expecter) GetBankStatementImportByFileHash(ctx interface{}, fileHash interface{}) *BankStatementImportRepository_GetBankStatementImportByFileHash_Call {
+	return &BankStatementImportRepository_GetBankStatementImportByFileHash_Call{Call: _e.mock.On("GetBankStatementImportByFileHash", ctx, fileHash)}
+}
+
+func (_c *BankStatementImportRepository_GetBankStatementImportByFileHash_Call) Run(run func(ctx context.Context, fileHash string)) *BankStatementImportRepository_GetBankStatementImportByFileHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *BankStatementImportRepository_GetBankStatementImportByFileHash_Call) Return(_a0 *entities.BankStatementImport, _a1 error) *BankStatementImportRepository_GetBankStatementImportByFileHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BankStatementImportRepository_GetBankStatementImportByFileHash_Call) RunAndReturn(run func(context.Context, string) (*entities.BankStatementImport, error)) *BankStatementImportRepository_GetBankStatementImportByFileHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBankStatementImportRepository creates a new instance of BankStatementImportRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBankStatementImportRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BankStatementImportRepository {
+	mock := &BankStatementImportRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
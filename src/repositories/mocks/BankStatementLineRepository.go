@@ -0,0 +1,374 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package repositories
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	entities "github.com/verizhang/billing-engine/src/entities"
+
+	pagination "github.com/verizhang/billing-engine/src/utils/pagination"
+
+	time "time"
+)
+
+// BankStatementLineRepository is an autogenerated mock type for the BankStatementLineRepository type
+type BankStatementLineRepository struct {
+	mock.Mock
+}
+
+type BankStatementLineRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BankStatementLineRepository) EXPECT() *BankStatementLineRepository_Expecter {
+	return &BankStatementLineRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateBankStatementLines provides a mock function with given fields: ctx, lines
+func (_m *BankStatementLineRepository) CreateBankStatementLines(ctx context.Context, lines []*entities.BankStatementLine) error {
+	ret := _m.Called(ctx, lines)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBankStatementLines")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*entities.BankStatementLine) error); ok {
+		r0 = rf(ctx, lines)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BankStatementLineRepository_CreateBankStatementLines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBankStatementLines'
+type BankStatementLineRepository_CreateBankStatementLines_Call struct {
+	*mock.Call
+}
+
+// CreateBankStatementLines is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lines []*entities.BankStatementLine
+func (_e *BankStatementLineRepository_Expecter) CreateBankStatementLines(ctx interface{}, lines interface{}) *BankStatementLineRepository_CreateBankStatementLines_Call {
+	return &BankStatementLineRepository_CreateBankStatementLines_Call{Call: _e.mock.On("CreateBankStatementLines", ctx, lines)}
+}
+
+func (_c *BankStatementLineRepository_CreateBankStatementLines_Call) Run(run func(ctx context.Context, lines []*entities.BankStatementLine)) *BankStatementLineRepository_CreateBankStatementLines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*entities.BankStatementLine))
+	})
+	return _c
+}
+
+func (_c *BankStatementLineRepository_CreateBankStatementLines_Call) Return(_a0 error) *BankStatementLineRepository_CreateBankStatementLines_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BankStatementLineRepository_CreateBankStatementLines_Call) RunAndReturn(run func(context.Context, []*entities.BankStatementLine) error) *BankStatementLineRepository_CreateBankStatementLines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBankStatementLineByID provides a mock function with given fields: ctx, ID
+func (_m *BankStatementLineRepository) GetBankStatementLineByID(ctx context.Context, ID string) (*entities.BankStatementLine, error) {
+	ret := _m.Called(ctx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBankStatementLineByID")
+	}
+
+	var r0 *entities.BankStatementLine
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.BankStatementLine, error)); ok {
+		return rf(ctx, ID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.BankStatementLine); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.BankStatementLine)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BankStatementLineRepository_GetBankStatementLineByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBankStatementLineByID'
+type BankStatementLineRepository_GetBankStatementLineByID_Call struct {
+	*mock.Call
+}
+
+// GetBankStatementLineByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+func (_e *BankStatementLineRepository_Expecter) GetBankStatementLineByID(ctx interface{}, ID interface{}) *BankStatementLineRepository_GetBankStatementLineByID_Call {
+	return &BankStatementLineRepository_GetBankStatementLineByID_Call{Call: _e.mock.On("GetBankStatementLineByID", ctx, ID)}
+}
+
+func (_c *BankStatementLineRepository_GetBankStatementLineByID_Call) Run(run func(ctx context.Context, ID string)) *BankStatementLineRepository_GetBankStatementLineByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *BankStatementLineRepository_GetBankStatementLineByID_Call) Return(_a0 *entities.BankStatementLine, _a1 error) *BankStatementLineRepository_GetBankStatementLineByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BankStatementLineRepository_GetBankStatementLineByID_Call) RunAndReturn(run func(context.Context, string) (*entities.BankStatementLine, error)) *BankStatementLineRepository_GetBankStatementLineByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBankStatementLines provides a mock function with given fields: ctx, filter, cursor, limit
+func (_m *BankStatementLineRepository) GetBankStatementLines(ctx context.Context, filter *entities.BankStatementLineFilter, cursor *pagination.Cursor, limit int) ([]*entities.BankStatementLine, error) {
+	ret := _m.Called(ctx, filter, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBankStatementLines")
+	}
+
+	var r0 []*entities.BankStatementLine
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.BankStatementLineFilter, *pagination.Cursor, int) ([]*entities.BankStatementLine, error)); ok {
+		return rf(ctx, filter, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.BankStatementLineFilter, *pagination.Cursor, int) []*entities.BankStatementLine); ok {
+		r0 = rf(ctx, filter, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.BankStatementLine)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entities.BankStatementLineFilter, *pagination.Cursor, int) error); ok {
+		r1 = rf(ctx, filter, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BankStatementLineRepository_GetBankStatementLines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBankStatementLines'
+type BankStatementLineRepository_GetBankStatementLines_Call struct {
+	*mock.Call
+}
+
+// GetBankStatementLines is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *entities.BankStatementLineFilter
+//   - cursor *pagination.Cursor
+//   - limit int
+func (_e *BankStatementLineRepository_Expecter) GetBankStatementLines(ctx interface{}, filter interface{}, cursor interface{}, limit interface{}) *BankStatementLineRepository_GetBankStatementLines_Call {
+	return &BankStatementLineRepository_GetBankStatementLines_Call{Call: _e.mock.On("GetBankStatementLines", ctx, filter, cursor, limit)}
+}
+
+func (_c *BankStatementLineRepository_GetBankStatementLines_Call) Run(run func(ctx context.Context, filter *entities.BankStatementLineFilter, cursor *pagination.Cursor, limit int)) *BankStatementLineRepository_GetBankStatementLines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.BankStatementLineFilter), args[2].(*pagination.Cursor), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *BankStatementLineRepository_GetBankStatementLines_Call) Return(_a0 []*entities.BankStatementLine, _a1 error) *BankStatementLineRepository_GetBankStatementLines_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BankStatementLineRepository_GetBankStatementLines_Call) RunAndReturn(run func(context.Context, *entities.BankStatementLineFilter, *pagination.Cursor, int) ([]*entities.BankStatementLine, error)) *BankStatementLineRepository_GetBankStatementLines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBankStatementLinesByImportID provides a mock function with given fields: ctx, importID
+func (_m *BankStatementLineRepository) GetBankStatementLinesByImportID(ctx context.Context, importID string) ([]*entities.BankStatementLine, error) {
+	ret := _m.Called(ctx, importID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBankStatementLinesByImportID")
+	}
+
+	var r0 []*entities.BankStatementLine
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*entities.BankStatementLine, error)); ok {
+		return rf(ctx, importID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*entities.BankStatementLine); ok {
+		r0 = rf(ctx, importID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.BankStatementLine)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, importID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BankStatementLineRepository_GetBankStatementLinesByImportID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBankStatementLinesByImportID'
+type BankStatementLineRepository_GetBankStatementLinesByImportID_Call struct {
+	*mock.Call
+}
+
+// GetBankStatementLinesByImportID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - importID string
+func (_e *BankStatementLineRepository_Expecter) GetBankStatementLinesByImportID(ctx interface{}, importID interface{}) *BankStatementLineRepository_GetBankStatementLinesByImportID_Call {
+	return &BankStatementLineRepository_GetBankStatementLinesByImportID_Call{Call: _e.mock.On("GetBankStatementLinesByImportID", ctx, importID)}
+}
+
+func (_c *BankStatementLineRepository_GetBankStatementLinesByImportID_Call) Run(run func(ctx context.Context, importID string)) *BankStatementLineRepository_GetBankStatementLinesByImportID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *BankStatementLineRepository_GetBankStatementLinesByImportID_Call) Return(_a0 []*entities.BankStatementLine, _a1 error) *BankStatementLineRepository_GetBankStatementLinesByImportID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BankStatementLineRepository_GetBankStatementLinesByImportID_Call) RunAndReturn(run func(context.Context, string) ([]*entities.BankStatementLine, error)) *BankStatementLineRepository_GetBankStatementLinesByImportID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReconciliationTotalsByDate provides a mock function with given fields: ctx, from, to
+func (_m *BankStatementLineRepository) GetReconciliationTotalsByDate(ctx context.Context, from time.Time, to time.Time) ([]*entities.ReconciliationTotal, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReconciliationTotalsByDate")
+	}
+
+	var r0 []*entities.ReconciliationTotal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]*entities.ReconciliationTotal, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []*entities.ReconciliationTotal); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.ReconciliationTotal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BankStatementLineRepository_GetReconciliationTotalsByDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReconciliationTotalsByDate'
+type BankStatementLineRepository_GetReconciliationTotalsByDate_Call struct {
+	*mock.Call
+}
+
+// GetReconciliationTotalsByDate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Time
+//   - to time.Time
+func (_e *BankStatementLineRepository_Expecter) GetReconciliationTotalsByDate(ctx interface{}, from interface{}, to interface{}) *BankStatementLineRepository_GetReconciliationTotalsByDate_Call {
+	return &BankStatementLineRepository_GetReconciliationTotalsByDate_Call{Call: _e.mock.On("GetReconciliationTotalsByDate", ctx, from, to)}
+}
+
+func (_c *BankStatementLineRepository_GetReconciliationTotalsByDate_Call) Run(run func(ctx context.Context, from time.Time, to time.Time)) *BankStatementLineRepository_GetReconciliationTotalsByDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *BankStatementLineRepository_GetReconciliationTotalsByDate_Call) Return(_a0 []*entities.ReconciliationTotal, _a1 error) *BankStatementLineRepository_GetReconciliationTotalsByDate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BankStatementLineRepository_GetReconciliationTotalsByDate_Call) RunAndReturn(run func(context.Context, time.Time, time.Time) ([]*entities.ReconciliationTotal, error)) *BankStatementLineRepository_GetReconciliationTotalsByDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateBankStatementLine provides a mock function with given fields: ctx, line
+func (_m *BankStatementLineRepository) UpdateBankStatementLine(ctx context.Context, line *entities.BankStatementLine) error {
+	ret := _m.Called(ctx, line)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBankStatementLine")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.BankStatementLine) error); ok {
+		r0 = rf(ctx, line)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BankStatementLineRepository_UpdateBankStatementLine_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBankStatementLine'
+type BankStatementLineRepository_UpdateBankStatementLine_Call struct {
+	*mock.Call
+}
+
+// UpdateBankStatementLine is a helper method to define mock.On call
+//   - ctx context.Context
+//   - line *entities.BankStatementLine
+func (_e *BankStatementLineRepository_Expecter) UpdateBankStatementLine(ctx interface{}, line interface{}) *BankStatementLineRepository_UpdateBankStatementLine_Call {
+	return &BankStatementLineRepository_UpdateBankStatementLine_Call{Call: _e.mock.On("UpdateBankStatementLine", ctx, line)}
+}
+
+func (_c *BankStatementLineRepository_UpdateBankStatementLine_Call) Run(run func(ctx context.Context, line *entities.BankStatementLine)) *BankStatementLineRepository_UpdateBankStatementLine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.BankStatementLine))
+	})
+	return _c
+}
+
+func (_c *BankStatementLineRepository_UpdateBankStatementLine_Call) Return(_a0 error) *BankStatementLineRepository_UpdateBankStatementLine_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BankStatementLineRepository_UpdateBankStatementLine_Call) RunAndReturn(run func(context.Context, *entities.BankStatementLine) error) *BankStatementLineRepository_UpdateBankStatementLine_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBankStatementLineRepository creates a new instance of BankStatementLineRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBankStatementLineRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BankStatementLineRepository {
+	mock := &BankStatementLineRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
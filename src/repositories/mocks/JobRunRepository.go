@@ -0,0 +1,303 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package repositories
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	entities "github.com/verizhang/billing-engine/src/entities"
+
+	pagination "github.com/verizhang/billing-engine/src/utils/pagination"
+
+	time "time"
+)
+
+// JobRunRepository is an autogenerated mock type for the JobRunRepository type
+type JobRunRepository struct {
+	mock.Mock
+}
+
+type JobRunRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobRunRepository) EXPECT() *JobRunRepository_Expecter {
+	return &JobRunRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateJobRun provides a mock function with given fields: ctx, run
+func (_m *JobRunRepository) CreateJobRun(ctx context.Context, run *entities.JobRun) error {
+	ret := _m.Called(ctx, run)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJobRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.JobRun) error); ok {
+		r0 = rf(ctx, run)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JobRunRepository_CreateJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateJobRun'
+type JobRunRepository_CreateJobRun_Call struct {
+	*mock.Call
+}
+
+// CreateJobRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - run *entities.JobRun
+func (_e *JobRunRepository_Expecter) CreateJobRun(ctx interface{}, run interface{}) *JobRunRepository_CreateJobRun_Call {
+	return &JobRunRepository_CreateJobRun_Call{Call: _e.mock.On("CreateJobRun", ctx, run)}
+}
+
+func (_c *JobRunRepository_CreateJobRun_Call) Run(run func(ctx context.Context, run *entities.JobRun)) *JobRunRepository_CreateJobRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.JobRun))
+	})
+	return _c
+}
+
+func (_c *JobRunRepository_CreateJobRun_Call) Return(_a0 error) *JobRunRepository_CreateJobRun_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobRunRepository_CreateJobRun_Call) RunAndReturn(run func(context.Context, *entities.JobRun) error) *JobRunRepository_CreateJobRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FailRunningJobRuns provides a mock function with given fields: ctx, job, finishedAt, reason
+func (_m *JobRunRepository) FailRunningJobRuns(ctx context.Context, job string, finishedAt time.Time, reason string) error {
+	ret := _m.Called(ctx, job, finishedAt, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailRunningJobRuns")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, string) error); ok {
+		r0 = rf(ctx, job, finishedAt, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JobRunRepository_FailRunningJobRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailRunningJobRuns'
+type JobRunRepository_FailRunningJobRuns_Call struct {
+	*mock.Call
+}
+
+// FailRunningJobRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - job string
+//   - finishedAt time.Time
+//   - reason string
+func (_e *JobRunRepository_Expecter) FailRunningJobRuns(ctx interface{}, job interface{}, finishedAt interface{}, reason interface{}) *JobRunRepository_FailRunningJobRuns_Call {
+	return &JobRunRepository_FailRunningJobRuns_Call{Call: _e.mock.On("FailRunningJobRuns", ctx, job, finishedAt, reason)}
+}
+
+func (_c *JobRunRepository_FailRunningJobRuns_Call) Run(run func(ctx context.Context, job string, finishedAt time.Time, reason string)) *JobRunRepository_FailRunningJobRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *JobRunRepository_FailRunningJobRuns_Call) Return(_a0 error) *JobRunRepository_FailRunningJobRuns_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobRunRepository_FailRunningJobRuns_Call) RunAndReturn(run func(context.Context, string, time.Time, string) error) *JobRunRepository_FailRunningJobRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetJobRuns provides a mock function with given fields: ctx, filter, cursor, limit
+func (_m *JobRunRepository) GetJobRuns(ctx context.Context, filter *entities.JobRunFilter, cursor *pagination.Cursor, limit int) ([]*entities.JobRun, error) {
+	ret := _m.Called(ctx, filter, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJobRuns")
+	}
+
+	var r0 []*entities.JobRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.JobRunFilter, *pagination.Cursor, int) ([]*entities.JobRun, error)); ok {
+		return rf(ctx, filter, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.JobRunFilter, *pagination.Cursor, int) []*entities.JobRun); ok {
+		r0 = rf(ctx, filter, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.JobRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entities.JobRunFilter, *pagination.Cursor, int) error); ok {
+		r1 = rf(ctx, filter, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRunRepository_GetJobRuns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJobRuns'
+type JobRunRepository_GetJobRuns_Call struct {
+	*mock.Call
+}
+
+// GetJobRuns is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *entities.JobRunFilter
+//   - cursor *pagination.Cursor
+//   - limit int
+func (_e *JobRunRepository_Expecter) GetJobRuns(ctx interface{}, filter interface{}, cursor interface{}, limit interface{}) *JobRunRepository_GetJobRuns_Call {
+	return &JobRunRepository_GetJobRuns_Call{Call: _e.mock.On("GetJobRuns", ctx, filter, cursor, limit)}
+}
+
+func (_c *JobRunRepository_GetJobRuns_Call) Run(run func(ctx context.Context, filter *entities.JobRunFilter, cursor *pagination.Cursor, limit int)) *JobRunRepository_GetJobRuns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.JobRunFilter), args[2].(*pagination.Cursor), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *JobRunRepository_GetJobRuns_Call) Return(_a0 []*entities.JobRun, _a1 error) *JobRunRepository_GetJobRuns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRunRepository_GetJobRuns_Call) RunAndReturn(run func(context.Context, *entities.JobRunFilter, *pagination.Cursor, int) ([]*entities.JobRun, error)) *JobRunRepository_GetJobRuns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateJobRun provides a mock function with given fields: ctx, run
+func (_m *JobRunRepository) UpdateJobRun(ctx context.Context, run *entities.JobRun) error {
+	ret := _m.Called(ctx, run)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateJobRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.JobRun) error); ok {
+		r0 = rf(ctx, run)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JobRunRepository_UpdateJobRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateJobRun'
+type JobRunRepository_UpdateJobRun_Call struct {
+	*mock.Call
+}
+
+// UpdateJobRun is a helper method to define mock.On call
+//   - ctx context.Context
+//   - run *entities.JobRun
+func (_e *JobRunRepository_Expecter) UpdateJobRun(ctx interface{}, run interface{}) *JobRunRepository_UpdateJobRun_Call {
+	return &JobRunRepository_UpdateJobRun_Call{Call: _e.mock.On("UpdateJobRun", ctx, run)}
+}
+
+func (_c *JobRunRepository_UpdateJobRun_Call) Run(run func(ctx context.Context, run *entities.JobRun)) *JobRunRepository_UpdateJobRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.JobRun))
+	})
+	return _c
+}
+
+func (_c *JobRunRepository_UpdateJobRun_Call) Return(_a0 error) *JobRunRepository_UpdateJobRun_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobRunRepository_UpdateJobRun_Call) RunAndReturn(run func(context.Context, *entities.JobRun) error) *JobRunRepository_UpdateJobRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithJobLock provides a mock function with given fields: ctx, job, fn
+func (_m *JobRunRepository) WithJobLock(ctx context.Context, job string, fn func() error) (bool, error) {
+	ret := _m.Called(ctx, job, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithJobLock")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, func() error) (bool, error)); ok {
+		return rf(ctx, job, fn)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, func() error) bool); ok {
+		r0 = rf(ctx, job, fn)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, func() error) error); ok {
+		r1 = rf(ctx, job, fn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobRunRepository_WithJobLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithJobLock'
+type JobRunRepository_WithJobLock_Call struct {
+	*mock.Call
+}
+
+// WithJobLock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - job string
+//   - fn func() error
+func (_e *JobRunRepository_Expecter) WithJobLock(ctx interface{}, job interface{}, fn interface{}) *JobRunRepository_WithJobLock_Call {
+	return &JobRunRepository_WithJobLock_Call{Call: _e.mock.On("WithJobLock", ctx, job, fn)}
+}
+
+func (_c *JobRunRepository_WithJobLock_Call) Run(run func(ctx context.Context, job string, fn func() error)) *JobRunRepository_WithJobLock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(func() error))
+	})
+	return _c
+}
+
+func (_c *JobRunRepository_WithJobLock_Call) Return(_a0 bool, _a1 error) *JobRunRepository_WithJobLock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobRunRepository_WithJobLock_Call) RunAndReturn(run func(context.Context, string, func() error) (bool, error)) *JobRunRepository_WithJobLock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobRunRepository creates a new instance of JobRunRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobRunRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobRunRepository {
+	mock := &JobRunRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
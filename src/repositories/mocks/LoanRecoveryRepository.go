@@ -0,0 +1,146 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package repositories
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	entities "github.com/verizhang/billing-engine/src/entities"
+
+	time "time"
+)
+
+// LoanRecoveryRepository is an autogenerated mock type for the LoanRecoveryRepository type
+type LoanRecoveryRepository struct {
+	mock.Mock
+}
+
+type LoanRecoveryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LoanRecoveryRepository) EXPECT() *LoanRecoveryRepository_Expecter {
+	return &LoanRecoveryRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateLoanRecovery provides a mock function with given fields: ctx, recovery
+func (_m *LoanRecoveryRepository) CreateLoanRecovery(ctx context.Context, recovery *entities.LoanRecovery) error {
+	ret := _m.Called(ctx, recovery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLoanRecovery")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.LoanRecovery) error); ok {
+		r0 = rf(ctx, recovery)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LoanRecoveryRepository_CreateLoanRecovery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLoanRecovery'
+type LoanRecoveryRepository_CreateLoanRecovery_Call struct {
+	*mock.Call
+}
+
+// CreateLoanRecovery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - recovery *entities.LoanRecovery
+func (_e *LoanRecoveryRepository_Expecter) CreateLoanRecovery(ctx interface{}, recovery interface{}) *LoanRecoveryRepository_CreateLoanRecovery_Call {
+	return &LoanRecoveryRepository_CreateLoanRecovery_Call{Call: _e.mock.On("CreateLoanRecovery", ctx, recovery)}
+}
+
+func (_c *LoanRecoveryRepository_CreateLoanRecovery_Call) Run(run func(ctx context.Context, recovery *entities.LoanRecovery)) *LoanRecoveryRepository_CreateLoanRecovery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.LoanRecovery))
+	})
+	return _c
+}
+
+func (_c *LoanRecoveryRepository_CreateLoanRecovery_Call) Return(_a0 error) *LoanRecoveryRepository_CreateLoanRecovery_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LoanRecoveryRepository_CreateLoanRecovery_Call) RunAndReturn(run func(context.Context, *entities.LoanRecovery) error) *LoanRecoveryRepository_CreateLoanRecovery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanRecoveriesByDate provides a mock function with given fields: ctx, from, to
+func (_m *LoanRecoveryRepository) GetLoanRecoveriesByDate(ctx context.Context, from time.Time, to time.Time) ([]*entities.LoanRecovery, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanRecoveriesByDate")
+	}
+
+	var r0 []*entities.LoanRecovery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]*entities.LoanRecovery, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []*entities.LoanRecovery); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.LoanRecovery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoanRecoveryRepository_GetLoanRecoveriesByDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanRecoveriesByDate'
+type LoanRecoveryRepository_GetLoanRecoveriesByDate_Call struct {
+	*mock.Call
+}
+
+// GetLoanRecoveriesByDate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Time
+//   - to time.Time
+func (_e *LoanRecoveryRepository_Expecter) GetLoanRecoveriesByDate(ctx interface{}, from interface{}, to interface{}) *LoanRecoveryRepository_GetLoanRecoveriesByDate_Call {
+	return &LoanRecoveryRepository_GetLoanRecoveriesByDate_Call{Call: _e.mock.On("GetLoanRecoveriesByDate", ctx, from, to)}
+}
+
+func (_c *LoanRecoveryRepository_GetLoanRecoveriesByDate_Call) Run(run func(ctx context.Context, from time.Time, to time.Time)) *LoanRecoveryRepository_GetLoanRecoveriesByDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *LoanRecoveryRepository_GetLoanRecoveriesByDate_Call) Return(_a0 []*entities.LoanRecovery, _a1 error) *LoanRecoveryRepository_GetLoanRecoveriesByDate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LoanRecoveryRepository_GetLoanRecoveriesByDate_Call) RunAndReturn(run func(context.Context, time.Time, time.Time) ([]*entities.LoanRecovery, error)) *LoanRecoveryRepository_GetLoanRecoveriesByDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLoanRecoveryRepository creates a new instance of LoanRecoveryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLoanRecoveryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LoanRecoveryRepository {
+	mock := &LoanRecoveryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
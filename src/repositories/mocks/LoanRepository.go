@@ -7,6 +7,10 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 	entities "github.com/verizhang/billing-engine/src/entities"
+
+	pagination "github.com/verizhang/billing-engine/src/utils/pagination"
+
+	time "time"
 )
 
 // LoanRepository is an autogenerated mock type for the LoanRepository type
@@ -22,6 +26,102 @@ func (_m *LoanRepository) EXPECT() *LoanRepository_Expecter {
 	return &LoanRepository_Expecter{mock: &_m.Mock}
 }
 
+// AddFeeLoanByID provides a mock function with given fields: ctx, ID, fee
+func (_m *LoanRepository) AddFeeLoanByID(ctx context.Context, ID string, fee float64) error {
+	ret := _m.Called(ctx, ID, fee)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddFeeLoanByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64) error); ok {
+		r0 = rf(ctx, ID, fee)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LoanRepository_AddFeeLoanByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddFeeLoanByID'
+type LoanRepository_AddFeeLoanByID_Call struct {
+	*mock.Call
+}
+
+// AddFeeLoanByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+//   - fee float64
+func (_e *LoanRepository_Expecter) AddFeeLoanByID(ctx interface{}, ID interface{}, fee interface{}) *LoanRepository_AddFeeLoanByID_Call {
+	return &LoanRepository_AddFeeLoanByID_Call{Call: _e.mock.On("AddFeeLoanByID", ctx, ID, fee)}
+}
+
+func (_c *LoanRepository_AddFeeLoanByID_Call) Run(run func(ctx context.Context, ID string, fee float64)) *LoanRepository_AddFeeLoanByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *LoanRepository_AddFeeLoanByID_Call) Return(_a0 error) *LoanRepository_AddFeeLoanByID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LoanRepository_AddFeeLoanByID_Call) RunAndReturn(run func(context.Context, string, float64) error) *LoanRepository_AddFeeLoanByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddInterestLoanByID provides a mock function with given fields: ctx, ID, interest
+func (_m *LoanRepository) AddInterestLoanByID(ctx context.Context, ID string, interest float64) error {
+	ret := _m.Called(ctx, ID, interest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddInterestLoanByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64) error); ok {
+		r0 = rf(ctx, ID, interest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LoanRepository_AddInterestLoanByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddInterestLoanByID'
+type LoanRepository_AddInterestLoanByID_Call struct {
+	*mock.Call
+}
+
+// AddInterestLoanByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+//   - interest float64
+func (_e *LoanRepository_Expecter) AddInterestLoanByID(ctx interface{}, ID interface{}, interest interface{}) *LoanRepository_AddInterestLoanByID_Call {
+	return &LoanRepository_AddInterestLoanByID_Call{Call: _e.mock.On("AddInterestLoanByID", ctx, ID, interest)}
+}
+
+func (_c *LoanRepository_AddInterestLoanByID_Call) Run(run func(ctx context.Context, ID string, interest float64)) *LoanRepository_AddInterestLoanByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *LoanRepository_AddInterestLoanByID_Call) Return(_a0 error) *LoanRepository_AddInterestLoanByID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LoanRepository_AddInterestLoanByID_Call) RunAndReturn(run func(context.Context, string, float64) error) *LoanRepository_AddInterestLoanByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateLoan provides a mock function with given fields: ctx, loan
 func (_m *LoanRepository) CreateLoan(ctx context.Context, loan *entities.Loan) error {
 	ret := _m.Called(ctx, loan)
@@ -128,6 +228,446 @@ func (_c *LoanRepository_GetActiveLoansByUserID_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// GetDelinquentLoans provides a mock function with given fields: ctx
+func (_m *LoanRepository) GetDelinquentLoans(ctx context.Context) ([]*entities.Loan, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDelinquentLoans")
+	}
+
+	var r0 []*entities.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*entities.Loan, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*entities.Loan); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Loan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoanRepository_GetDelinquentLoans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDelinquentLoans'
+type LoanRepository_GetDelinquentLoans_Call struct {
+	*mock.Call
+}
+
+// GetDelinquentLoans is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *LoanRepository_Expecter) GetDelinquentLoans(ctx interface{}) *LoanRepository_GetDelinquentLoans_Call {
+	return &LoanRepository_GetDelinquentLoans_Call{Call: _e.mock.On("GetDelinquentLoans", ctx)}
+}
+
+func (_c *LoanRepository_GetDelinquentLoans_Call) Run(run func(ctx context.Context)) *LoanRepository_GetDelinquentLoans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *LoanRepository_GetDelinquentLoans_Call) Return(_a0 []*entities.Loan, _a1 error) *LoanRepository_GetDelinquentLoans_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LoanRepository_GetDelinquentLoans_Call) RunAndReturn(run func(context.Context) ([]*entities.Loan, error)) *LoanRepository_GetDelinquentLoans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanByID provides a mock function with given fields: ctx, ID
+func (_m *LoanRepository) GetLoanByID(ctx context.Context, ID string) (*entities.Loan, error) {
+	ret := _m.Called(ctx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanByID")
+	}
+
+	var r0 *entities.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.Loan, error)); ok {
+		return rf(ctx, ID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.Loan); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Loan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoanRepository_GetLoanByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanByID'
+type LoanRepository_GetLoanByID_Call struct {
+	*mock.Call
+}
+
+// GetLoanByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+func (_e *LoanRepository_Expecter) GetLoanByID(ctx interface{}, ID interface{}) *LoanRepository_GetLoanByID_Call {
+	return &LoanRepository_GetLoanByID_Call{Call: _e.mock.On("GetLoanByID", ctx, ID)}
+}
+
+func (_c *LoanRepository_GetLoanByID_Call) Run(run func(ctx context.Context, ID string)) *LoanRepository_GetLoanByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *LoanRepository_GetLoanByID_Call) Return(_a0 *entities.Loan, _a1 error) *LoanRepository_GetLoanByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LoanRepository_GetLoanByID_Call) RunAndReturn(run func(context.Context, string) (*entities.Loan, error)) *LoanRepository_GetLoanByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanByPaymentReference provides a mock function with given fields: ctx, paymentReference
+func (_m *LoanRepository) GetLoanByPaymentReference(ctx context.Context, paymentReference string) (*entities.Loan, error) {
+	ret := _m.Called(ctx, paymentReference)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanByPaymentReference")
+	}
+
+	var r0 *entities.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.Loan, error)); ok {
+		return rf(ctx, paymentReference)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.Loan); ok {
+		r0 = rf(ctx, paymentReference)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Loan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, paymentReference)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoanRepository_GetLoanByPaymentReference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanByPaymentReference'
+type LoanRepository_GetLoanByPaymentReference_Call struct {
+	*mock.Call
+}
+
+// GetLoanByPaymentReference is a helper method to define mock.On call
+//   - ctx context.Context
+//   - paymentReference string
+func (_e *LoanRepository_Expecter) GetLoanByPaymentReference(ctx interface{}, paymentReference interface{}) *LoanRepository_GetLoanByPaymentReference_Call {
+	return &LoanRepository_GetLoanByPaymentReference_Call{Call: _e.mock.On("GetLoanByPaymentReference", ctx, paymentReference)}
+}
+
+func (_c *LoanRepository_GetLoanByPaymentReference_Call) Run(run func(ctx context.Context, paymentReference string)) *LoanRepository_GetLoanByPaymentReference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *LoanRepository_GetLoanByPaymentReference_Call) Return(_a0 *entities.Loan, _a1 error) *LoanRepository_GetLoanByPaymentReference_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LoanRepository_GetLoanByPaymentReference_Call) RunAndReturn(run func(context.Context, string) (*entities.Loan, error)) *LoanRepository_GetLoanByPaymentReference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoansByUserID provides a mock function with given fields: ctx, userID, statuses, cursor, limit
+func (_m *LoanRepository) GetLoansByUserID(ctx context.Context, userID string, statuses []string, cursor *pagination.Cursor, limit int) ([]*entities.Loan, error) {
+	ret := _m.Called(ctx, userID, statuses, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoansByUserID")
+	}
+
+	var r0 []*entities.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, *pagination.Cursor, int) ([]*entities.Loan, error)); ok {
+		return rf(ctx, userID, statuses, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, *pagination.Cursor, int) []*entities.Loan); ok {
+		r0 = rf(ctx, userID, statuses, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Loan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, *pagination.Cursor, int) error); ok {
+		r1 = rf(ctx, userID, statuses, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoanRepository_GetLoansByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoansByUserID'
+type LoanRepository_GetLoansByUserID_Call struct {
+	*mock.Call
+}
+
+// GetLoansByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - statuses []string
+//   - cursor *pagination.Cursor
+//   - limit int
+func (_e *LoanRepository_Expecter) GetLoansByUserID(ctx interface{}, userID interface{}, statuses interface{}, cursor interface{}, limit interface{}) *LoanRepository_GetLoansByUserID_Call {
+	return &LoanRepository_GetLoansByUserID_Call{Call: _e.mock.On("GetLoansByUserID", ctx, userID, statuses, cursor, limit)}
+}
+
+func (_c *LoanRepository_GetLoansByUserID_Call) Run(run func(ctx context.Context, userID string, statuses []string, cursor *pagination.Cursor, limit int)) *LoanRepository_GetLoansByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string), args[3].(*pagination.Cursor), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *LoanRepository_GetLoansByUserID_Call) Return(_a0 []*entities.Loan, _a1 error) *LoanRepository_GetLoansByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LoanRepository_GetLoansByUserID_Call) RunAndReturn(run func(context.Context, string, []string, *pagination.Cursor, int) ([]*entities.Loan, error)) *LoanRepository_GetLoansByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPastDueLoans provides a mock function with given fields: ctx, dueBefore
+func (_m *LoanRepository) GetPastDueLoans(ctx context.Context, dueBefore time.Time) ([]*entities.Loan, error) {
+	ret := _m.Called(ctx, dueBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPastDueLoans")
+	}
+
+	var r0 []*entities.Loan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]*entities.Loan, error)); ok {
+		return rf(ctx, dueBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []*entities.Loan); ok {
+		r0 = rf(ctx, dueBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Loan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, dueBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoanRepository_GetPastDueLoans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPastDueLoans'
+type LoanRepository_GetPastDueLoans_Call struct {
+	*mock.Call
+}
+
+// GetPastDueLoans is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dueBefore time.Time
+func (_e *LoanRepository_Expecter) GetPastDueLoans(ctx interface{}, dueBefore interface{}) *LoanRepository_GetPastDueLoans_Call {
+	return &LoanRepository_GetPastDueLoans_Call{Call: _e.mock.On("GetPastDueLoans", ctx, dueBefore)}
+}
+
+func (_c *LoanRepository_GetPastDueLoans_Call) Run(run func(ctx context.Context, dueBefore time.Time)) *LoanRepository_GetPastDueLoans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *LoanRepository_GetPastDueLoans_Call) Return(_a0 []*entities.Loan, _a1 error) *LoanRepository_GetPastDueLoans_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LoanRepository_GetPastDueLoans_Call) RunAndReturn(run func(context.Context, time.Time) ([]*entities.Loan, error)) *LoanRepository_GetPastDueLoans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockUserLoans provides a mock function with given fields: ctx, userID
+func (_m *LoanRepository) LockUserLoans(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockUserLoans")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LoanRepository_LockUserLoans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockUserLoans'
+type LoanRepository_LockUserLoans_Call struct {
+	*mock.Call
+}
+
+// LockUserLoans is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *LoanRepository_Expecter) LockUserLoans(ctx interface{}, userID interface{}) *LoanRepository_LockUserLoans_Call {
+	return &LoanRepository_LockUserLoans_Call{Call: _e.mock.On("LockUserLoans", ctx, userID)}
+}
+
+func (_c *LoanRepository_LockUserLoans_Call) Run(run func(ctx context.Context, userID string)) *LoanRepository_LockUserLoans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *LoanRepository_LockUserLoans_Call) Return(_a0 error) *LoanRepository_LockUserLoans_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LoanRepository_LockUserLoans_Call) RunAndReturn(run func(context.Context, string) error) *LoanRepository_LockUserLoans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDelinquentAtLoanByID provides a mock function with given fields: ctx, ID, delinquentAt
+func (_m *LoanRepository) UpdateDelinquentAtLoanByID(ctx context.Context, ID string, delinquentAt *time.Time) error {
+	ret := _m.Called(ctx, ID, delinquentAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDelinquentAtLoanByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time) error); ok {
+		r0 = rf(ctx, ID, delinquentAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LoanRepository_UpdateDelinquentAtLoanByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDelinquentAtLoanByID'
+type LoanRepository_UpdateDelinquentAtLoanByID_Call struct {
+	*mock.Call
+}
+
+// UpdateDelinquentAtLoanByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+//   - delinquentAt *time.Time
+func (_e *LoanRepository_Expecter) UpdateDelinquentAtLoanByID(ctx interface{}, ID interface{}, delinquentAt interface{}) *LoanRepository_UpdateDelinquentAtLoanByID_Call {
+	return &LoanRepository_UpdateDelinquentAtLoanByID_Call{Call: _e.mock.On("UpdateDelinquentAtLoanByID", ctx, ID, delinquentAt)}
+}
+
+func (_c *LoanRepository_UpdateDelinquentAtLoanByID_Call) Run(run func(ctx context.Context, ID string, delinquentAt *time.Time)) *LoanRepository_UpdateDelinquentAtLoanByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*time.Time))
+	})
+	return _c
+}
+
+func (_c *LoanRepository_UpdateDelinquentAtLoanByID_Call) Return(_a0 error) *LoanRepository_UpdateDelinquentAtLoanByID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LoanRepository_UpdateDelinquentAtLoanByID_Call) RunAndReturn(run func(context.Context, string, *time.Time) error) *LoanRepository_UpdateDelinquentAtLoanByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateInterestLoanByID provides a mock function with given fields: ctx, ID, interest
+func (_m *LoanRepository) UpdateInterestLoanByID(ctx context.Context, ID string, interest float64) error {
+	ret := _m.Called(ctx, ID, interest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateInterestLoanByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64) error); ok {
+		r0 = rf(ctx, ID, interest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LoanRepository_UpdateInterestLoanByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateInterestLoanByID'
+type LoanRepository_UpdateInterestLoanByID_Call struct {
+	*mock.Call
+}
+
+// UpdateInterestLoanByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+//   - interest float64
+func (_e *LoanRepository_Expecter) UpdateInterestLoanByID(ctx interface{}, ID interface{}, interest interface{}) *LoanRepository_UpdateInterestLoanByID_Call {
+	return &LoanRepository_UpdateInterestLoanByID_Call{Call: _e.mock.On("UpdateInterestLoanByID", ctx, ID, interest)}
+}
+
+func (_c *LoanRepository_UpdateInterestLoanByID_Call) Run(run func(ctx context.Context, ID string, interest float64)) *LoanRepository_UpdateInterestLoanByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *LoanRepository_UpdateInterestLoanByID_Call) Return(_a0 error) *LoanRepository_UpdateInterestLoanByID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LoanRepository_UpdateInterestLoanByID_Call) RunAndReturn(run func(context.Context, string, float64) error) *LoanRepository_UpdateInterestLoanByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateIsActiveLoanByID provides a mock function with given fields: ctx, ID, isActive
 func (_m *LoanRepository) UpdateIsActiveLoanByID(ctx context.Context, ID string, isActive bool) error {
 	ret := _m.Called(ctx, ID, isActive)
@@ -176,6 +716,112 @@ func (_c *LoanRepository_UpdateIsActiveLoanByID_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// UpdateRefinancedLoanByID provides a mock function with given fields: ctx, ID, refinancedByLoanID
+func (_m *LoanRepository) UpdateRefinancedLoanByID(ctx context.Context, ID string, refinancedByLoanID string) (bool, error) {
+	ret := _m.Called(ctx, ID, refinancedByLoanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRefinancedLoanByID")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, ID, refinancedByLoanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, ID, refinancedByLoanID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, ID, refinancedByLoanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoanRepository_UpdateRefinancedLoanByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRefinancedLoanByID'
+type LoanRepository_UpdateRefinancedLoanByID_Call struct {
+	*mock.Call
+}
+
+// UpdateRefinancedLoanByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+//   - refinancedByLoanID string
+func (_e *LoanRepository_Expecter) UpdateRefinancedLoanByID(ctx interface{}, ID interface{}, refinancedByLoanID interface{}) *LoanRepository_UpdateRefinancedLoanByID_Call {
+	return &LoanRepository_UpdateRefinancedLoanByID_Call{Call: _e.mock.On("UpdateRefinancedLoanByID", ctx, ID, refinancedByLoanID)}
+}
+
+func (_c *LoanRepository_UpdateRefinancedLoanByID_Call) Run(run func(ctx context.Context, ID string, refinancedByLoanID string)) *LoanRepository_UpdateRefinancedLoanByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *LoanRepository_UpdateRefinancedLoanByID_Call) Return(_a0 bool, _a1 error) *LoanRepository_UpdateRefinancedLoanByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LoanRepository_UpdateRefinancedLoanByID_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *LoanRepository_UpdateRefinancedLoanByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStatusLoanByID provides a mock function with given fields: ctx, ID, status
+func (_m *LoanRepository) UpdateStatusLoanByID(ctx context.Context, ID string, status string) error {
+	ret := _m.Called(ctx, ID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatusLoanByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, ID, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LoanRepository_UpdateStatusLoanByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatusLoanByID'
+type LoanRepository_UpdateStatusLoanByID_Call struct {
+	*mock.Call
+}
+
+// UpdateStatusLoanByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+//   - status string
+func (_e *LoanRepository_Expecter) UpdateStatusLoanByID(ctx interface{}, ID interface{}, status interface{}) *LoanRepository_UpdateStatusLoanByID_Call {
+	return &LoanRepository_UpdateStatusLoanByID_Call{Call: _e.mock.On("UpdateStatusLoanByID", ctx, ID, status)}
+}
+
+func (_c *LoanRepository_UpdateStatusLoanByID_Call) Run(run func(ctx context.Context, ID string, status string)) *LoanRepository_UpdateStatusLoanByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *LoanRepository_UpdateStatusLoanByID_Call) Return(_a0 error) *LoanRepository_UpdateStatusLoanByID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LoanRepository_UpdateStatusLoanByID_Call) RunAndReturn(run func(context.Context, string, string) error) *LoanRepository_UpdateStatusLoanByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewLoanRepository creates a new instance of LoanRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewLoanRepository(t interface {
@@ -0,0 +1,205 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package repositories
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	entities "github.com/verizhang/billing-engine/src/entities"
+
+	time "time"
+)
+
+// LoanWriteOffRepository is an autogenerated mock type for the LoanWriteOffRepository type
+type LoanWriteOffRepository struct {
+	mock.Mock
+}
+
+type LoanWriteOffRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LoanWriteOffRepository) EXPECT() *LoanWriteOffRepository_Expecter {
+	return &LoanWriteOffRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateLoanWriteOff provides a mock function with given fields: ctx, writeOff
+func (_m *LoanWriteOffRepository) CreateLoanWriteOff(ctx context.Context, writeOff *entities.LoanWriteOff) error {
+	ret := _m.Called(ctx, writeOff)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateLoanWriteOff")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.LoanWriteOff) error); ok {
+		r0 = rf(ctx, writeOff)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LoanWriteOffRepository_CreateLoanWriteOff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLoanWriteOff'
+type LoanWriteOffRepository_CreateLoanWriteOff_Call struct {
+	*mock.Call
+}
+
+// CreateLoanWriteOff is a helper method to define mock.On call
+//   - ctx context.Context
+//   - writeOff *entities.LoanWriteOff
+func (_e *LoanWriteOffRepository_Expecter) CreateLoanWriteOff(ctx interface{}, writeOff interface{}) *LoanWriteOffRepository_CreateLoanWriteOff_Call {
+	return &LoanWriteOffRepository_CreateLoanWriteOff_Call{Call: _e.mock.On("CreateLoanWriteOff", ctx, writeOff)}
+}
+
+func (_c *LoanWriteOffRepository_CreateLoanWriteOff_Call) Run(run func(ctx context.Context, writeOff *entities.LoanWriteOff)) *LoanWriteOffRepository_CreateLoanWriteOff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.LoanWriteOff))
+	})
+	return _c
+}
+
+func (_c *LoanWriteOffRepository_CreateLoanWriteOff_Call) Return(_a0 error) *LoanWriteOffRepository_CreateLoanWriteOff_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LoanWriteOffRepository_CreateLoanWriteOff_Call) RunAndReturn(run func(context.Context, *entities.LoanWriteOff) error) *LoanWriteOffRepository_CreateLoanWriteOff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanWriteOffByLoanID provides a mock function with given fields: ctx, loanID
+func (_m *LoanWriteOffRepository) GetLoanWriteOffByLoanID(ctx context.Context, loanID string) (*entities.LoanWriteOff, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanWriteOffByLoanID")
+	}
+
+	var r0 *entities.LoanWriteOff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.LoanWriteOff, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.LoanWriteOff); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.LoanWriteOff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoanWriteOffRepository_GetLoanWriteOffByLoanID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanWriteOffByLoanID'
+type LoanWriteOffRepository_GetLoanWriteOffByLoanID_Call struct {
+	*mock.Call
+}
+
+// GetLoanWriteOffByLoanID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID string
+func (_e *LoanWriteOffRepository_Expecter) GetLoanWriteOffByLoanID(ctx interface{}, loanID interface{}) *LoanWriteOffRepository_GetLoanWriteOffByLoanID_Call {
+	return &LoanWriteOffRepository_GetLoanWriteOffByLoanID_Call{Call: _e.mock.On("GetLoanWriteOffByLoanID", ctx, loanID)}
+}
+
+func (_c *LoanWriteOffRepository_GetLoanWriteOffByLoanID_Call) Run(run func(ctx context.Context, loanID string)) *LoanWriteOffRepository_GetLoanWriteOffByLoanID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *LoanWriteOffRepository_GetLoanWriteOffByLoanID_Call) Return(_a0 *entities.LoanWriteOff, _a1 error) *LoanWriteOffRepository_GetLoanWriteOffByLoanID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LoanWriteOffRepository_GetLoanWriteOffByLoanID_Call) RunAndReturn(run func(context.Context, string) (*entities.LoanWriteOff, error)) *LoanWriteOffRepository_GetLoanWriteOffByLoanID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoanWriteOffsByDate provides a mock function with given fields: ctx, from, to
+func (_m *LoanWriteOffRepository) GetLoanWriteOffsByDate(ctx context.Context, from time.Time, to time.Time) ([]*entities.LoanWriteOff, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLoanWriteOffsByDate")
+	}
+
+	var r0 []*entities.LoanWriteOff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]*entities.LoanWriteOff, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []*entities.LoanWriteOff); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.LoanWriteOff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LoanWriteOffRepository_GetLoanWriteOffsByDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoanWriteOffsByDate'
+type LoanWriteOffRepository_GetLoanWriteOffsByDate_Call struct {
+	*mock.Call
+}
+
+// GetLoanWriteOffsByDate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Time
+//   - to time.Time
+func (_e *LoanWriteOffRepository_Expecter) GetLoanWriteOffsByDate(ctx interface{}, from interface{}, to interface{}) *LoanWriteOffRepository_GetLoanWriteOffsByDate_Call {
+	return &LoanWriteOffRepository_GetLoanWriteOffsByDate_Call{Call: _e.mock.On("GetLoanWriteOffsByDate", ctx, from, to)}
+}
+
+func (_c *LoanWriteOffRepository_GetLoanWriteOffsByDate_Call) Run(run func(ctx context.Context, from time.Time, to time.Time)) *LoanWriteOffRepository_GetLoanWriteOffsByDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *LoanWriteOffRepository_GetLoanWriteOffsByDate_Call) Return(_a0 []*entities.LoanWriteOff, _a1 error) *LoanWriteOffRepository_GetLoanWriteOffsByDate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LoanWriteOffRepository_GetLoanWriteOffsByDate_Call) RunAndReturn(run func(context.Context, time.Time, time.Time) ([]*entities.LoanWriteOff, error)) *LoanWriteOffRepository_GetLoanWriteOffsByDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLoanWriteOffRepository creates a new instance of LoanWriteOffRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLoanWriteOffRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LoanWriteOffRepository {
+	mock := &LoanWriteOffRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
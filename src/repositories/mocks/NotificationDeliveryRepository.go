@@ -0,0 +1,256 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package repositories
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	entities "github.com/verizhang/billing-engine/src/entities"
+
+	pagination "github.com/verizhang/billing-engine/src/utils/pagination"
+)
+
+// NotificationDeliveryRepository is an autogenerated mock type for the NotificationDeliveryRepository type
+type NotificationDeliveryRepository struct {
+	mock.Mock
+}
+
+type NotificationDeliveryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NotificationDeliveryRepository) EXPECT() *NotificationDeliveryRepository_Expecter {
+	return &NotificationDeliveryRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateNotificationDelivery provides a mock function with given fields: ctx, delivery
+func (_m *NotificationDeliveryRepository) CreateNotificationDelivery(ctx context.Context, delivery *entities.NotificationDelivery) error {
+	ret := _m.Called(ctx, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNotificationDelivery")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.NotificationDelivery) error); ok {
+		r0 = rf(ctx, delivery)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationDeliveryRepository_CreateNotificationDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNotificationDelivery'
+type NotificationDeliveryRepository_CreateNotificationDelivery_Call struct {
+	*mock.Call
+}
+
+// CreateNotificationDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delivery *entities.NotificationDelivery
+func (_e *NotificationDeliveryRepository_Expecter) CreateNotificationDelivery(ctx interface{}, delivery interface{}) *NotificationDeliveryRepository_CreateNotificationDelivery_Call {
+	return &NotificationDeliveryRepository_CreateNotificationDelivery_Call{Call: _e.mock.On("CreateNotificationDelivery", ctx, delivery)}
+}
+
+func (_c *NotificationDeliveryRepository_CreateNotificationDelivery_Call) Run(run func(ctx context.Context, delivery *entities.NotificationDelivery)) *NotificationDeliveryRepository_CreateNotificationDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.NotificationDelivery))
+	})
+	return _c
+}
+
+func (_c *NotificationDeliveryRepository_CreateNotificationDelivery_Call) Return(_a0 error) *NotificationDeliveryRepository_CreateNotificationDelivery_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationDeliveryRepository_CreateNotificationDelivery_Call) RunAndReturn(run func(context.Context, *entities.NotificationDelivery) error) *NotificationDeliveryRepository_CreateNotificationDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotificationDeliveries provides a mock function with given fields: ctx, filter, cursor, limit
+func (_m *NotificationDeliveryRepository) GetNotificationDeliveries(ctx context.Context, filter *entities.NotificationDeliveryFilter, cursor *pagination.Cursor, limit int) ([]*entities.NotificationDelivery, error) {
+	ret := _m.Called(ctx, filter, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationDeliveries")
+	}
+
+	var r0 []*entities.NotificationDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.NotificationDeliveryFilter, *pagination.Cursor, int) ([]*entities.NotificationDelivery, error)); ok {
+		return rf(ctx, filter, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.NotificationDeliveryFilter, *pagination.Cursor, int) []*entities.NotificationDelivery); ok {
+		r0 = rf(ctx, filter, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.NotificationDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entities.NotificationDeliveryFilter, *pagination.Cursor, int) error); ok {
+		r1 = rf(ctx, filter, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationDeliveryRepository_GetNotificationDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationDeliveries'
+type NotificationDeliveryRepository_GetNotificationDeliveries_Call struct {
+	*mock.Call
+}
+
+// GetNotificationDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *entities.NotificationDeliveryFilter
+//   - cursor *pagination.Cursor
+//   - limit int
+func (_e *NotificationDeliveryRepository_Expecter) GetNotificationDeliveries(ctx interface{}, filter interface{}, cursor interface{}, limit interface{}) *NotificationDeliveryRepository_GetNotificationDeliveries_Call {
+	return &NotificationDeliveryRepository_GetNotificationDeliveries_Call{Call: _e.mock.On("GetNotificationDeliveries", ctx, filter, cursor, limit)}
+}
+
+func (_c *NotificationDeliveryRepository_GetNotificationDeliveries_Call) Run(run func(ctx context.Context, filter *entities.NotificationDeliveryFilter, cursor *pagination.Cursor, limit int)) *NotificationDeliveryRepository_GetNotificationDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.NotificationDeliveryFilter), args[2].(*pagination.Cursor), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *NotificationDeliveryRepository_GetNotificationDeliveries_Call) Return(_a0 []*entities.NotificationDelivery, _a1 error) *NotificationDeliveryRepository_GetNotificationDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationDeliveryRepository_GetNotificationDeliveries_Call) RunAndReturn(run func(context.Context, *entities.NotificationDeliveryFilter, *pagination.Cursor, int) ([]*entities.NotificationDelivery, error)) *NotificationDeliveryRepository_GetNotificationDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotificationDeliveriesByPaymentID provides a mock function with given fields: ctx, paymentID, kind
+func (_m *NotificationDeliveryRepository) GetNotificationDeliveriesByPaymentID(ctx context.Context, paymentID string, kind string) ([]*entities.NotificationDelivery, error) {
+	ret := _m.Called(ctx, paymentID, kind)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationDeliveriesByPaymentID")
+	}
+
+	var r0 []*entities.NotificationDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]*entities.NotificationDelivery, error)); ok {
+		return rf(ctx, paymentID, kind)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*entities.NotificationDelivery); ok {
+		r0 = rf(ctx, paymentID, kind)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.NotificationDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, paymentID, kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationDeliveryRepository_GetNotificationDeliveriesByPaymentID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationDeliveriesByPaymentID'
+type NotificationDeliveryRepository_GetNotificationDeliveriesByPaymentID_Call struct {
+	*mock.Call
+}
+
+// GetNotificationDeliveriesByPaymentID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - paymentID string
+//   - kind string
+func (_e *NotificationDeliveryRepository_Expecter) GetNotificationDeliveriesByPaymentID(ctx interface{}, paymentID interface{}, kind interface{}) *NotificationDeliveryRepository_GetNotificationDeliveriesByPaymentID_Call {
+	return &NotificationDeliveryRepository_GetNotificationDeliveriesByPaymentID_Call{Call: _e.mock.On("GetNotificationDeliveriesByPaymentID", ctx, paymentID, kind)}
+}
+
+func (_c *NotificationDeliveryRepository_GetNotificationDeliveriesByPaymentID_Call) Run(run func(ctx context.Context, paymentID string, kind string)) *NotificationDeliveryRepository_GetNotificationDeliveriesByPaymentID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *NotificationDeliveryRepository_GetNotificationDeliveriesByPaymentID_Call) Return(_a0 []*entities.NotificationDelivery, _a1 error) *NotificationDeliveryRepository_GetNotificationDeliveriesByPaymentID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationDeliveryRepository_GetNotificationDeliveriesByPaymentID_Call) RunAndReturn(run func(context.Context, string, string) ([]*entities.NotificationDelivery, error)) *NotificationDeliveryRepository_GetNotificationDeliveriesByPaymentID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateNotificationDeliveryStatus provides a mock function with given fields: ctx, ID, status, deliveryError
+func (_m *NotificationDeliveryRepository) UpdateNotificationDeliveryStatus(ctx context.Context, ID string, status string, deliveryError *string) error {
+	ret := _m.Called(ctx, ID, status, deliveryError)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNotificationDeliveryStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *string) error); ok {
+		r0 = rf(ctx, ID, status, deliveryError)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationDeliveryRepository_UpdateNotificationDeliveryStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNotificationDeliveryStatus'
+type NotificationDeliveryRepository_UpdateNotificationDeliveryStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateNotificationDeliveryStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+//   - status string
+//   - deliveryError *string
+func (_e *NotificationDeliveryRepository_Expecter) UpdateNotificationDeliveryStatus(ctx interface{}, ID interface{}, status interface{}, deliveryError interface{}) *NotificationDeliveryRepository_UpdateNotificationDeliveryStatus_Call {
+	return &NotificationDeliveryRepository_UpdateNotificationDeliveryStatus_Call{Call: _e.mock.On("UpdateNotificationDeliveryStatus", ctx, ID, status, deliveryError)}
+}
+
+func (_c *NotificationDeliveryRepository_UpdateNotificationDeliveryStatus_Call) Run(run func(ctx context.Context, ID string, status string, deliveryError *string)) *NotificationDeliveryRepository_UpdateNotificationDeliveryStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*string))
+	})
+	return _c
+}
+
+func (_c *NotificationDeliveryRepository_UpdateNotificationDeliveryStatus_Call) Return(_a0 error) *NotificationDeliveryRepository_UpdateNotificationDeliveryStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationDeliveryRepository_UpdateNotificationDeliveryStatus_Call) RunAndReturn(run func(context.Context, string, string, *string) error) *NotificationDeliveryRepository_UpdateNotificationDeliveryStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNotificationDeliveryRepository creates a new instance of NotificationDeliveryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationDeliveryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationDeliveryRepository {
+	mock := &NotificationDeliveryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
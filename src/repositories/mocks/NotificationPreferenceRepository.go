@@ -0,0 +1,143 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package repositories
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	entities "github.com/verizhang/billing-engine/src/entities"
+)
+
+// NotificationPreferenceRepository is an autogenerated mock type for the NotificationPreferenceRepository type
+type NotificationPreferenceRepository struct {
+	mock.Mock
+}
+
+type NotificationPreferenceRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NotificationPreferenceRepository) EXPECT() *NotificationPreferenceRepository_Expecter {
+	return &NotificationPreferenceRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetNotificationPreferenceByUserID provides a mock function with given fields: ctx, userID
+func (_m *NotificationPreferenceRepository) GetNotificationPreferenceByUserID(ctx context.Context, userID string) (*entities.NotificationPreference, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationPreferenceByUserID")
+	}
+
+	var r0 *entities.NotificationPreference
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.NotificationPreference, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.NotificationPreference); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.NotificationPreference)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NotificationPreferenceRepository_GetNotificationPreferenceByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationPreferenceByUserID'
+type NotificationPreferenceRepository_GetNotificationPreferenceByUserID_Call struct {
+	*mock.Call
+}
+
+// GetNotificationPreferenceByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *NotificationPreferenceRepository_Expecter) GetNotificationPreferenceByUserID(ctx interface{}, userID interface{}) *NotificationPreferenceRepository_GetNotificationPreferenceByUserID_Call {
+	return &NotificationPreferenceRepository_GetNotificationPreferenceByUserID_Call{Call: _e.mock.On("GetNotificationPreferenceByUserID", ctx, userID)}
+}
+
+func (_c *NotificationPreferenceRepository_GetNotificationPreferenceByUserID_Call) Run(run func(ctx context.Context, userID string)) *NotificationPreferenceRepository_GetNotificationPreferenceByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *NotificationPreferenceRepository_GetNotificationPreferenceByUserID_Call) Return(_a0 *entities.NotificationPreference, _a1 error) *NotificationPreferenceRepository_GetNotificationPreferenceByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NotificationPreferenceRepository_GetNotificationPreferenceByUserID_Call) RunAndReturn(run func(context.Context, string) (*entities.NotificationPreference, error)) *NotificationPreferenceRepository_GetNotificationPreferenceByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveNotificationPreference provides a mock function with given fields: ctx, preference
+func (_m *NotificationPreferenceRepository) SaveNotificationPreference(ctx context.Context, preference *entities.NotificationPreference) error {
+	ret := _m.Called(ctx, preference)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveNotificationPreference")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.NotificationPreference) error); ok {
+		r0 = rf(ctx, preference)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NotificationPreferenceRepository_SaveNotificationPreference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveNotificationPreference'
+type NotificationPreferenceRepository_SaveNotificationPreference_Call struct {
+	*mock.Call
+}
+
+// SaveNotificationPreference is a helper method to define mock.On call
+//   - ctx context.Context
+//   - preference *entities.NotificationPreference
+func (_e *NotificationPreferenceRepository_Expecter) SaveNotificationPreference(ctx interface{}, preference interface{}) *NotificationPreferenceRepository_SaveNotificationPreference_Call {
+	return &NotificationPreferenceRepository_SaveNotificationPreference_Call{Call: _e.mock.On("SaveNotificationPreference", ctx, preference)}
+}
+
+func (_c *NotificationPreferenceRepository_SaveNotificationPreference_Call) Run(run func(ctx context.Context, preference *entities.NotificationPreference)) *NotificationPreferenceRepository_SaveNotificationPreference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.NotificationPreference))
+	})
+	return _c
+}
+
+func (_c *NotificationPreferenceRepository_SaveNotificationPreference_Call) Return(_a0 error) *NotificationPreferenceRepository_SaveNotificationPreference_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NotificationPreferenceRepository_SaveNotificationPreference_Call) RunAndReturn(run func(context.Context, *entities.NotificationPreference) error) *NotificationPreferenceRepository_SaveNotificationPreference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNotificationPreferenceRepository creates a new instance of NotificationPreferenceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationPreferenceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationPreferenceRepository {
+	mock := &NotificationPreferenceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,573 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package repositories
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	entities "github.com/verizhang/billing-engine/src/entities"
+
+	time "time"
+)
+
+// OutboxEventRepository is an autogenerated mock type for the OutboxEventRepository type
+type OutboxEventRepository struct {
+	mock.Mock
+}
+
+type OutboxEventRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OutboxEventRepository) EXPECT() *OutboxEventRepository_Expecter {
+	return &OutboxEventRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimOutboxEvents provides a mock function with given fields: ctx, now, lockedUntil, limit
+func (_m *OutboxEventRepository) ClaimOutboxEvents(ctx context.Context, now time.Time, lockedUntil time.Time, limit int) ([]*entities.OutboxEvent, error) {
+	ret := _m.Called(ctx, now, lockedUntil, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimOutboxEvents")
+	}
+
+	var r0 []*entities.OutboxEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) ([]*entities.OutboxEvent, error)); ok {
+		return rf(ctx, now, lockedUntil, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) []*entities.OutboxEvent); ok {
+		r0 = rf(ctx, now, lockedUntil, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OutboxEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, int) error); ok {
+		r1 = rf(ctx, now, lockedUntil, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OutboxEventRepository_ClaimOutboxEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimOutboxEvents'
+type OutboxEventRepository_ClaimOutboxEvents_Call struct {
+	*mock.Call
+}
+
+// ClaimOutboxEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - lockedUntil time.Time
+//   - limit int
+func (_e *OutboxEventRepository_Expecter) ClaimOutboxEvents(ctx interface{}, now interface{}, lockedUntil interface{}, limit interface{}) *OutboxEventRepository_ClaimOutboxEvents_Call {
+	return &OutboxEventRepository_ClaimOutboxEvents_Call{Call: _e.mock.On("ClaimOutboxEvents", ctx, now, lockedUntil, limit)}
+}
+
+func (_c *OutboxEventRepository_ClaimOutboxEvents_Call) Run(run func(ctx context.Context, now time.Time, lockedUntil time.Time, limit int)) *OutboxEventRepository_ClaimOutboxEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *OutboxEventRepository_ClaimOutboxEvents_Call) Return(_a0 []*entities.OutboxEvent, _a1 error) *OutboxEventRepository_ClaimOutboxEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OutboxEventRepository_ClaimOutboxEvents_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, int) ([]*entities.OutboxEvent, error)) *OutboxEventRepository_ClaimOutboxEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOutboxEvent provides a mock function with given fields: ctx, event
+func (_m *OutboxEventRepository) CreateOutboxEvent(ctx context.Context, event *entities.OutboxEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOutboxEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.OutboxEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OutboxEventRepository_CreateOutboxEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOutboxEvent'
+type OutboxEventRepository_CreateOutboxEvent_Call struct {
+	*mock.Call
+}
+
+// CreateOutboxEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *entities.OutboxEvent
+func (_e *OutboxEventRepository_Expecter) CreateOutboxEvent(ctx interface{}, event interface{}) *OutboxEventRepository_CreateOutboxEvent_Call {
+	return &OutboxEventRepository_CreateOutboxEvent_Call{Call: _e.mock.On("CreateOutboxEvent", ctx, event)}
+}
+
+func (_c *OutboxEventRepository_CreateOutboxEvent_Call) Run(run func(ctx context.Context, event *entities.OutboxEvent)) *OutboxEventRepository_CreateOutboxEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.OutboxEvent))
+	})
+	return _c
+}
+
+func (_c *OutboxEventRepository_CreateOutboxEvent_Call) Return(_a0 error) *OutboxEventRepository_CreateOutboxEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OutboxEventRepository_CreateOutboxEvent_Call) RunAndReturn(run func(context.Context, *entities.OutboxEvent) error) *OutboxEventRepository_CreateOutboxEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLastOutboxEventByLoanID provides a mock function with given fields: ctx, loanID
+func (_m *OutboxEventRepository) GetLastOutboxEventByLoanID(ctx context.Context, loanID string) (*entities.OutboxEvent, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastOutboxEventByLoanID")
+	}
+
+	var r0 *entities.OutboxEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.OutboxEvent, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.OutboxEvent); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OutboxEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OutboxEventRepository_GetLastOutboxEventByLoanID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastOutboxEventByLoanID'
+type OutboxEventRepository_GetLastOutboxEventByLoanID_Call struct {
+	*mock.Call
+}
+
+// GetLastOutboxEventByLoanID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID string
+func (_e *OutboxEventRepository_Expecter) GetLastOutboxEventByLoanID(ctx interface{}, loanID interface{}) *OutboxEventRepository_GetLastOutboxEventByLoanID_Call {
+	return &OutboxEventRepository_GetLastOutboxEventByLoanID_Call{Call: _e.mock.On("GetLastOutboxEventByLoanID", ctx, loanID)}
+}
+
+func (_c *OutboxEventRepository_GetLastOutboxEventByLoanID_Call) Run(run func(ctx context.Context, loanID string)) *OutboxEventRepository_GetLastOutboxEventByLoanID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OutboxEventRepository_GetLastOutboxEventByLoanID_Call) Return(_a0 *entities.OutboxEvent, _a1 error) *OutboxEventRepository_GetLastOutboxEventByLoanID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OutboxEventRepository_GetLastOutboxEventByLoanID_Call) RunAndReturn(run func(context.Context, string) (*entities.OutboxEvent, error)) *OutboxEventRepository_GetLastOutboxEventByLoanID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOutboxEventByID provides a mock function with given fields: ctx, ID
+func (_m *OutboxEventRepository) GetOutboxEventByID(ctx context.Context, ID string) (*entities.OutboxEvent, error) {
+	ret := _m.Called(ctx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOutboxEventByID")
+	}
+
+	var r0 *entities.OutboxEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.OutboxEvent, error)); ok {
+		return rf(ctx, ID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.OutboxEvent); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.OutboxEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OutboxEventRepository_GetOutboxEventByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOutboxEventByID'
+type OutboxEventRepository_GetOutboxEventByID_Call struct {
+	*mock.Call
+}
+
+// GetOutboxEventByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+func (_e *OutboxEventRepository_Expecter) GetOutboxEventByID(ctx interface{}, ID interface{}) *OutboxEventRepository_GetOutboxEventByID_Call {
+	return &OutboxEventRepository_GetOutboxEventByID_Call{Call: _e.mock.On("GetOutboxEventByID", ctx, ID)}
+}
+
+func (_c *OutboxEventRepository_GetOutboxEventByID_Call) Run(run func(ctx context.Context, ID string)) *OutboxEventRepository_GetOutboxEventByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OutboxEventRepository_GetOutboxEventByID_Call) Return(_a0 *entities.OutboxEvent, _a1 error) *OutboxEventRepository_GetOutboxEventByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OutboxEventRepository_GetOutboxEventByID_Call) RunAndReturn(run func(context.Context, string) (*entities.OutboxEvent, error)) *OutboxEventRepository_GetOutboxEventByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOutboxEventsToFanOut provides a mock function with given fields: ctx, limit
+func (_m *OutboxEventRepository) GetOutboxEventsToFanOut(ctx context.Context, limit int) ([]*entities.OutboxEvent, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOutboxEventsToFanOut")
+	}
+
+	var r0 []*entities.OutboxEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*entities.OutboxEvent, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*entities.OutboxEvent); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.OutboxEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OutboxEventRepository_GetOutboxEventsToFanOut_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOutboxEventsToFanOut'
+type OutboxEventRepository_GetOutboxEventsToFanOut_Call struct {
+	*mock.Call
+}
+
+// GetOutboxEventsToFanOut is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *OutboxEventRepository_Expecter) GetOutboxEventsToFanOut(ctx interface{}, limit interface{}) *OutboxEventRepository_GetOutboxEventsToFanOut_Call {
+	return &OutboxEventRepository_GetOutboxEventsToFanOut_Call{Call: _e.mock.On("GetOutboxEventsToFanOut", ctx, limit)}
+}
+
+func (_c *OutboxEventRepository_GetOutboxEventsToFanOut_Call) Run(run func(ctx context.Context, limit int)) *OutboxEventRepository_GetOutboxEventsToFanOut_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *OutboxEventRepository_GetOutboxEventsToFanOut_Call) Return(_a0 []*entities.OutboxEvent, _a1 error) *OutboxEventRepository_GetOutboxEventsToFanOut_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OutboxEventRepository_GetOutboxEventsToFanOut_Call) RunAndReturn(run func(context.Context, int) ([]*entities.OutboxEvent, error)) *OutboxEventRepository_GetOutboxEventsToFanOut_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseOutboxEvents provides a mock function with given fields: ctx, IDs
+func (_m *OutboxEventRepository) ReleaseOutboxEvents(ctx context.Context, IDs []string) error {
+	ret := _m.Called(ctx, IDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseOutboxEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = rf(ctx, IDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OutboxEventRepository_ReleaseOutboxEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseOutboxEvents'
+type OutboxEventRepository_ReleaseOutboxEvents_Call struct {
+	*mock.Call
+}
+
+// ReleaseOutboxEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - IDs []string
+func (_e *OutboxEventRepository_Expecter) ReleaseOutboxEvents(ctx interface{}, IDs interface{}) *OutboxEventRepository_ReleaseOutboxEvents_Call {
+	return &OutboxEventRepository_ReleaseOutboxEvents_Call{Call: _e.mock.On("ReleaseOutboxEvents", ctx, IDs)}
+}
+
+func (_c *OutboxEventRepository_ReleaseOutboxEvents_Call) Run(run func(ctx context.Context, IDs []string)) *OutboxEventRepository_ReleaseOutboxEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *OutboxEventRepository_ReleaseOutboxEvents_Call) Return(_a0 error) *OutboxEventRepository_ReleaseOutboxEvents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OutboxEventRepository_ReleaseOutboxEvents_Call) RunAndReturn(run func(context.Context, []string) error) *OutboxEventRepository_ReleaseOutboxEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TryLockRelay provides a mock function with given fields: ctx
+func (_m *OutboxEventRepository) TryLockRelay(ctx context.Context) (bool, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TryLockRelay")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (bool, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) bool); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OutboxEventRepository_TryLockRelay_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TryLockRelay'
+type OutboxEventRepository_TryLockRelay_Call struct {
+	*mock.Call
+}
+
+// TryLockRelay is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OutboxEventRepository_Expecter) TryLockRelay(ctx interface{}) *OutboxEventRepository_TryLockRelay_Call {
+	return &OutboxEventRepository_TryLockRelay_Call{Call: _e.mock.On("TryLockRelay", ctx)}
+}
+
+func (_c *OutboxEventRepository_TryLockRelay_Call) Run(run func(ctx context.Context)) *OutboxEventRepository_TryLockRelay_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OutboxEventRepository_TryLockRelay_Call) Return(_a0 bool, _a1 error) *OutboxEventRepository_TryLockRelay_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OutboxEventRepository_TryLockRelay_Call) RunAndReturn(run func(context.Context) (bool, error)) *OutboxEventRepository_TryLockRelay_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFailedOutboxEventByID provides a mock function with given fields: ctx, ID, lastError, lockedUntil, parkedAt
+func (_m *OutboxEventRepository) UpdateFailedOutboxEventByID(ctx context.Context, ID string, lastError string, lockedUntil time.Time, parkedAt *time.Time) error {
+	ret := _m.Called(ctx, ID, lastError, lockedUntil, parkedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFailedOutboxEventByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time, *time.Time) error); ok {
+		r0 = rf(ctx, ID, lastError, lockedUntil, parkedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OutboxEventRepository_UpdateFailedOutboxEventByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFailedOutboxEventByID'
+type OutboxEventRepository_UpdateFailedOutboxEventByID_Call struct {
+	*mock.Call
+}
+
+// UpdateFailedOutboxEventByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+//   - lastError string
+//   - lockedUntil time.Time
+//   - parkedAt *time.Time
+func (_e *OutboxEventRepository_Expecter) UpdateFailedOutboxEventByID(ctx interface{}, ID interface{}, lastError interface{}, lockedUntil interface{}, parkedAt interface{}) *OutboxEventRepository_UpdateFailedOutboxEventByID_Call {
+	return &OutboxEventRepository_UpdateFailedOutboxEventByID_Call{Call: _e.mock.On("UpdateFailedOutboxEventByID", ctx, ID, lastError, lockedUntil, parkedAt)}
+}
+
+func (_c *OutboxEventRepository_UpdateFailedOutboxEventByID_Call) Run(run func(ctx context.Context, ID string, lastError string, lockedUntil time.Time, parkedAt *time.Time)) *OutboxEventRepository_UpdateFailedOutboxEventByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Time), args[4].(*time.Time))
+	})
+	return _c
+}
+
+func (_c *OutboxEventRepository_UpdateFailedOutboxEventByID_Call) Return(_a0 error) *OutboxEventRepository_UpdateFailedOutboxEventByID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OutboxEventRepository_UpdateFailedOutboxEventByID_Call) RunAndReturn(run func(context.Context, string, string, time.Time, *time.Time) error) *OutboxEventRepository_UpdateFailedOutboxEventByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateFannedOutOutboxEventByID provides a mock function with given fields: ctx, ID, fannedOutAt
+func (_m *OutboxEventRepository) UpdateFannedOutOutboxEventByID(ctx context.Context, ID string, fannedOutAt time.Time) error {
+	ret := _m.Called(ctx, ID, fannedOutAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFannedOutOutboxEventByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, ID, fannedOutAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OutboxEventRepository_UpdateFannedOutOutboxEventByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateFannedOutOutboxEventByID'
+type OutboxEventRepository_UpdateFannedOutOutboxEventByID_Call struct {
+	*mock.Call
+}
+
+// UpdateFannedOutOutboxEventByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+//   - fannedOutAt time.Time
+func (_e *OutboxEventRepository_Expecter) UpdateFannedOutOutboxEventByID(ctx interface{}, ID interface{}, fannedOutAt interface{}) *OutboxEventRepository_UpdateFannedOutOutboxEventByID_Call {
+	return &OutboxEventRepository_UpdateFannedOutOutboxEventByID_Call{Call: _e.mock.On("UpdateFannedOutOutboxEventByID", ctx, ID, fannedOutAt)}
+}
+
+func (_c *OutboxEventRepository_UpdateFannedOutOutboxEventByID_Call) Run(run func(ctx context.Context, ID string, fannedOutAt time.Time)) *OutboxEventRepository_UpdateFannedOutOutboxEventByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *OutboxEventRepository_UpdateFannedOutOutboxEventByID_Call) Return(_a0 error) *OutboxEventRepository_UpdateFannedOutOutboxEventByID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OutboxEventRepository_UpdateFannedOutOutboxEventByID_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *OutboxEventRepository_UpdateFannedOutOutboxEventByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePublishedOutboxEventByID provides a mock function with given fields: ctx, ID, publishedAt
+func (_m *OutboxEventRepository) UpdatePublishedOutboxEventByID(ctx context.Context, ID string, publishedAt time.Time) error {
+	ret := _m.Called(ctx, ID, publishedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePublishedOutboxEventByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, ID, publishedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OutboxEventRepository_UpdatePublishedOutboxEventByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePublishedOutboxEventByID'
+type OutboxEventRepository_UpdatePublishedOutboxEventByID_Call struct {
+	*mock.Call
+}
+
+// UpdatePublishedOutboxEventByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+//   - publishedAt time.Time
+func (_e *OutboxEventRepository_Expecter) UpdatePublishedOutboxEventByID(ctx interface{}, ID interface{}, publishedAt interface{}) *OutboxEventRepository_UpdatePublishedOutboxEventByID_Call {
+	return &OutboxEventRepository_UpdatePublishedOutboxEventByID_Call{Call: _e.mock.On("UpdatePublishedOutboxEventByID", ctx, ID, publishedAt)}
+}
+
+func (_c *OutboxEventRepository_UpdatePublishedOutboxEventByID_Call) Run(run func(ctx context.Context, ID string, publishedAt time.Time)) *OutboxEventRepository_UpdatePublishedOutboxEventByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *OutboxEventRepository_UpdatePublishedOutboxEventByID_Call) Return(_a0 error) *OutboxEventRepository_UpdatePublishedOutboxEventByID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OutboxEventRepository_UpdatePublishedOutboxEventByID_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *OutboxEventRepository_UpdatePublishedOutboxEventByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOutboxEventRepository creates a new instance of OutboxEventRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOutboxEventRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OutboxEventRepository {
+	mock := &OutboxEventRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,143 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package repositories
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	entities "github.com/verizhang/billing-engine/src/entities"
+)
+
+// PaymentDeferralRepository is an autogenerated mock type for the PaymentDeferralRepository type
+type PaymentDeferralRepository struct {
+	mock.Mock
+}
+
+type PaymentDeferralRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PaymentDeferralRepository) EXPECT() *PaymentDeferralRepository_Expecter {
+	return &PaymentDeferralRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreatePaymentDeferral provides a mock function with given fields: ctx, deferral
+func (_m *PaymentDeferralRepository) CreatePaymentDeferral(ctx context.Context, deferral *entities.PaymentDeferral) error {
+	ret := _m.Called(ctx, deferral)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePaymentDeferral")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.PaymentDeferral) error); ok {
+		r0 = rf(ctx, deferral)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PaymentDeferralRepository_CreatePaymentDeferral_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePaymentDeferral'
+type PaymentDeferralRepository_CreatePaymentDeferral_Call struct {
+	*mock.Call
+}
+
+// CreatePaymentDeferral is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deferral *entities.PaymentDeferral
+func (_e *PaymentDeferralRepository_Expecter) CreatePaymentDeferral(ctx interface{}, deferral interface{}) *PaymentDeferralRepository_CreatePaymentDeferral_Call {
+	return &PaymentDeferralRepository_CreatePaymentDeferral_Call{Call: _e.mock.On("CreatePaymentDeferral", ctx, deferral)}
+}
+
+func (_c *PaymentDeferralRepository_CreatePaymentDeferral_Call) Run(run func(ctx context.Context, deferral *entities.PaymentDeferral)) *PaymentDeferralRepository_CreatePaymentDeferral_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.PaymentDeferral))
+	})
+	return _c
+}
+
+func (_c *PaymentDeferralRepository_CreatePaymentDeferral_Call) Return(_a0 error) *PaymentDeferralRepository_CreatePaymentDeferral_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentDeferralRepository_CreatePaymentDeferral_Call) RunAndReturn(run func(context.Context, *entities.PaymentDeferral) error) *PaymentDeferralRepository_CreatePaymentDeferral_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPaymentDeferralsByLoanID provides a mock function with given fields: ctx, loanID
+func (_m *PaymentDeferralRepository) GetPaymentDeferralsByLoanID(ctx context.Context, loanID string) ([]*entities.PaymentDeferral, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaymentDeferralsByLoanID")
+	}
+
+	var r0 []*entities.PaymentDeferral
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*entities.PaymentDeferral, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*entities.PaymentDeferral); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.PaymentDeferral)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentDeferralRepository_GetPaymentDeferralsByLoanID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaymentDeferralsByLoanID'
+type PaymentDeferralRepository_GetPaymentDeferralsByLoanID_Call struct {
+	*mock.Call
+}
+
+// GetPaymentDeferralsByLoanID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID string
+func (_e *PaymentDeferralRepository_Expecter) GetPaymentDeferralsByLoanID(ctx interface{}, loanID interface{}) *PaymentDeferralRepository_GetPaymentDeferralsByLoanID_Call {
+	return &PaymentDeferralRepository_GetPaymentDeferralsByLoanID_Call{Call: _e.mock.On("GetPaymentDeferralsByLoanID", ctx, loanID)}
+}
+
+func (_c *PaymentDeferralRepository_GetPaymentDeferralsByLoanID_Call) Run(run func(ctx context.Context, loanID string)) *PaymentDeferralRepository_GetPaymentDeferralsByLoanID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PaymentDeferralRepository_GetPaymentDeferralsByLoanID_Call) Return(_a0 []*entities.PaymentDeferral, _a1 error) *PaymentDeferralRepository_GetPaymentDeferralsByLoanID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentDeferralRepository_GetPaymentDeferralsByLoanID_Call) RunAndReturn(run func(context.Context, string) ([]*entities.PaymentDeferral, error)) *PaymentDeferralRepository_GetPaymentDeferralsByLoanID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPaymentDeferralRepository creates a new instance of PaymentDeferralRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPaymentDeferralRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PaymentDeferralRepository {
+	mock := &PaymentDeferralRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
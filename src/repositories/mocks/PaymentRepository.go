@@ -24,6 +24,65 @@ func (_m *PaymentRepository) EXPECT() *PaymentRepository_Expecter {
 	return &PaymentRepository_Expecter{mock: &_m.Mock}
 }
 
+// ChargeLateFeePayment provides a mock function with given fields: ctx, ID, fee, chargedAt
+func (_m *PaymentRepository) ChargeLateFeePayment(ctx context.Context, ID string, fee float64, chargedAt time.Time) (bool, error) {
+	ret := _m.Called(ctx, ID, fee, chargedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChargeLateFeePayment")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64, time.Time) (bool, error)); ok {
+		return rf(ctx, ID, fee, chargedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64, time.Time) bool); ok {
+		r0 = rf(ctx, ID, fee, chargedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, float64, time.Time) error); ok {
+		r1 = rf(ctx, ID, fee, chargedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentRepository_ChargeLateFeePayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChargeLateFeePayment'
+type PaymentRepository_ChargeLateFeePayment_Call struct {
+	*mock.Call
+}
+
+// ChargeLateFeePayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+//   - fee float64
+//   - chargedAt time.Time
+func (_e *PaymentRepository_Expecter) ChargeLateFeePayment(ctx interface{}, ID interface{}, fee interface{}, chargedAt interface{}) *PaymentRepository_ChargeLateFeePayment_Call {
+	return &PaymentRepository_ChargeLateFeePayment_Call{Call: _e.mock.On("ChargeLateFeePayment", ctx, ID, fee, chargedAt)}
+}
+
+func (_c *PaymentRepository_ChargeLateFeePayment_Call) Run(run func(ctx context.Context, ID string, fee float64, chargedAt time.Time)) *PaymentRepository_ChargeLateFeePayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(float64), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *PaymentRepository_ChargeLateFeePayment_Call) Return(_a0 bool, _a1 error) *PaymentRepository_ChargeLateFeePayment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentRepository_ChargeLateFeePayment_Call) RunAndReturn(run func(context.Context, string, float64, time.Time) (bool, error)) *PaymentRepository_ChargeLateFeePayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreatePayments provides a mock function with given fields: ctx, payments
 func (_m *PaymentRepository) CreatePayments(ctx context.Context, payments []*entities.Payment) error {
 	ret := _m.Called(ctx, payments)
@@ -71,6 +130,65 @@ func (_c *PaymentRepository_CreatePayments_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetLateFeeDuePayments provides a mock function with given fields: ctx, dueBefore
+func (_m *PaymentRepository) GetLateFeeDuePayments(ctx context.Context, dueBefore time.Time) ([]*entities.Payment, error) {
+	ret := _m.Called(ctx, dueBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLateFeeDuePayments")
+	}
+
+	var r0 []*entities.Payment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]*entities.Payment, error)); ok {
+		return rf(ctx, dueBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []*entities.Payment); ok {
+		r0 = rf(ctx, dueBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Payment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, dueBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentRepository_GetLateFeeDuePayments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLateFeeDuePayments'
+type PaymentRepository_GetLateFeeDuePayments_Call struct {
+	*mock.Call
+}
+
+// GetLateFeeDuePayments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dueBefore time.Time
+func (_e *PaymentRepository_Expecter) GetLateFeeDuePayments(ctx interface{}, dueBefore interface{}) *PaymentRepository_GetLateFeeDuePayments_Call {
+	return &PaymentRepository_GetLateFeeDuePayments_Call{Call: _e.mock.On("GetLateFeeDuePayments", ctx, dueBefore)}
+}
+
+func (_c *PaymentRepository_GetLateFeeDuePayments_Call) Run(run func(ctx context.Context, dueBefore time.Time)) *PaymentRepository_GetLateFeeDuePayments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *PaymentRepository_GetLateFeeDuePayments_Call) Return(_a0 []*entities.Payment, _a1 error) *PaymentRepository_GetLateFeeDuePayments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentRepository_GetLateFeeDuePayments_Call) RunAndReturn(run func(context.Context, time.Time) ([]*entities.Payment, error)) *PaymentRepository_GetLateFeeDuePayments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPaymentByLoanID provides a mock function with given fields: ctx, loanID
 func (_m *PaymentRepository) GetPaymentByLoanID(ctx context.Context, loanID string) ([]*entities.Payment, error) {
 	ret := _m.Called(ctx, loanID)
@@ -130,22 +248,328 @@ func (_c *PaymentRepository_GetPaymentByLoanID_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// GetPaymentHistoryByLoanID provides a mock function with given fields: ctx, loanID
+func (_m *PaymentRepository) GetPaymentHistoryByLoanID(ctx context.Context, loanID string) ([]*entities.Payment, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaymentHistoryByLoanID")
+	}
+
+	var r0 []*entities.Payment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*entities.Payment, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*entities.Payment); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Payment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentRepository_GetPaymentHistoryByLoanID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaymentHistoryByLoanID'
+type PaymentRepository_GetPaymentHistoryByLoanID_Call struct {
+	*mock.Call
+}
+
+// GetPaymentHistoryByLoanID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID string
+func (_e *PaymentRepository_Expecter) GetPaymentHistoryByLoanID(ctx interface{}, loanID interface{}) *PaymentRepository_GetPaymentHistoryByLoanID_Call {
+	return &PaymentRepository_GetPaymentHistoryByLoanID_Call{Call: _e.mock.On("GetPaymentHistoryByLoanID", ctx, loanID)}
+}
+
+func (_c *PaymentRepository_GetPaymentHistoryByLoanID_Call) Run(run func(ctx context.Context, loanID string)) *PaymentRepository_GetPaymentHistoryByLoanID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PaymentRepository_GetPaymentHistoryByLoanID_Call) Return(_a0 []*entities.Payment, _a1 error) *PaymentRepository_GetPaymentHistoryByLoanID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentRepository_GetPaymentHistoryByLoanID_Call) RunAndReturn(run func(context.Context, string) ([]*entities.Payment, error)) *PaymentRepository_GetPaymentHistoryByLoanID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReminderDuePayments provides a mock function with given fields: ctx, now, dueBefore
+func (_m *PaymentRepository) GetReminderDuePayments(ctx context.Context, now time.Time, dueBefore time.Time) ([]*entities.Payment, error) {
+	ret := _m.Called(ctx, now, dueBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReminderDuePayments")
+	}
+
+	var r0 []*entities.Payment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]*entities.Payment, error)); ok {
+		return rf(ctx, now, dueBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []*entities.Payment); ok {
+		r0 = rf(ctx, now, dueBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Payment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, now, dueBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentRepository_GetReminderDuePayments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReminderDuePayments'
+type PaymentRepository_GetReminderDuePayments_Call struct {
+	*mock.Call
+}
+
+// GetReminderDuePayments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - dueBefore time.Time
+func (_e *PaymentRepository_Expecter) GetReminderDuePayments(ctx interface{}, now interface{}, dueBefore interface{}) *PaymentRepository_GetReminderDuePayments_Call {
+	return &PaymentRepository_GetReminderDuePayments_Call{Call: _e.mock.On("GetReminderDuePayments", ctx, now, dueBefore)}
+}
+
+func (_c *PaymentRepository_GetReminderDuePayments_Call) Run(run func(ctx context.Context, now time.Time, dueBefore time.Time)) *PaymentRepository_GetReminderDuePayments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *PaymentRepository_GetReminderDuePayments_Call) Return(_a0 []*entities.Payment, _a1 error) *PaymentRepository_GetReminderDuePayments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentRepository_GetReminderDuePayments_Call) RunAndReturn(run func(context.Context, time.Time, time.Time) ([]*entities.Payment, error)) *PaymentRepository_GetReminderDuePayments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUnpaidPaymentsEndingBetween provides a mock function with given fields: ctx, from, to
+func (_m *PaymentRepository) GetUnpaidPaymentsEndingBetween(ctx context.Context, from time.Time, to time.Time) ([]*entities.Payment, error) {
+	ret := _m.Called(ctx, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUnpaidPaymentsEndingBetween")
+	}
+
+	var r0 []*entities.Payment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]*entities.Payment, error)); ok {
+		return rf(ctx, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []*entities.Payment); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Payment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentRepository_GetUnpaidPaymentsEndingBetween_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUnpaidPaymentsEndingBetween'
+type PaymentRepository_GetUnpaidPaymentsEndingBetween_Call struct {
+	*mock.Call
+}
+
+// GetUnpaidPaymentsEndingBetween is a helper method to define mock.On call
+//   - ctx context.Context
+//   - from time.Time
+//   - to time.Time
+func (_e *PaymentRepository_Expecter) GetUnpaidPaymentsEndingBetween(ctx interface{}, from interface{}, to interface{}) *PaymentRepository_GetUnpaidPaymentsEndingBetween_Call {
+	return &PaymentRepository_GetUnpaidPaymentsEndingBetween_Call{Call: _e.mock.On("GetUnpaidPaymentsEndingBetween", ctx, from, to)}
+}
+
+func (_c *PaymentRepository_GetUnpaidPaymentsEndingBetween_Call) Run(run func(ctx context.Context, from time.Time, to time.Time)) *PaymentRepository_GetUnpaidPaymentsEndingBetween_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *PaymentRepository_GetUnpaidPaymentsEndingBetween_Call) Return(_a0 []*entities.Payment, _a1 error) *PaymentRepository_GetUnpaidPaymentsEndingBetween_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentRepository_GetUnpaidPaymentsEndingBetween_Call) RunAndReturn(run func(context.Context, time.Time, time.Time) ([]*entities.Payment, error)) *PaymentRepository_GetUnpaidPaymentsEndingBetween_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplacePayments provides a mock function with given fields: ctx, IDs, scheduleID, deletedAt
+func (_m *PaymentRepository) ReplacePayments(ctx context.Context, IDs []string, scheduleID string, deletedAt *time.Time) (int, error) {
+	ret := _m.Called(ctx, IDs, scheduleID, deletedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplacePayments")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, *time.Time) (int, error)); ok {
+		return rf(ctx, IDs, scheduleID, deletedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string, *time.Time) int); ok {
+		r0 = rf(ctx, IDs, scheduleID, deletedAt)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, string, *time.Time) error); ok {
+		r1 = rf(ctx, IDs, scheduleID, deletedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentRepository_ReplacePayments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplacePayments'
+type PaymentRepository_ReplacePayments_Call struct {
+	*mock.Call
+}
+
+// ReplacePayments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - IDs []string
+//   - scheduleID string
+//   - deletedAt *time.Time
+func (_e *PaymentRepository_Expecter) ReplacePayments(ctx interface{}, IDs interface{}, scheduleID interface{}, deletedAt interface{}) *PaymentRepository_ReplacePayments_Call {
+	return &PaymentRepository_ReplacePayments_Call{Call: _e.mock.On("ReplacePayments", ctx, IDs, scheduleID, deletedAt)}
+}
+
+func (_c *PaymentRepository_ReplacePayments_Call) Run(run func(ctx context.Context, IDs []string, scheduleID string, deletedAt *time.Time)) *PaymentRepository_ReplacePayments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(string), args[3].(*time.Time))
+	})
+	return _c
+}
+
+func (_c *PaymentRepository_ReplacePayments_Call) Return(_a0 int, _a1 error) *PaymentRepository_ReplacePayments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentRepository_ReplacePayments_Call) RunAndReturn(run func(context.Context, []string, string, *time.Time) (int, error)) *PaymentRepository_ReplacePayments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SoftDeletePayments provides a mock function with given fields: ctx, IDs, deletedAt
+func (_m *PaymentRepository) SoftDeletePayments(ctx context.Context, IDs []string, deletedAt *time.Time) (int, error) {
+	ret := _m.Called(ctx, IDs, deletedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SoftDeletePayments")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, *time.Time) (int, error)); ok {
+		return rf(ctx, IDs, deletedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, *time.Time) int); ok {
+		r0 = rf(ctx, IDs, deletedAt)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, *time.Time) error); ok {
+		r1 = rf(ctx, IDs, deletedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentRepository_SoftDeletePayments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SoftDeletePayments'
+type PaymentRepository_SoftDeletePayments_Call struct {
+	*mock.Call
+}
+
+// SoftDeletePayments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - IDs []string
+//   - deletedAt *time.Time
+func (_e *PaymentRepository_Expecter) SoftDeletePayments(ctx interface{}, IDs interface{}, deletedAt interface{}) *PaymentRepository_SoftDeletePayments_Call {
+	return &PaymentRepository_SoftDeletePayments_Call{Call: _e.mock.On("SoftDeletePayments", ctx, IDs, deletedAt)}
+}
+
+func (_c *PaymentRepository_SoftDeletePayments_Call) Run(run func(ctx context.Context, IDs []string, deletedAt *time.Time)) *PaymentRepository_SoftDeletePayments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(*time.Time))
+	})
+	return _c
+}
+
+func (_c *PaymentRepository_SoftDeletePayments_Call) Return(_a0 int, _a1 error) *PaymentRepository_SoftDeletePayments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentRepository_SoftDeletePayments_Call) RunAndReturn(run func(context.Context, []string, *time.Time) (int, error)) *PaymentRepository_SoftDeletePayments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdatePaidAtPayment provides a mock function with given fields: ctx, ID, paidAt
-func (_m *PaymentRepository) UpdatePaidAtPayment(ctx context.Context, ID string, paidAt *time.Time) error {
+func (_m *PaymentRepository) UpdatePaidAtPayment(ctx context.Context, ID string, paidAt *time.Time) (bool, error) {
 	ret := _m.Called(ctx, ID, paidAt)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdatePaidAtPayment")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time) error); ok {
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time) (bool, error)); ok {
+		return rf(ctx, ID, paidAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time) bool); ok {
 		r0 = rf(ctx, ID, paidAt)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(bool)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string, *time.Time) error); ok {
+		r1 = rf(ctx, ID, paidAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // PaymentRepository_UpdatePaidAtPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePaidAtPayment'
@@ -168,12 +592,117 @@ func (_c *PaymentRepository_UpdatePaidAtPayment_Call) Run(run func(ctx context.C
 	return _c
 }
 
-func (_c *PaymentRepository_UpdatePaidAtPayment_Call) Return(_a0 error) *PaymentRepository_UpdatePaidAtPayment_Call {
+func (_c *PaymentRepository_UpdatePaidAtPayment_Call) Return(_a0 bool, _a1 error) *PaymentRepository_UpdatePaidAtPayment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentRepository_UpdatePaidAtPayment_Call) RunAndReturn(run func(context.Context, string, *time.Time) (bool, error)) *PaymentRepository_UpdatePaidAtPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePayments provides a mock function with given fields: ctx, payments
+func (_m *PaymentRepository) UpdatePayments(ctx context.Context, payments []*entities.Payment) error {
+	ret := _m.Called(ctx, payments)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePayments")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*entities.Payment) error); ok {
+		r0 = rf(ctx, payments)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PaymentRepository_UpdatePayments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePayments'
+type PaymentRepository_UpdatePayments_Call struct {
+	*mock.Call
+}
+
+// UpdatePayments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - payments []*entities.Payment
+func (_e *PaymentRepository_Expecter) UpdatePayments(ctx interface{}, payments interface{}) *PaymentRepository_UpdatePayments_Call {
+	return &PaymentRepository_UpdatePayments_Call{Call: _e.mock.On("UpdatePayments", ctx, payments)}
+}
+
+func (_c *PaymentRepository_UpdatePayments_Call) Run(run func(ctx context.Context, payments []*entities.Payment)) *PaymentRepository_UpdatePayments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*entities.Payment))
+	})
+	return _c
+}
+
+func (_c *PaymentRepository_UpdatePayments_Call) Return(_a0 error) *PaymentRepository_UpdatePayments_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *PaymentRepository_UpdatePaidAtPayment_Call) RunAndReturn(run func(context.Context, string, *time.Time) error) *PaymentRepository_UpdatePaidAtPayment_Call {
+func (_c *PaymentRepository_UpdatePayments_Call) RunAndReturn(run func(context.Context, []*entities.Payment) error) *PaymentRepository_UpdatePayments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRemindedAtPayment provides a mock function with given fields: ctx, ID, remindedAt
+func (_m *PaymentRepository) UpdateRemindedAtPayment(ctx context.Context, ID string, remindedAt time.Time) (bool, error) {
+	ret := _m.Called(ctx, ID, remindedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRemindedAtPayment")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (bool, error)); ok {
+		return rf(ctx, ID, remindedAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) bool); ok {
+		r0 = rf(ctx, ID, remindedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, ID, remindedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentRepository_UpdateRemindedAtPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRemindedAtPayment'
+type PaymentRepository_UpdateRemindedAtPayment_Call struct {
+	*mock.Call
+}
+
+// UpdateRemindedAtPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+//   - remindedAt time.Time
+func (_e *PaymentRepository_Expecter) UpdateRemindedAtPayment(ctx interface{}, ID interface{}, remindedAt interface{}) *PaymentRepository_UpdateRemindedAtPayment_Call {
+	return &PaymentRepository_UpdateRemindedAtPayment_Call{Call: _e.mock.On("UpdateRemindedAtPayment", ctx, ID, remindedAt)}
+}
+
+func (_c *PaymentRepository_UpdateRemindedAtPayment_Call) Run(run func(ctx context.Context, ID string, remindedAt time.Time)) *PaymentRepository_UpdateRemindedAtPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *PaymentRepository_UpdateRemindedAtPayment_Call) Return(_a0 bool, _a1 error) *PaymentRepository_UpdateRemindedAtPayment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentRepository_UpdateRemindedAtPayment_Call) RunAndReturn(run func(context.Context, string, time.Time) (bool, error)) *PaymentRepository_UpdateRemindedAtPayment_Call {
 	_c.Call.Return(run)
 	return _c
 }
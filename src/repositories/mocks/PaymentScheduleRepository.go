@@ -0,0 +1,202 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package repositories
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	entities "github.com/verizhang/billing-engine/src/entities"
+)
+
+// PaymentScheduleRepository is an autogenerated mock type for the PaymentScheduleRepository type
+type PaymentScheduleRepository struct {
+	mock.Mock
+}
+
+type PaymentScheduleRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PaymentScheduleRepository) EXPECT() *PaymentScheduleRepository_Expecter {
+	return &PaymentScheduleRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreatePaymentSchedule provides a mock function with given fields: ctx, schedule
+func (_m *PaymentScheduleRepository) CreatePaymentSchedule(ctx context.Context, schedule *entities.PaymentSchedule) error {
+	ret := _m.Called(ctx, schedule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePaymentSchedule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.PaymentSchedule) error); ok {
+		r0 = rf(ctx, schedule)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PaymentScheduleRepository_CreatePaymentSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePaymentSchedule'
+type PaymentScheduleRepository_CreatePaymentSchedule_Call struct {
+	*mock.Call
+}
+
+// CreatePaymentSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - schedule *entities.PaymentSchedule
+func (_e *PaymentScheduleRepository_Expecter) CreatePaymentSchedule(ctx interface{}, schedule interface{}) *PaymentScheduleRepository_CreatePaymentSchedule_Call {
+	return &PaymentScheduleRepository_CreatePaymentSchedule_Call{Call: _e.mock.On("CreatePaymentSchedule", ctx, schedule)}
+}
+
+func (_c *PaymentScheduleRepository_CreatePaymentSchedule_Call) Run(run func(ctx context.Context, schedule *entities.PaymentSchedule)) *PaymentScheduleRepository_CreatePaymentSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.PaymentSchedule))
+	})
+	return _c
+}
+
+func (_c *PaymentScheduleRepository_CreatePaymentSchedule_Call) Return(_a0 error) *PaymentScheduleRepository_CreatePaymentSchedule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentScheduleRepository_CreatePaymentSchedule_Call) RunAndReturn(run func(context.Context, *entities.PaymentSchedule) error) *PaymentScheduleRepository_CreatePaymentSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCurrentPaymentScheduleByLoanID provides a mock function with given fields: ctx, loanID
+func (_m *PaymentScheduleRepository) GetCurrentPaymentScheduleByLoanID(ctx context.Context, loanID string) (*entities.PaymentSchedule, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCurrentPaymentScheduleByLoanID")
+	}
+
+	var r0 *entities.PaymentSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.PaymentSchedule, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.PaymentSchedule); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.PaymentSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentScheduleRepository_GetCurrentPaymentScheduleByLoanID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCurrentPaymentScheduleByLoanID'
+type PaymentScheduleRepository_GetCurrentPaymentScheduleByLoanID_Call struct {
+	*mock.Call
+}
+
+// GetCurrentPaymentScheduleByLoanID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID string
+func (_e *PaymentScheduleRepository_Expecter) GetCurrentPaymentScheduleByLoanID(ctx interface{}, loanID interface{}) *PaymentScheduleRepository_GetCurrentPaymentScheduleByLoanID_Call {
+	return &PaymentScheduleRepository_GetCurrentPaymentScheduleByLoanID_Call{Call: _e.mock.On("GetCurrentPaymentScheduleByLoanID", ctx, loanID)}
+}
+
+func (_c *PaymentScheduleRepository_GetCurrentPaymentScheduleByLoanID_Call) Run(run func(ctx context.Context, loanID string)) *PaymentScheduleRepository_GetCurrentPaymentScheduleByLoanID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PaymentScheduleRepository_GetCurrentPaymentScheduleByLoanID_Call) Return(_a0 *entities.PaymentSchedule, _a1 error) *PaymentScheduleRepository_GetCurrentPaymentScheduleByLoanID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentScheduleRepository_GetCurrentPaymentScheduleByLoanID_Call) RunAndReturn(run func(context.Context, string) (*entities.PaymentSchedule, error)) *PaymentScheduleRepository_GetCurrentPaymentScheduleByLoanID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPaymentSchedulesByLoanID provides a mock function with given fields: ctx, loanID
+func (_m *PaymentScheduleRepository) GetPaymentSchedulesByLoanID(ctx context.Context, loanID string) ([]*entities.PaymentSchedule, error) {
+	ret := _m.Called(ctx, loanID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaymentSchedulesByLoanID")
+	}
+
+	var r0 []*entities.PaymentSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*entities.PaymentSchedule, error)); ok {
+		return rf(ctx, loanID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*entities.PaymentSchedule); ok {
+		r0 = rf(ctx, loanID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.PaymentSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, loanID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentScheduleRepository_GetPaymentSchedulesByLoanID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaymentSchedulesByLoanID'
+type PaymentScheduleRepository_GetPaymentSchedulesByLoanID_Call struct {
+	*mock.Call
+}
+
+// GetPaymentSchedulesByLoanID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - loanID string
+func (_e *PaymentScheduleRepository_Expecter) GetPaymentSchedulesByLoanID(ctx interface{}, loanID interface{}) *PaymentScheduleRepository_GetPaymentSchedulesByLoanID_Call {
+	return &PaymentScheduleRepository_GetPaymentSchedulesByLoanID_Call{Call: _e.mock.On("GetPaymentSchedulesByLoanID", ctx, loanID)}
+}
+
+func (_c *PaymentScheduleRepository_GetPaymentSchedulesByLoanID_Call) Run(run func(ctx context.Context, loanID string)) *PaymentScheduleRepository_GetPaymentSchedulesByLoanID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PaymentScheduleRepository_GetPaymentSchedulesByLoanID_Call) Return(_a0 []*entities.PaymentSchedule, _a1 error) *PaymentScheduleRepository_GetPaymentSchedulesByLoanID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentScheduleRepository_GetPaymentSchedulesByLoanID_Call) RunAndReturn(run func(context.Context, string) ([]*entities.PaymentSchedule, error)) *PaymentScheduleRepository_GetPaymentSchedulesByLoanID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPaymentScheduleRepository creates a new instance of PaymentScheduleRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPaymentScheduleRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PaymentScheduleRepository {
+	mock := &PaymentScheduleRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
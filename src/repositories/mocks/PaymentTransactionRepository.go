@@ -0,0 +1,257 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package repositories
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	entities "github.com/verizhang/billing-engine/src/entities"
+
+	pagination "github.com/verizhang/billing-engine/src/utils/pagination"
+
+	time "time"
+)
+
+// PaymentTransactionRepository is an autogenerated mock type for the PaymentTransactionRepository type
+type PaymentTransactionRepository struct {
+	mock.Mock
+}
+
+type PaymentTransactionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PaymentTransactionRepository) EXPECT() *PaymentTransactionRepository_Expecter {
+	return &PaymentTransactionRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreatePaymentTransaction provides a mock function with given fields: ctx, transaction
+func (_m *PaymentTransactionRepository) CreatePaymentTransaction(ctx context.Context, transaction *entities.PaymentTransaction) error {
+	ret := _m.Called(ctx, transaction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePaymentTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.PaymentTransaction) error); ok {
+		r0 = rf(ctx, transaction)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PaymentTransactionRepository_CreatePaymentTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePaymentTransaction'
+type PaymentTransactionRepository_CreatePaymentTransaction_Call struct {
+	*mock.Call
+}
+
+// CreatePaymentTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transaction *entities.PaymentTransaction
+func (_e *PaymentTransactionRepository_Expecter) CreatePaymentTransaction(ctx interface{}, transaction interface{}) *PaymentTransactionRepository_CreatePaymentTransaction_Call {
+	return &PaymentTransactionRepository_CreatePaymentTransaction_Call{Call: _e.mock.On("CreatePaymentTransaction", ctx, transaction)}
+}
+
+func (_c *PaymentTransactionRepository_CreatePaymentTransaction_Call) Run(run func(ctx context.Context, transaction *entities.PaymentTransaction)) *PaymentTransactionRepository_CreatePaymentTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.PaymentTransaction))
+	})
+	return _c
+}
+
+func (_c *PaymentTransactionRepository_CreatePaymentTransaction_Call) Return(_a0 error) *PaymentTransactionRepository_CreatePaymentTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentTransactionRepository_CreatePaymentTransaction_Call) RunAndReturn(run func(context.Context, *entities.PaymentTransaction) error) *PaymentTransactionRepository_CreatePaymentTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPaymentTransactionByReference provides a mock function with given fields: ctx, channel, reference
+func (_m *PaymentTransactionRepository) GetPaymentTransactionByReference(ctx context.Context, channel string, reference string) (*entities.PaymentTransaction, error) {
+	ret := _m.Called(ctx, channel, reference)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaymentTransactionByReference")
+	}
+
+	var r0 *entities.PaymentTransaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entities.PaymentTransaction, error)); ok {
+		return rf(ctx, channel, reference)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *entities.PaymentTransaction); ok {
+		r0 = rf(ctx, channel, reference)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.PaymentTransaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, channel, reference)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentTransactionRepository_GetPaymentTransactionByReference_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaymentTransactionByReference'
+type PaymentTransactionRepository_GetPaymentTransactionByReference_Call struct {
+	*mock.Call
+}
+
+// GetPaymentTransactionByReference is a helper method to define mock.On call
+//   - ctx context.Context
+//   - channel string
+//   - reference string
+func (_e *PaymentTransactionRepository_Expecter) GetPaymentTransactionByReference(ctx interface{}, channel interface{}, reference interface{}) *PaymentTransactionRepository_GetPaymentTransactionByReference_Call {
+	return &PaymentTransactionRepository_GetPaymentTransactionByReference_Call{Call: _e.mock.On("GetPaymentTransactionByReference", ctx, channel, reference)}
+}
+
+func (_c *PaymentTransactionRepository_GetPaymentTransactionByReference_Call) Run(run func(ctx context.Context, channel string, reference string)) *PaymentTransactionRepository_GetPaymentTransactionByReference_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *PaymentTransactionRepository_GetPaymentTransactionByReference_Call) Return(_a0 *entities.PaymentTransaction, _a1 error) *PaymentTransactionRepository_GetPaymentTransactionByReference_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentTransactionRepository_GetPaymentTransactionByReference_Call) RunAndReturn(run func(context.Context, string, string) (*entities.PaymentTransaction, error)) *PaymentTransactionRepository_GetPaymentTransactionByReference_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPaymentTransactions provides a mock function with given fields: ctx, filter, cursor, limit
+func (_m *PaymentTransactionRepository) GetPaymentTransactions(ctx context.Context, filter *entities.PaymentTransactionFilter, cursor *pagination.Cursor, limit int) ([]*entities.PaymentTransaction, error) {
+	ret := _m.Called(ctx, filter, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaymentTransactions")
+	}
+
+	var r0 []*entities.PaymentTransaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.PaymentTransactionFilter, *pagination.Cursor, int) ([]*entities.PaymentTransaction, error)); ok {
+		return rf(ctx, filter, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.PaymentTransactionFilter, *pagination.Cursor, int) []*entities.PaymentTransaction); ok {
+		r0 = rf(ctx, filter, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.PaymentTransaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entities.PaymentTransactionFilter, *pagination.Cursor, int) error); ok {
+		r1 = rf(ctx, filter, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentTransactionRepository_GetPaymentTransactions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaymentTransactions'
+type PaymentTransactionRepository_GetPaymentTransactions_Call struct {
+	*mock.Call
+}
+
+// GetPaymentTransactions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *entities.PaymentTransactionFilter
+//   - cursor *pagination.Cursor
+//   - limit int
+func (_e *PaymentTransactionRepository_Expecter) GetPaymentTransactions(ctx interface{}, filter interface{}, cursor interface{}, limit interface{}) *PaymentTransactionRepository_GetPaymentTransactions_Call {
+	return &PaymentTransactionRepository_GetPaymentTransactions_Call{Call: _e.mock.On("GetPaymentTransactions", ctx, filter, cursor, limit)}
+}
+
+func (_c *PaymentTransactionRepository_GetPaymentTransactions_Call) Run(run func(ctx context.Context, filter *entities.PaymentTransactionFilter, cursor *pagination.Cursor, limit int)) *PaymentTransactionRepository_GetPaymentTransactions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.PaymentTransactionFilter), args[2].(*pagination.Cursor), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *PaymentTransactionRepository_GetPaymentTransactions_Call) Return(_a0 []*entities.PaymentTransaction, _a1 error) *PaymentTransactionRepository_GetPaymentTransactions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentTransactionRepository_GetPaymentTransactions_Call) RunAndReturn(run func(context.Context, *entities.PaymentTransactionFilter, *pagination.Cursor, int) ([]*entities.PaymentTransaction, error)) *PaymentTransactionRepository_GetPaymentTransactions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReversePaymentTransactionsByPaymentIDs provides a mock function with given fields: ctx, paymentIDs, reversedAt
+func (_m *PaymentTransactionRepository) ReversePaymentTransactionsByPaymentIDs(ctx context.Context, paymentIDs []string, reversedAt *time.Time) error {
+	ret := _m.Called(ctx, paymentIDs, reversedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReversePaymentTransactionsByPaymentIDs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, *time.Time) error); ok {
+		r0 = rf(ctx, paymentIDs, reversedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PaymentTransactionRepository_ReversePaymentTransactionsByPaymentIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReversePaymentTransactionsByPaymentIDs'
+type PaymentTransactionRepository_ReversePaymentTransactionsByPaymentIDs_Call struct {
+	*mock.Call
+}
+
+// ReversePaymentTransactionsByPaymentIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - paymentIDs []string
+//   - reversedAt *time.Time
+func (_e *PaymentTransactionRepository_Expecter) ReversePaymentTransactionsByPaymentIDs(ctx interface{}, paymentIDs interface{}, reversedAt interface{}) *PaymentTransactionRepository_ReversePaymentTransactionsByPaymentIDs_Call {
+	return &PaymentTransactionRepository_ReversePaymentTransactionsByPaymentIDs_Call{Call: _e.mock.On("ReversePaymentTransactionsByPaymentIDs", ctx, paymentIDs, reversedAt)}
+}
+
+func (_c *PaymentTransactionRepository_ReversePaymentTransactionsByPaymentIDs_Call) Run(run func(ctx context.Context, paymentIDs []string, reversedAt *time.Time)) *PaymentTransactionRepository_ReversePaymentTransactionsByPaymentIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(*time.Time))
+	})
+	return _c
+}
+
+func (_c *PaymentTransactionRepository_ReversePaymentTransactionsByPaymentIDs_Call) Return(_a0 error) *PaymentTransactionRepository_ReversePaymentTransactionsByPaymentIDs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentTransactionRepository_ReversePaymentTransactionsByPaymentIDs_Call) RunAndReturn(run func(context.Context, []string, *time.Time) error) *PaymentTransactionRepository_ReversePaymentTransactionsByPaymentIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPaymentTransactionRepository creates a new instance of PaymentTransactionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPaymentTransactionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PaymentTransactionRepository {
+	mock := &PaymentTransactionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package repositories
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	entities "github.com/verizhang/billing-engine/src/entities"
+)
+
+// ProviderCallbackRepository is an autogenerated mock type for the ProviderCallbackRepository type
+type ProviderCallbackRepository struct {
+	mock.Mock
+}
+
+type ProviderCallbackRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ProviderCallbackRepository) EXPECT() *ProviderCallbackRepository_Expecter {
+	return &ProviderCallbackRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateProviderCallback provides a mock function with given fields: ctx, callback
+func (_m *ProviderCallbackRepository) CreateProviderCallback(ctx context.Context, callback *entities.ProviderCallback) error {
+	ret := _m.Called(ctx, callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateProviderCallback")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.ProviderCallback) error); ok {
+		r0 = rf(ctx, callback)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ProviderCallbackRepository_CreateProviderCallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateProviderCallback'
+type ProviderCallbackRepository_CreateProviderCallback_Call struct {
+	*mock.Call
+}
+
+// CreateProviderCallback is a helper method to define mock.On call
+//   - ctx context.Context
+//   - callback *entities.ProviderCallback
+func (_e *ProviderCallbackRepository_Expecter) CreateProviderCallback(ctx interface{}, callback interface{}) *ProviderCallbackRepository_CreateProviderCallback_Call {
+	return &ProviderCallbackRepository_CreateProviderCallback_Call{Call: _e.mock.On("CreateProviderCallback", ctx, callback)}
+}
+
+func (_c *ProviderCallbackRepository_CreateProviderCallback_Call) Run(run func(ctx context.Context, callback *entities.ProviderCallback)) *ProviderCallbackRepository_CreateProviderCallback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.ProviderCallback))
+	})
+	return _c
+}
+
+func (_c *ProviderCallbackRepository_CreateProviderCallback_Call) Return(_a0 error) *ProviderCallbackRepository_CreateProviderCallback_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ProviderCallbackRepository_CreateProviderCallback_Call) RunAndReturn(run func(context.Context, *entities.ProviderCallback) error) *ProviderCallbackRepository_CreateProviderCallback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewProviderCallbackRepository creates a new instance of ProviderCallbackRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProviderCallbackRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ProviderCallbackRepository {
+	mock := &ProviderCallbackRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
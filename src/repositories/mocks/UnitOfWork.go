@@ -25,6 +25,150 @@ func (_m *UnitOfWork) EXPECT() *UnitOfWork_Expecter {
 	return &UnitOfWork_Expecter{mock: &_m.Mock}
 }
 
+// AuditEventRepository provides a mock function with given fields: tx
+func (_m *UnitOfWork) AuditEventRepository(tx *gorm.DB) srcrepositories.AuditEventRepository {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuditEventRepository")
+	}
+
+	var r0 srcrepositories.AuditEventRepository
+	if rf, ok := ret.Get(0).(func(*gorm.DB) srcrepositories.AuditEventRepository); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(srcrepositories.AuditEventRepository)
+		}
+	}
+
+	return r0
+}
+
+// UnitOfWork_AuditEventRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuditEventRepository'
+type UnitOfWork_AuditEventRepository_Call struct {
+	*mock.Call
+}
+
+// AuditEventRepository is a helper method to define mock.On call
+//   - tx *gorm.DB
+func (_e *UnitOfWork_Expecter) AuditEventRepository(tx interface{}) *UnitOfWork_AuditEventRepository_Call {
+	return &UnitOfWork_AuditEventRepository_Call{Call: _e.mock.On("AuditEventRepository", tx)}
+}
+
+func (_c *UnitOfWork_AuditEventRepository_Call) Run(run func(tx *gorm.DB)) *UnitOfWork_AuditEventRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gorm.DB))
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_AuditEventRepository_Call) Return(_a0 srcrepositories.AuditEventRepository) *UnitOfWork_AuditEventRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UnitOfWork_AuditEventRepository_Call) RunAndReturn(run func(*gorm.DB) srcrepositories.AuditEventRepository) *UnitOfWork_AuditEventRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BankStatementImportRepository provides a mock function with given fields: tx
+func (_m *UnitOfWork) BankStatementImportRepository(tx *gorm.DB) srcrepositories.BankStatementImportRepository {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BankStatementImportRepository")
+	}
+
+	var r0 srcrepositories.BankStatementImportRepository
+	if rf, ok := ret.Get(0).(func(*gorm.DB) srcrepositories.BankStatementImportRepository); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(srcrepositories.BankStatementImportRepository)
+		}
+	}
+
+	return r0
+}
+
+// UnitOfWork_BankStatementImportRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BankStatementImportRepository'
+type UnitOfWork_BankStatementImportRepository_Call struct {
+	*mock.Call
+}
+
+// BankStatementImportRepository is a helper method to define mock.On call
+//   - tx *gorm.DB
+func (_e *UnitOfWork_Expecter) BankStatementImportRepository(tx interface{}) *UnitOfWork_BankStatementImportRepository_Call {
+	return &UnitOfWork_BankStatementImportRepository_Call{Call: _e.mock.On("BankStatementImportRepository", tx)}
+}
+
+func (_c *UnitOfWork_BankStatementImportRepository_Call) Run(run func(tx *gorm.DB)) *UnitOfWork_BankStatementImportRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gorm.DB))
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_BankStatementImportRepository_Call) Return(_a0 srcrepositories.BankStatementImportRepository) *UnitOfWork_BankStatementImportRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UnitOfWork_BankStatementImportRepository_Call) RunAndReturn(run func(*gorm.DB) srcrepositories.BankStatementImportRepository) *UnitOfWork_BankStatementImportRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BankStatementLineRepository provides a mock function with given fields: tx
+func (_m *UnitOfWork) BankStatementLineRepository(tx *gorm.DB) srcrepositories.BankStatementLineRepository {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BankStatementLineRepository")
+	}
+
+	var r0 srcrepositories.BankStatementLineRepository
+	if rf, ok := ret.Get(0).(func(*gorm.DB) srcrepositories.BankStatementLineRepository); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(srcrepositories.BankStatementLineRepository)
+		}
+	}
+
+	return r0
+}
+
+// UnitOfWork_BankStatementLineRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BankStatementLineRepository'
+type UnitOfWork_BankStatementLineRepository_Call struct {
+	*mock.Call
+}
+
+// BankStatementLineRepository is a helper method to define mock.On call
+//   - tx *gorm.DB
+func (_e *UnitOfWork_Expecter) BankStatementLineRepository(tx interface{}) *UnitOfWork_BankStatementLineRepository_Call {
+	return &UnitOfWork_BankStatementLineRepository_Call{Call: _e.mock.On("BankStatementLineRepository", tx)}
+}
+
+func (_c *UnitOfWork_BankStatementLineRepository_Call) Run(run func(tx *gorm.DB)) *UnitOfWork_BankStatementLineRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gorm.DB))
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_BankStatementLineRepository_Call) Return(_a0 srcrepositories.BankStatementLineRepository) *UnitOfWork_BankStatementLineRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UnitOfWork_BankStatementLineRepository_Call) RunAndReturn(run func(*gorm.DB) srcrepositories.BankStatementLineRepository) *UnitOfWork_BankStatementLineRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Begin provides a mock function with given fields: ctx
 func (_m *UnitOfWork) Begin(ctx context.Context) (*gorm.DB, error) {
 	ret := _m.Called(ctx)
@@ -129,6 +273,54 @@ func (_c *UnitOfWork_Commit_Call) RunAndReturn(run func(*gorm.DB) error) *UnitOf
 	return _c
 }
 
+// LoanRecoveryRepository provides a mock function with given fields: tx
+func (_m *UnitOfWork) LoanRecoveryRepository(tx *gorm.DB) srcrepositories.LoanRecoveryRepository {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoanRecoveryRepository")
+	}
+
+	var r0 srcrepositories.LoanRecoveryRepository
+	if rf, ok := ret.Get(0).(func(*gorm.DB) srcrepositories.LoanRecoveryRepository); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(srcrepositories.LoanRecoveryRepository)
+		}
+	}
+
+	return r0
+}
+
+// UnitOfWork_LoanRecoveryRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoanRecoveryRepository'
+type UnitOfWork_LoanRecoveryRepository_Call struct {
+	*mock.Call
+}
+
+// LoanRecoveryRepository is a helper method to define mock.On call
+//   - tx *gorm.DB
+func (_e *UnitOfWork_Expecter) LoanRecoveryRepository(tx interface{}) *UnitOfWork_LoanRecoveryRepository_Call {
+	return &UnitOfWork_LoanRecoveryRepository_Call{Call: _e.mock.On("LoanRecoveryRepository", tx)}
+}
+
+func (_c *UnitOfWork_LoanRecoveryRepository_Call) Run(run func(tx *gorm.DB)) *UnitOfWork_LoanRecoveryRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gorm.DB))
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_LoanRecoveryRepository_Call) Return(_a0 srcrepositories.LoanRecoveryRepository) *UnitOfWork_LoanRecoveryRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UnitOfWork_LoanRecoveryRepository_Call) RunAndReturn(run func(*gorm.DB) srcrepositories.LoanRecoveryRepository) *UnitOfWork_LoanRecoveryRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LoanRepository provides a mock function with given fields: tx
 func (_m *UnitOfWork) LoanRepository(tx *gorm.DB) srcrepositories.LoanRepository {
 	ret := _m.Called(tx)
@@ -177,6 +369,198 @@ func (_c *UnitOfWork_LoanRepository_Call) RunAndReturn(run func(*gorm.DB) srcrep
 	return _c
 }
 
+// LoanWriteOffRepository provides a mock function with given fields: tx
+func (_m *UnitOfWork) LoanWriteOffRepository(tx *gorm.DB) srcrepositories.LoanWriteOffRepository {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoanWriteOffRepository")
+	}
+
+	var r0 srcrepositories.LoanWriteOffRepository
+	if rf, ok := ret.Get(0).(func(*gorm.DB) srcrepositories.LoanWriteOffRepository); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(srcrepositories.LoanWriteOffRepository)
+		}
+	}
+
+	return r0
+}
+
+// UnitOfWork_LoanWriteOffRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoanWriteOffRepository'
+type UnitOfWork_LoanWriteOffRepository_Call struct {
+	*mock.Call
+}
+
+// LoanWriteOffRepository is a helper method to define mock.On call
+//   - tx *gorm.DB
+func (_e *UnitOfWork_Expecter) LoanWriteOffRepository(tx interface{}) *UnitOfWork_LoanWriteOffRepository_Call {
+	return &UnitOfWork_LoanWriteOffRepository_Call{Call: _e.mock.On("LoanWriteOffRepository", tx)}
+}
+
+func (_c *UnitOfWork_LoanWriteOffRepository_Call) Run(run func(tx *gorm.DB)) *UnitOfWork_LoanWriteOffRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gorm.DB))
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_LoanWriteOffRepository_Call) Return(_a0 srcrepositories.LoanWriteOffRepository) *UnitOfWork_LoanWriteOffRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UnitOfWork_LoanWriteOffRepository_Call) RunAndReturn(run func(*gorm.DB) srcrepositories.LoanWriteOffRepository) *UnitOfWork_LoanWriteOffRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NotificationPreferenceRepository provides a mock function with given fields: tx
+func (_m *UnitOfWork) NotificationPreferenceRepository(tx *gorm.DB) srcrepositories.NotificationPreferenceRepository {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotificationPreferenceRepository")
+	}
+
+	var r0 srcrepositories.NotificationPreferenceRepository
+	if rf, ok := ret.Get(0).(func(*gorm.DB) srcrepositories.NotificationPreferenceRepository); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(srcrepositories.NotificationPreferenceRepository)
+		}
+	}
+
+	return r0
+}
+
+// UnitOfWork_NotificationPreferenceRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NotificationPreferenceRepository'
+type UnitOfWork_NotificationPreferenceRepository_Call struct {
+	*mock.Call
+}
+
+// NotificationPreferenceRepository is a helper method to define mock.On call
+//   - tx *gorm.DB
+func (_e *UnitOfWork_Expecter) NotificationPreferenceRepository(tx interface{}) *UnitOfWork_NotificationPreferenceRepository_Call {
+	return &UnitOfWork_NotificationPreferenceRepository_Call{Call: _e.mock.On("NotificationPreferenceRepository", tx)}
+}
+
+func (_c *UnitOfWork_NotificationPreferenceRepository_Call) Run(run func(tx *gorm.DB)) *UnitOfWork_NotificationPreferenceRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gorm.DB))
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_NotificationPreferenceRepository_Call) Return(_a0 srcrepositories.NotificationPreferenceRepository) *UnitOfWork_NotificationPreferenceRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UnitOfWork_NotificationPreferenceRepository_Call) RunAndReturn(run func(*gorm.DB) srcrepositories.NotificationPreferenceRepository) *UnitOfWork_NotificationPreferenceRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OutboxEventRepository provides a mock function with given fields: tx
+func (_m *UnitOfWork) OutboxEventRepository(tx *gorm.DB) srcrepositories.OutboxEventRepository {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OutboxEventRepository")
+	}
+
+	var r0 srcrepositories.OutboxEventRepository
+	if rf, ok := ret.Get(0).(func(*gorm.DB) srcrepositories.OutboxEventRepository); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(srcrepositories.OutboxEventRepository)
+		}
+	}
+
+	return r0
+}
+
+// UnitOfWork_OutboxEventRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OutboxEventRepository'
+type UnitOfWork_OutboxEventRepository_Call struct {
+	*mock.Call
+}
+
+// OutboxEventRepository is a helper method to define mock.On call
+//   - tx *gorm.DB
+func (_e *UnitOfWork_Expecter) OutboxEventRepository(tx interface{}) *UnitOfWork_OutboxEventRepository_Call {
+	return &UnitOfWork_OutboxEventRepository_Call{Call: _e.mock.On("OutboxEventRepository", tx)}
+}
+
+func (_c *UnitOfWork_OutboxEventRepository_Call) Run(run func(tx *gorm.DB)) *UnitOfWork_OutboxEventRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gorm.DB))
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_OutboxEventRepository_Call) Return(_a0 srcrepositories.OutboxEventRepository) *UnitOfWork_OutboxEventRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UnitOfWork_OutboxEventRepository_Call) RunAndReturn(run func(*gorm.DB) srcrepositories.OutboxEventRepository) *UnitOfWork_OutboxEventRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PaymentDeferralRepository provides a mock function with given fields: tx
+func (_m *UnitOfWork) PaymentDeferralRepository(tx *gorm.DB) srcrepositories.PaymentDeferralRepository {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PaymentDeferralRepository")
+	}
+
+	var r0 srcrepositories.PaymentDeferralRepository
+	if rf, ok := ret.Get(0).(func(*gorm.DB) srcrepositories.PaymentDeferralRepository); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(srcrepositories.PaymentDeferralRepository)
+		}
+	}
+
+	return r0
+}
+
+// UnitOfWork_PaymentDeferralRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PaymentDeferralRepository'
+type UnitOfWork_PaymentDeferralRepository_Call struct {
+	*mock.Call
+}
+
+// PaymentDeferralRepository is a helper method to define mock.On call
+//   - tx *gorm.DB
+func (_e *UnitOfWork_Expecter) PaymentDeferralRepository(tx interface{}) *UnitOfWork_PaymentDeferralRepository_Call {
+	return &UnitOfWork_PaymentDeferralRepository_Call{Call: _e.mock.On("PaymentDeferralRepository", tx)}
+}
+
+func (_c *UnitOfWork_PaymentDeferralRepository_Call) Run(run func(tx *gorm.DB)) *UnitOfWork_PaymentDeferralRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gorm.DB))
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_PaymentDeferralRepository_Call) Return(_a0 srcrepositories.PaymentDeferralRepository) *UnitOfWork_PaymentDeferralRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UnitOfWork_PaymentDeferralRepository_Call) RunAndReturn(run func(*gorm.DB) srcrepositories.PaymentDeferralRepository) *UnitOfWork_PaymentDeferralRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PaymentRepository provides a mock function with given fields: tx
 func (_m *UnitOfWork) PaymentRepository(tx *gorm.DB) srcrepositories.PaymentRepository {
 	ret := _m.Called(tx)
@@ -225,6 +609,102 @@ func (_c *UnitOfWork_PaymentRepository_Call) RunAndReturn(run func(*gorm.DB) src
 	return _c
 }
 
+// PaymentScheduleRepository provides a mock function with given fields: tx
+func (_m *UnitOfWork) PaymentScheduleRepository(tx *gorm.DB) srcrepositories.PaymentScheduleRepository {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PaymentScheduleRepository")
+	}
+
+	var r0 srcrepositories.PaymentScheduleRepository
+	if rf, ok := ret.Get(0).(func(*gorm.DB) srcrepositories.PaymentScheduleRepository); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(srcrepositories.PaymentScheduleRepository)
+		}
+	}
+
+	return r0
+}
+
+// UnitOfWork_PaymentScheduleRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PaymentScheduleRepository'
+type UnitOfWork_PaymentScheduleRepository_Call struct {
+	*mock.Call
+}
+
+// PaymentScheduleRepository is a helper method to define mock.On call
+//   - tx *gorm.DB
+func (_e *UnitOfWork_Expecter) PaymentScheduleRepository(tx interface{}) *UnitOfWork_PaymentScheduleRepository_Call {
+	return &UnitOfWork_PaymentScheduleRepository_Call{Call: _e.mock.On("PaymentScheduleRepository", tx)}
+}
+
+func (_c *UnitOfWork_PaymentScheduleRepository_Call) Run(run func(tx *gorm.DB)) *UnitOfWork_PaymentScheduleRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gorm.DB))
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_PaymentScheduleRepository_Call) Return(_a0 srcrepositories.PaymentScheduleRepository) *UnitOfWork_PaymentScheduleRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UnitOfWork_PaymentScheduleRepository_Call) RunAndReturn(run func(*gorm.DB) srcrepositories.PaymentScheduleRepository) *UnitOfWork_PaymentScheduleRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PaymentTransactionRepository provides a mock function with given fields: tx
+func (_m *UnitOfWork) PaymentTransactionRepository(tx *gorm.DB) srcrepositories.PaymentTransactionRepository {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PaymentTransactionRepository")
+	}
+
+	var r0 srcrepositories.PaymentTransactionRepository
+	if rf, ok := ret.Get(0).(func(*gorm.DB) srcrepositories.PaymentTransactionRepository); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(srcrepositories.PaymentTransactionRepository)
+		}
+	}
+
+	return r0
+}
+
+// UnitOfWork_PaymentTransactionRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PaymentTransactionRepository'
+type UnitOfWork_PaymentTransactionRepository_Call struct {
+	*mock.Call
+}
+
+// PaymentTransactionRepository is a helper method to define mock.On call
+//   - tx *gorm.DB
+func (_e *UnitOfWork_Expecter) PaymentTransactionRepository(tx interface{}) *UnitOfWork_PaymentTransactionRepository_Call {
+	return &UnitOfWork_PaymentTransactionRepository_Call{Call: _e.mock.On("PaymentTransactionRepository", tx)}
+}
+
+func (_c *UnitOfWork_PaymentTransactionRepository_Call) Run(run func(tx *gorm.DB)) *UnitOfWork_PaymentTransactionRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gorm.DB))
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_PaymentTransactionRepository_Call) Return(_a0 srcrepositories.PaymentTransactionRepository) *UnitOfWork_PaymentTransactionRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UnitOfWork_PaymentTransactionRepository_Call) RunAndReturn(run func(*gorm.DB) srcrepositories.PaymentTransactionRepository) *UnitOfWork_PaymentTransactionRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Rollback provides a mock function with given fields: tx
 func (_m *UnitOfWork) Rollback(tx *gorm.DB) error {
 	ret := _m.Called(tx)
@@ -271,6 +751,102 @@ func (_c *UnitOfWork_Rollback_Call) RunAndReturn(run func(*gorm.DB) error) *Unit
 	return _c
 }
 
+// WebhookDeliveryRepository provides a mock function with given fields: tx
+func (_m *UnitOfWork) WebhookDeliveryRepository(tx *gorm.DB) srcrepositories.WebhookDeliveryRepository {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WebhookDeliveryRepository")
+	}
+
+	var r0 srcrepositories.WebhookDeliveryRepository
+	if rf, ok := ret.Get(0).(func(*gorm.DB) srcrepositories.WebhookDeliveryRepository); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(srcrepositories.WebhookDeliveryRepository)
+		}
+	}
+
+	return r0
+}
+
+// UnitOfWork_WebhookDeliveryRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookDeliveryRepository'
+type UnitOfWork_WebhookDeliveryRepository_Call struct {
+	*mock.Call
+}
+
+// WebhookDeliveryRepository is a helper method to define mock.On call
+//   - tx *gorm.DB
+func (_e *UnitOfWork_Expecter) WebhookDeliveryRepository(tx interface{}) *UnitOfWork_WebhookDeliveryRepository_Call {
+	return &UnitOfWork_WebhookDeliveryRepository_Call{Call: _e.mock.On("WebhookDeliveryRepository", tx)}
+}
+
+func (_c *UnitOfWork_WebhookDeliveryRepository_Call) Run(run func(tx *gorm.DB)) *UnitOfWork_WebhookDeliveryRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gorm.DB))
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_WebhookDeliveryRepository_Call) Return(_a0 srcrepositories.WebhookDeliveryRepository) *UnitOfWork_WebhookDeliveryRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UnitOfWork_WebhookDeliveryRepository_Call) RunAndReturn(run func(*gorm.DB) srcrepositories.WebhookDeliveryRepository) *UnitOfWork_WebhookDeliveryRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WebhookSubscriptionRepository provides a mock function with given fields: tx
+func (_m *UnitOfWork) WebhookSubscriptionRepository(tx *gorm.DB) srcrepositories.WebhookSubscriptionRepository {
+	ret := _m.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WebhookSubscriptionRepository")
+	}
+
+	var r0 srcrepositories.WebhookSubscriptionRepository
+	if rf, ok := ret.Get(0).(func(*gorm.DB) srcrepositories.WebhookSubscriptionRepository); ok {
+		r0 = rf(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(srcrepositories.WebhookSubscriptionRepository)
+		}
+	}
+
+	return r0
+}
+
+// UnitOfWork_WebhookSubscriptionRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookSubscriptionRepository'
+type UnitOfWork_WebhookSubscriptionRepository_Call struct {
+	*mock.Call
+}
+
+// WebhookSubscriptionRepository is a helper method to define mock.On call
+//   - tx *gorm.DB
+func (_e *UnitOfWork_Expecter) WebhookSubscriptionRepository(tx interface{}) *UnitOfWork_WebhookSubscriptionRepository_Call {
+	return &UnitOfWork_WebhookSubscriptionRepository_Call{Call: _e.mock.On("WebhookSubscriptionRepository", tx)}
+}
+
+func (_c *UnitOfWork_WebhookSubscriptionRepository_Call) Run(run func(tx *gorm.DB)) *UnitOfWork_WebhookSubscriptionRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*gorm.DB))
+	})
+	return _c
+}
+
+func (_c *UnitOfWork_WebhookSubscriptionRepository_Call) Return(_a0 srcrepositories.WebhookSubscriptionRepository) *UnitOfWork_WebhookSubscriptionRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UnitOfWork_WebhookSubscriptionRepository_Call) RunAndReturn(run func(*gorm.DB) srcrepositories.WebhookSubscriptionRepository) *UnitOfWork_WebhookSubscriptionRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewUnitOfWork creates a new instance of UnitOfWork. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUnitOfWork(t interface {
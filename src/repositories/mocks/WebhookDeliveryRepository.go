@@ -0,0 +1,372 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package repositories
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	entities "github.com/verizhang/billing-engine/src/entities"
+
+	pagination "github.com/verizhang/billing-engine/src/utils/pagination"
+
+	time "time"
+)
+
+// WebhookDeliveryRepository is an autogenerated mock type for the WebhookDeliveryRepository type
+type WebhookDeliveryRepository struct {
+	mock.Mock
+}
+
+type WebhookDeliveryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookDeliveryRepository) EXPECT() *WebhookDeliveryRepository_Expecter {
+	return &WebhookDeliveryRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimDueWebhookDeliveries provides a mock function with given fields: ctx, now, leasedUntil, limit
+func (_m *WebhookDeliveryRepository) ClaimDueWebhookDeliveries(ctx context.Context, now time.Time, leasedUntil time.Time, limit int) ([]*entities.WebhookDelivery, error) {
+	ret := _m.Called(ctx, now, leasedUntil, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDueWebhookDeliveries")
+	}
+
+	var r0 []*entities.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) ([]*entities.WebhookDelivery, error)); ok {
+		return rf(ctx, now, leasedUntil, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) []*entities.WebhookDelivery); ok {
+		r0 = rf(ctx, now, leasedUntil, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, int) error); ok {
+		r1 = rf(ctx, now, leasedUntil, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookDeliveryRepository_ClaimDueWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDueWebhookDeliveries'
+type WebhookDeliveryRepository_ClaimDueWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// ClaimDueWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - leasedUntil time.Time
+//   - limit int
+func (_e *WebhookDeliveryRepository_Expecter) ClaimDueWebhookDeliveries(ctx interface{}, now interface{}, leasedUntil interface{}, limit interface{}) *WebhookDeliveryRepository_ClaimDueWebhookDeliveries_Call {
+	return &WebhookDeliveryRepository_ClaimDueWebhookDeliveries_Call{Call: _e.mock.On("ClaimDueWebhookDeliveries", ctx, now, leasedUntil, limit)}
+}
+
+func (_c *WebhookDeliveryRepository_ClaimDueWebhookDeliveries_Call) Run(run func(ctx context.Context, now time.Time, leasedUntil time.Time, limit int)) *WebhookDeliveryRepository_ClaimDueWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *WebhookDeliveryRepository_ClaimDueWebhookDeliveries_Call) Return(_a0 []*entities.WebhookDelivery, _a1 error) *WebhookDeliveryRepository_ClaimDueWebhookDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookDeliveryRepository_ClaimDueWebhookDeliveries_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, int) ([]*entities.WebhookDelivery, error)) *WebhookDeliveryRepository_ClaimDueWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWebhookDeliveries provides a mock function with given fields: ctx, deliveries
+func (_m *WebhookDeliveryRepository) CreateWebhookDeliveries(ctx context.Context, deliveries []*entities.WebhookDelivery) error {
+	ret := _m.Called(ctx, deliveries)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhookDeliveries")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*entities.WebhookDelivery) error); ok {
+		r0 = rf(ctx, deliveries)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookDeliveryRepository_CreateWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhookDeliveries'
+type WebhookDeliveryRepository_CreateWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// CreateWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deliveries []*entities.WebhookDelivery
+func (_e *WebhookDeliveryRepository_Expecter) CreateWebhookDeliveries(ctx interface{}, deliveries interface{}) *WebhookDeliveryRepository_CreateWebhookDeliveries_Call {
+	return &WebhookDeliveryRepository_CreateWebhookDeliveries_Call{Call: _e.mock.On("CreateWebhookDeliveries", ctx, deliveries)}
+}
+
+func (_c *WebhookDeliveryRepository_CreateWebhookDeliveries_Call) Run(run func(ctx context.Context, deliveries []*entities.WebhookDelivery)) *WebhookDeliveryRepository_CreateWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*entities.WebhookDelivery))
+	})
+	return _c
+}
+
+func (_c *WebhookDeliveryRepository_CreateWebhookDeliveries_Call) Return(_a0 error) *WebhookDeliveryRepository_CreateWebhookDeliveries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookDeliveryRepository_CreateWebhookDeliveries_Call) RunAndReturn(run func(context.Context, []*entities.WebhookDelivery) error) *WebhookDeliveryRepository_CreateWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhookDeliveries provides a mock function with given fields: ctx, filter, cursor, limit
+func (_m *WebhookDeliveryRepository) GetWebhookDeliveries(ctx context.Context, filter *entities.WebhookDeliveryFilter, cursor *pagination.Cursor, limit int) ([]*entities.WebhookDelivery, error) {
+	ret := _m.Called(ctx, filter, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookDeliveries")
+	}
+
+	var r0 []*entities.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.WebhookDeliveryFilter, *pagination.Cursor, int) ([]*entities.WebhookDelivery, error)); ok {
+		return rf(ctx, filter, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.WebhookDeliveryFilter, *pagination.Cursor, int) []*entities.WebhookDelivery); ok {
+		r0 = rf(ctx, filter, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entities.WebhookDeliveryFilter, *pagination.Cursor, int) error); ok {
+		r1 = rf(ctx, filter, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookDeliveryRepository_GetWebhookDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookDeliveries'
+type WebhookDeliveryRepository_GetWebhookDeliveries_Call struct {
+	*mock.Call
+}
+
+// GetWebhookDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *entities.WebhookDeliveryFilter
+//   - cursor *pagination.Cursor
+//   - limit int
+func (_e *WebhookDeliveryRepository_Expecter) GetWebhookDeliveries(ctx interface{}, filter interface{}, cursor interface{}, limit interface{}) *WebhookDeliveryRepository_GetWebhookDeliveries_Call {
+	return &WebhookDeliveryRepository_GetWebhookDeliveries_Call{Call: _e.mock.On("GetWebhookDeliveries", ctx, filter, cursor, limit)}
+}
+
+func (_c *WebhookDeliveryRepository_GetWebhookDeliveries_Call) Run(run func(ctx context.Context, filter *entities.WebhookDeliveryFilter, cursor *pagination.Cursor, limit int)) *WebhookDeliveryRepository_GetWebhookDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.WebhookDeliveryFilter), args[2].(*pagination.Cursor), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *WebhookDeliveryRepository_GetWebhookDeliveries_Call) Return(_a0 []*entities.WebhookDelivery, _a1 error) *WebhookDeliveryRepository_GetWebhookDeliveries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookDeliveryRepository_GetWebhookDeliveries_Call) RunAndReturn(run func(context.Context, *entities.WebhookDeliveryFilter, *pagination.Cursor, int) ([]*entities.WebhookDelivery, error)) *WebhookDeliveryRepository_GetWebhookDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhookDeliveryByID provides a mock function with given fields: ctx, ID
+func (_m *WebhookDeliveryRepository) GetWebhookDeliveryByID(ctx context.Context, ID string) (*entities.WebhookDelivery, error) {
+	ret := _m.Called(ctx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookDeliveryByID")
+	}
+
+	var r0 *entities.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.WebhookDelivery, error)); ok {
+		return rf(ctx, ID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.WebhookDelivery); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookDeliveryRepository_GetWebhookDeliveryByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookDeliveryByID'
+type WebhookDeliveryRepository_GetWebhookDeliveryByID_Call struct {
+	*mock.Call
+}
+
+// GetWebhookDeliveryByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+func (_e *WebhookDeliveryRepository_Expecter) GetWebhookDeliveryByID(ctx interface{}, ID interface{}) *WebhookDeliveryRepository_GetWebhookDeliveryByID_Call {
+	return &WebhookDeliveryRepository_GetWebhookDeliveryByID_Call{Call: _e.mock.On("GetWebhookDeliveryByID", ctx, ID)}
+}
+
+func (_c *WebhookDeliveryRepository_GetWebhookDeliveryByID_Call) Run(run func(ctx context.Context, ID string)) *WebhookDeliveryRepository_GetWebhookDeliveryByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *WebhookDeliveryRepository_GetWebhookDeliveryByID_Call) Return(_a0 *entities.WebhookDelivery, _a1 error) *WebhookDeliveryRepository_GetWebhookDeliveryByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookDeliveryRepository_GetWebhookDeliveryByID_Call) RunAndReturn(run func(context.Context, string) (*entities.WebhookDelivery, error)) *WebhookDeliveryRepository_GetWebhookDeliveryByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TryLockDispatcher provides a mock function with given fields: ctx
+func (_m *WebhookDeliveryRepository) TryLockDispatcher(ctx context.Context) (bool, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TryLockDispatcher")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (bool, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) bool); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookDeliveryRepository_TryLockDispatcher_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TryLockDispatcher'
+type WebhookDeliveryRepository_TryLockDispatcher_Call struct {
+	*mock.Call
+}
+
+// TryLockDispatcher is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *WebhookDeliveryRepository_Expecter) TryLockDispatcher(ctx interface{}) *WebhookDeliveryRepository_TryLockDispatcher_Call {
+	return &WebhookDeliveryRepository_TryLockDispatcher_Call{Call: _e.mock.On("TryLockDispatcher", ctx)}
+}
+
+func (_c *WebhookDeliveryRepository_TryLockDispatcher_Call) Run(run func(ctx context.Context)) *WebhookDeliveryRepository_TryLockDispatcher_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookDeliveryRepository_TryLockDispatcher_Call) Return(_a0 bool, _a1 error) *WebhookDeliveryRepository_TryLockDispatcher_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookDeliveryRepository_TryLockDispatcher_Call) RunAndReturn(run func(context.Context) (bool, error)) *WebhookDeliveryRepository_TryLockDispatcher_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWebhookDelivery provides a mock function with given fields: ctx, delivery
+func (_m *WebhookDeliveryRepository) UpdateWebhookDelivery(ctx context.Context, delivery *entities.WebhookDelivery) error {
+	ret := _m.Called(ctx, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWebhookDelivery")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.WebhookDelivery) error); ok {
+		r0 = rf(ctx, delivery)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookDeliveryRepository_UpdateWebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebhookDelivery'
+type WebhookDeliveryRepository_UpdateWebhookDelivery_Call struct {
+	*mock.Call
+}
+
+// UpdateWebhookDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delivery *entities.WebhookDelivery
+func (_e *WebhookDeliveryRepository_Expecter) UpdateWebhookDelivery(ctx interface{}, delivery interface{}) *WebhookDeliveryRepository_UpdateWebhookDelivery_Call {
+	return &WebhookDeliveryRepository_UpdateWebhookDelivery_Call{Call: _e.mock.On("UpdateWebhookDelivery", ctx, delivery)}
+}
+
+func (_c *WebhookDeliveryRepository_UpdateWebhookDelivery_Call) Run(run func(ctx context.Context, delivery *entities.WebhookDelivery)) *WebhookDeliveryRepository_UpdateWebhookDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.WebhookDelivery))
+	})
+	return _c
+}
+
+func (_c *WebhookDeliveryRepository_UpdateWebhookDelivery_Call) Return(_a0 error) *WebhookDeliveryRepository_UpdateWebhookDelivery_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookDeliveryRepository_UpdateWebhookDelivery_Call) RunAndReturn(run func(context.Context, *entities.WebhookDelivery) error) *WebhookDeliveryRepository_UpdateWebhookDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookDeliveryRepository creates a new instance of WebhookDeliveryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookDeliveryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookDeliveryRepository {
+	mock := &WebhookDeliveryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,357 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package repositories
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	entities "github.com/verizhang/billing-engine/src/entities"
+
+	time "time"
+)
+
+// WebhookSubscriptionRepository is an autogenerated mock type for the WebhookSubscriptionRepository type
+type WebhookSubscriptionRepository struct {
+	mock.Mock
+}
+
+type WebhookSubscriptionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebhookSubscriptionRepository) EXPECT() *WebhookSubscriptionRepository_Expecter {
+	return &WebhookSubscriptionRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateWebhookSubscription provides a mock function with given fields: ctx, subscription
+func (_m *WebhookSubscriptionRepository) CreateWebhookSubscription(ctx context.Context, subscription *entities.WebhookSubscription) error {
+	ret := _m.Called(ctx, subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhookSubscription")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.WebhookSubscription) error); ok {
+		r0 = rf(ctx, subscription)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookSubscriptionRepository_CreateWebhookSubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhookSubscription'
+type WebhookSubscriptionRepository_CreateWebhookSubscription_Call struct {
+	*mock.Call
+}
+
+// CreateWebhookSubscription is a helper method to define mock.On call
+//   - ctx context.Context
+//   - subscription *entities.WebhookSubscription
+func (_e *WebhookSubscriptionRepository_Expecter) CreateWebhookSubscription(ctx interface{}, subscription interface{}) *WebhookSubscriptionRepository_CreateWebhookSubscription_Call {
+	return &WebhookSubscriptionRepository_CreateWebhookSubscription_Call{Call: _e.mock.On("CreateWebhookSubscription", ctx, subscription)}
+}
+
+func (_c *WebhookSubscriptionRepository_CreateWebhookSubscription_Call) Run(run func(ctx context.Context, subscription *entities.WebhookSubscription)) *WebhookSubscriptionRepository_CreateWebhookSubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.WebhookSubscription))
+	})
+	return _c
+}
+
+func (_c *WebhookSubscriptionRepository_CreateWebhookSubscription_Call) Return(_a0 error) *WebhookSubscriptionRepository_CreateWebhookSubscription_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookSubscriptionRepository_CreateWebhookSubscription_Call) RunAndReturn(run func(context.Context, *entities.WebhookSubscription) error) *WebhookSubscriptionRepository_CreateWebhookSubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhookSubscriptionByID provides a mock function with given fields: ctx, ID, deletedAt
+func (_m *WebhookSubscriptionRepository) DeleteWebhookSubscriptionByID(ctx context.Context, ID string, deletedAt time.Time) error {
+	ret := _m.Called(ctx, ID, deletedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhookSubscriptionByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, ID, deletedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookSubscriptionRepository_DeleteWebhookSubscriptionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhookSubscriptionByID'
+type WebhookSubscriptionRepository_DeleteWebhookSubscriptionByID_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhookSubscriptionByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+//   - deletedAt time.Time
+func (_e *WebhookSubscriptionRepository_Expecter) DeleteWebhookSubscriptionByID(ctx interface{}, ID interface{}, deletedAt interface{}) *WebhookSubscriptionRepository_DeleteWebhookSubscriptionByID_Call {
+	return &WebhookSubscriptionRepository_DeleteWebhookSubscriptionByID_Call{Call: _e.mock.On("DeleteWebhookSubscriptionByID", ctx, ID, deletedAt)}
+}
+
+func (_c *WebhookSubscriptionRepository_DeleteWebhookSubscriptionByID_Call) Run(run func(ctx context.Context, ID string, deletedAt time.Time)) *WebhookSubscriptionRepository_DeleteWebhookSubscriptionByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *WebhookSubscriptionRepository_DeleteWebhookSubscriptionByID_Call) Return(_a0 error) *WebhookSubscriptionRepository_DeleteWebhookSubscriptionByID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookSubscriptionRepository_DeleteWebhookSubscriptionByID_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *WebhookSubscriptionRepository_DeleteWebhookSubscriptionByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveWebhookSubscriptions provides a mock function with given fields: ctx
+func (_m *WebhookSubscriptionRepository) GetActiveWebhookSubscriptions(ctx context.Context) ([]*entities.WebhookSubscription, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveWebhookSubscriptions")
+	}
+
+	var r0 []*entities.WebhookSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*entities.WebhookSubscription, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*entities.WebhookSubscription); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.WebhookSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookSubscriptionRepository_GetActiveWebhookSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveWebhookSubscriptions'
+type WebhookSubscriptionRepository_GetActiveWebhookSubscriptions_Call struct {
+	*mock.Call
+}
+
+// GetActiveWebhookSubscriptions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *WebhookSubscriptionRepository_Expecter) GetActiveWebhookSubscriptions(ctx interface{}) *WebhookSubscriptionRepository_GetActiveWebhookSubscriptions_Call {
+	return &WebhookSubscriptionRepository_GetActiveWebhookSubscriptions_Call{Call: _e.mock.On("GetActiveWebhookSubscriptions", ctx)}
+}
+
+func (_c *WebhookSubscriptionRepository_GetActiveWebhookSubscriptions_Call) Run(run func(ctx context.Context)) *WebhookSubscriptionRepository_GetActiveWebhookSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookSubscriptionRepository_GetActiveWebhookSubscriptions_Call) Return(_a0 []*entities.WebhookSubscription, _a1 error) *WebhookSubscriptionRepository_GetActiveWebhookSubscriptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookSubscriptionRepository_GetActiveWebhookSubscriptions_Call) RunAndReturn(run func(context.Context) ([]*entities.WebhookSubscription, error)) *WebhookSubscriptionRepository_GetActiveWebhookSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhookSubscriptionByID provides a mock function with given fields: ctx, ID
+func (_m *WebhookSubscriptionRepository) GetWebhookSubscriptionByID(ctx context.Context, ID string) (*entities.WebhookSubscription, error) {
+	ret := _m.Called(ctx, ID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookSubscriptionByID")
+	}
+
+	var r0 *entities.WebhookSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entities.WebhookSubscription, error)); ok {
+		return rf(ctx, ID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entities.WebhookSubscription); ok {
+		r0 = rf(ctx, ID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.WebhookSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, ID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookSubscriptionRepository_GetWebhookSubscriptionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookSubscriptionByID'
+type WebhookSubscriptionRepository_GetWebhookSubscriptionByID_Call struct {
+	*mock.Call
+}
+
+// GetWebhookSubscriptionByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+func (_e *WebhookSubscriptionRepository_Expecter) GetWebhookSubscriptionByID(ctx interface{}, ID interface{}) *WebhookSubscriptionRepository_GetWebhookSubscriptionByID_Call {
+	return &WebhookSubscriptionRepository_GetWebhookSubscriptionByID_Call{Call: _e.mock.On("GetWebhookSubscriptionByID", ctx, ID)}
+}
+
+func (_c *WebhookSubscriptionRepository_GetWebhookSubscriptionByID_Call) Run(run func(ctx context.Context, ID string)) *WebhookSubscriptionRepository_GetWebhookSubscriptionByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *WebhookSubscriptionRepository_GetWebhookSubscriptionByID_Call) Return(_a0 *entities.WebhookSubscription, _a1 error) *WebhookSubscriptionRepository_GetWebhookSubscriptionByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookSubscriptionRepository_GetWebhookSubscriptionByID_Call) RunAndReturn(run func(context.Context, string) (*entities.WebhookSubscription, error)) *WebhookSubscriptionRepository_GetWebhookSubscriptionByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhookSubscriptions provides a mock function with given fields: ctx
+func (_m *WebhookSubscriptionRepository) GetWebhookSubscriptions(ctx context.Context) ([]*entities.WebhookSubscription, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhookSubscriptions")
+	}
+
+	var r0 []*entities.WebhookSubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*entities.WebhookSubscription, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*entities.WebhookSubscription); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.WebhookSubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebhookSubscriptionRepository_GetWebhookSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhookSubscriptions'
+type WebhookSubscriptionRepository_GetWebhookSubscriptions_Call struct {
+	*mock.Call
+}
+
+// GetWebhookSubscriptions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *WebhookSubscriptionRepository_Expecter) GetWebhookSubscriptions(ctx interface{}) *WebhookSubscriptionRepository_GetWebhookSubscriptions_Call {
+	return &WebhookSubscriptionRepository_GetWebhookSubscriptions_Call{Call: _e.mock.On("GetWebhookSubscriptions", ctx)}
+}
+
+func (_c *WebhookSubscriptionRepository_GetWebhookSubscriptions_Call) Run(run func(ctx context.Context)) *WebhookSubscriptionRepository_GetWebhookSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *WebhookSubscriptionRepository_GetWebhookSubscriptions_Call) Return(_a0 []*entities.WebhookSubscription, _a1 error) *WebhookSubscriptionRepository_GetWebhookSubscriptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebhookSubscriptionRepository_GetWebhookSubscriptions_Call) RunAndReturn(run func(context.Context) ([]*entities.WebhookSubscription, error)) *WebhookSubscriptionRepository_GetWebhookSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWebhookSubscriptionByID provides a mock function with given fields: ctx, ID, update
+func (_m *WebhookSubscriptionRepository) UpdateWebhookSubscriptionByID(ctx context.Context, ID string, update *entities.WebhookSubscriptionUpdate) error {
+	ret := _m.Called(ctx, ID, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWebhookSubscriptionByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *entities.WebhookSubscriptionUpdate) error); ok {
+		r0 = rf(ctx, ID, update)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebhookSubscriptionRepository_UpdateWebhookSubscriptionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWebhookSubscriptionByID'
+type WebhookSubscriptionRepository_UpdateWebhookSubscriptionByID_Call struct {
+	*mock.Call
+}
+
+// UpdateWebhookSubscriptionByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID string
+//   - update *entities.WebhookSubscriptionUpdate
+func (_e *WebhookSubscriptionRepository_Expecter) UpdateWebhookSubscriptionByID(ctx interface{}, ID interface{}, update interface{}) *WebhookSubscriptionRepository_UpdateWebhookSubscriptionByID_Call {
+	return &WebhookSubscriptionRepository_UpdateWebhookSubscriptionByID_Call{Call: _e.mock.On("UpdateWebhookSubscriptionByID", ctx, ID, update)}
+}
+
+func (_c *WebhookSubscriptionRepository_UpdateWebhookSubscriptionByID_Call) Run(run func(ctx context.Context, ID string, update *entities.WebhookSubscriptionUpdate)) *WebhookSubscriptionRepository_UpdateWebhookSubscriptionByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*entities.WebhookSubscriptionUpdate))
+	})
+	return _c
+}
+
+func (_c *WebhookSubscriptionRepository_UpdateWebhookSubscriptionByID_Call) Return(_a0 error) *WebhookSubscriptionRepository_UpdateWebhookSubscriptionByID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebhookSubscriptionRepository_UpdateWebhookSubscriptionByID_Call) RunAndReturn(run func(context.Context, string, *entities.WebhookSubscriptionUpdate) error) *WebhookSubscriptionRepository_UpdateWebhookSubscriptionByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebhookSubscriptionRepository creates a new instance of WebhookSubscriptionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookSubscriptionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookSubscriptionRepository {
+	mock := &WebhookSubscriptionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
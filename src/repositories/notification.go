@@ -0,0 +1,119 @@
+package repositories
+
+import (
+	"context"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/utils/pagination"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NotificationPreferenceRepository interface {
+	GetNotificationPreferenceByUserID(ctx context.Context, userID string) (*entities.NotificationPreference, error)
+	SaveNotificationPreference(ctx context.Context, preference *entities.NotificationPreference) error
+}
+
+type notificationPreferenceRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationPreferenceRepository(db *gorm.DB) NotificationPreferenceRepository {
+	return &notificationPreferenceRepository{
+		db: db,
+	}
+}
+
+func (r *notificationPreferenceRepository) GetNotificationPreferenceByUserID(ctx context.Context, userID string) (*entities.NotificationPreference, error) {
+	var preference entities.NotificationPreference
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&preference).Error; err != nil {
+		return nil, err
+	}
+
+	return &preference, nil
+}
+
+// SaveNotificationPreference creates the preference of the user or replaces it, keeping when it was created
+func (r *notificationPreferenceRepository) SaveNotificationPreference(ctx context.Context, preference *entities.NotificationPreference) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"locale", "email", "phone", "opt_outs", "updated_at"}),
+	}).Create(preference).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type NotificationDeliveryRepository interface {
+	CreateNotificationDelivery(ctx context.Context, delivery *entities.NotificationDelivery) error
+	UpdateNotificationDeliveryStatus(ctx context.Context, ID string, status string, deliveryError *string) error
+	GetNotificationDeliveriesByPaymentID(ctx context.Context, paymentID string, kind string) ([]*entities.NotificationDelivery, error)
+	GetNotificationDeliveries(ctx context.Context, filter *entities.NotificationDeliveryFilter, cursor *pagination.Cursor, limit int) ([]*entities.NotificationDelivery, error)
+}
+
+type notificationDeliveryRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationDeliveryRepository(db *gorm.DB) NotificationDeliveryRepository {
+	return &notificationDeliveryRepository{
+		db: db,
+	}
+}
+
+func (r *notificationDeliveryRepository) CreateNotificationDelivery(ctx context.Context, delivery *entities.NotificationDelivery) error {
+	if err := r.db.WithContext(ctx).Create(delivery).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *notificationDeliveryRepository) UpdateNotificationDeliveryStatus(ctx context.Context, ID string, status string, deliveryError *string) error {
+	err := r.db.WithContext(ctx).Model(&entities.NotificationDelivery{}).Where("id = ?", ID).Updates(map[string]interface{}{
+		"status": status,
+		"error":  deliveryError,
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *notificationDeliveryRepository) GetNotificationDeliveriesByPaymentID(ctx context.Context, paymentID string, kind string) ([]*entities.NotificationDelivery, error) {
+	var deliveries []*entities.NotificationDelivery
+	err := r.db.WithContext(ctx).Where("payment_id = ? AND kind = ?", paymentID, kind).
+		Order("created_at ASC").
+		Find(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// GetNotificationDeliveries returns deliveries ordered by created_at and id descending
+func (r *notificationDeliveryRepository) GetNotificationDeliveries(ctx context.Context, filter *entities.NotificationDeliveryFilter, cursor *pagination.Cursor, limit int) ([]*entities.NotificationDelivery, error) {
+	var deliveries []*entities.NotificationDelivery
+	query := r.db.WithContext(ctx)
+	if filter.UserID != "" {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.Kind != "" {
+		query = query.Where("kind = ?", filter.Kind)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", cursor.Time, cursor.ID)
+	}
+
+	err := query.Order("created_at DESC, id DESC").Limit(limit).Find(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,156 @@
+package repositories
+
+import (
+	"context"
+	"github.com/verizhang/billing-engine/src/entities"
+	"gorm.io/gorm"
+	"time"
+)
+
+// OUTBOX_RELAY_LOCK is the advisory lock key held by the relay publishing the outbox, one relay runs at a time
+const OUTBOX_RELAY_LOCK = 7340001
+
+type OutboxEventRepository interface {
+	CreateOutboxEvent(ctx context.Context, event *entities.OutboxEvent) error
+	GetOutboxEventByID(ctx context.Context, ID string) (*entities.OutboxEvent, error)
+	GetLastOutboxEventByLoanID(ctx context.Context, loanID string) (*entities.OutboxEvent, error)
+	ClaimOutboxEvents(ctx context.Context, now time.Time, lockedUntil time.Time, limit int) ([]*entities.OutboxEvent, error)
+	ReleaseOutboxEvents(ctx context.Context, IDs []string) error
+	GetOutboxEventsToFanOut(ctx context.Context, limit int) ([]*entities.OutboxEvent, error)
+	UpdateFannedOutOutboxEventByID(ctx context.Context, ID string, fannedOutAt time.Time) error
+	UpdatePublishedOutboxEventByID(ctx context.Context, ID string, publishedAt time.Time) error
+	UpdateFailedOutboxEventByID(ctx context.Context, ID string, lastError string, lockedUntil time.Time, parkedAt *time.Time) error
+	TryLockRelay(ctx context.Context) (bool, error)
+}
+
+type outboxEventRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxEventRepository(db *gorm.DB) OutboxEventRepository {
+	return &outboxEventRepository{
+		db: db,
+	}
+}
+
+func (r *outboxEventRepository) CreateOutboxEvent(ctx context.Context, event *entities.OutboxEvent) error {
+	if err := r.db.WithContext(ctx).Create(event).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *outboxEventRepository) GetOutboxEventByID(ctx context.Context, ID string) (*entities.OutboxEvent, error) {
+	var event entities.OutboxEvent
+	if err := r.db.WithContext(ctx).Where("id = ?", ID).First(&event).Error; err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+func (r *outboxEventRepository) GetLastOutboxEventByLoanID(ctx context.Context, loanID string) (*entities.OutboxEvent, error) {
+	var event entities.OutboxEvent
+	if err := r.db.WithContext(ctx).Where("loan_id = ?", loanID).Order("sequence DESC").First(&event).Error; err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+// ClaimOutboxEvents leases the next pending events in position order until lockedUntil,
+// a loan with an event still leased or backing off is skipped so its events are never published out of order
+func (r *outboxEventRepository) ClaimOutboxEvents(ctx context.Context, now time.Time, lockedUntil time.Time, limit int) ([]*entities.OutboxEvent, error) {
+	var events []*entities.OutboxEvent
+	err := r.db.WithContext(ctx).
+		Where("published_at IS NULL AND parked_at IS NULL AND (locked_until IS NULL OR locked_until <= ?)", now).
+		Where("NOT EXISTS (SELECT 1 FROM outbox_events leased WHERE leased.loan_id = outbox_events.loan_id AND leased.published_at IS NULL AND leased.parked_at IS NULL AND leased.locked_until > ?)", now).
+		Order("position ASC").Limit(limit).Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return events, nil
+	}
+
+	IDs := make([]string, 0, len(events))
+	for _, event := range events {
+		IDs = append(IDs, event.ID)
+		event.LockedUntil = &lockedUntil
+	}
+	err = r.db.WithContext(ctx).Model(&entities.OutboxEvent{}).Where("id IN ?", IDs).Update("locked_until", lockedUntil).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// ReleaseOutboxEvents ends the lease of claimed events the relay held back, they are claimed again by the next run
+func (r *outboxEventRepository) ReleaseOutboxEvents(ctx context.Context, IDs []string) error {
+	err := r.db.WithContext(ctx).Model(&entities.OutboxEvent{}).Where("id IN ? AND published_at IS NULL", IDs).Update("locked_until", nil).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetOutboxEventsToFanOut returns the events without webhook deliveries yet in position order
+func (r *outboxEventRepository) GetOutboxEventsToFanOut(ctx context.Context, limit int) ([]*entities.OutboxEvent, error) {
+	var events []*entities.OutboxEvent
+	err := r.db.WithContext(ctx).Where("fanned_out_at IS NULL").Order("position ASC").Limit(limit).Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r *outboxEventRepository) UpdateFannedOutOutboxEventByID(ctx context.Context, ID string, fannedOutAt time.Time) error {
+	err := r.db.WithContext(ctx).Model(&entities.OutboxEvent{}).Where("id = ?", ID).Update("fanned_out_at", fannedOutAt).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *outboxEventRepository) UpdatePublishedOutboxEventByID(ctx context.Context, ID string, publishedAt time.Time) error {
+	err := r.db.WithContext(ctx).Model(&entities.OutboxEvent{}).Where("id = ?", ID).Updates(map[string]interface{}{
+		"published_at": publishedAt,
+		"attempts":     gorm.Expr("attempts + 1"),
+		"last_error":   nil,
+		"locked_until": nil,
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateFailedOutboxEventByID keeps the event locked until its next attempt, a parked event is not claimed again
+func (r *outboxEventRepository) UpdateFailedOutboxEventByID(ctx context.Context, ID string, lastError string, lockedUntil time.Time, parkedAt *time.Time) error {
+	err := r.db.WithContext(ctx).Model(&entities.OutboxEvent{}).Where("id = ?", ID).Updates(map[string]interface{}{
+		"attempts":     gorm.Expr("attempts + 1"),
+		"last_error":   lastError,
+		"locked_until": lockedUntil,
+		"parked_at":    parkedAt,
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// TryLockRelay takes the relay lock until the end of the transaction, false when another relay holds it
+func (r *outboxEventRepository) TryLockRelay(ctx context.Context) (bool, error) {
+	var locked bool
+	if err := r.db.WithContext(ctx).Raw("SELECT pg_try_advisory_xact_lock(?)", OUTBOX_RELAY_LOCK).Scan(&locked).Error; err != nil {
+		return false, err
+	}
+
+	return locked, nil
+}
@@ -9,8 +9,17 @@ import (
 
 type PaymentRepository interface {
 	CreatePayments(ctx context.Context, payments []*entities.Payment) error
-	UpdatePaidAtPayment(ctx context.Context, ID string, paidAt *time.Time) error
+	UpdatePaidAtPayment(ctx context.Context, ID string, paidAt *time.Time) (bool, error)
 	GetPaymentByLoanID(ctx context.Context, loanID string) ([]*entities.Payment, error)
+	GetPaymentHistoryByLoanID(ctx context.Context, loanID string) ([]*entities.Payment, error)
+	SoftDeletePayments(ctx context.Context, IDs []string, deletedAt *time.Time) (int, error)
+	ReplacePayments(ctx context.Context, IDs []string, scheduleID string, deletedAt *time.Time) (int, error)
+	UpdatePayments(ctx context.Context, payments []*entities.Payment) error
+	GetLateFeeDuePayments(ctx context.Context, dueBefore time.Time) ([]*entities.Payment, error)
+	ChargeLateFeePayment(ctx context.Context, ID string, fee float64, chargedAt time.Time) (bool, error)
+	GetReminderDuePayments(ctx context.Context, now time.Time, dueBefore time.Time) ([]*entities.Payment, error)
+	UpdateRemindedAtPayment(ctx context.Context, ID string, remindedAt time.Time) (bool, error)
+	GetUnpaidPaymentsEndingBetween(ctx context.Context, from time.Time, to time.Time) ([]*entities.Payment, error)
 }
 
 type paymentRepository struct {
@@ -24,22 +33,146 @@ func NewPaymentRepository(db *gorm.DB) PaymentRepository {
 }
 
 func (r *paymentRepository) CreatePayments(ctx context.Context, payments []*entities.Payment) error {
-	if err := r.db.Create(payments).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(payments).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
-func (r *paymentRepository) UpdatePaidAtPayment(ctx context.Context, ID string, paidAt *time.Time) error {
-	if err := r.db.Model(&entities.Payment{}).Where("id = ?", ID).Update("paid_at", paidAt).Error; err != nil {
-		return err
+// UpdatePaidAtPayment marks an unpaid installment paid, false when it was paid or replaced meanwhile
+func (r *paymentRepository) UpdatePaidAtPayment(ctx context.Context, ID string, paidAt *time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&entities.Payment{}).
+		Where("id = ? AND paid_at IS NULL AND deleted_at IS NULL", ID).
+		Update("paid_at", paidAt)
+	if result.Error != nil {
+		return false, result.Error
 	}
-	return nil
+
+	return result.RowsAffected == 1, nil
 }
 
 func (r *paymentRepository) GetPaymentByLoanID(ctx context.Context, loanID string) ([]*entities.Payment, error) {
 	var payments []*entities.Payment
-	if err := r.db.Where("loan_id = ?", loanID).Order("start_at ASC").Find(&payments).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where("loan_id = ? AND deleted_at IS NULL", loanID).Order("start_at ASC").Find(&payments).Error; err != nil {
+		return nil, err
+	}
+
+	return payments, nil
+}
+
+func (r *paymentRepository) GetPaymentHistoryByLoanID(ctx context.Context, loanID string) ([]*entities.Payment, error) {
+	var payments []*entities.Payment
+	if err := r.db.WithContext(ctx).Where("loan_id = ?", loanID).Order("start_at ASC, created_at ASC").Find(&payments).Error; err != nil {
+		return nil, err
+	}
+
+	return payments, nil
+}
+
+// SoftDeletePayments removes the installments still unpaid, it returns how many were removed
+func (r *paymentRepository) SoftDeletePayments(ctx context.Context, IDs []string, deletedAt *time.Time) (int, error) {
+	result := r.db.WithContext(ctx).Model(&entities.Payment{}).
+		Where("id IN ? AND paid_at IS NULL AND deleted_at IS NULL", IDs).
+		Update("deleted_at", deletedAt)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return int(result.RowsAffected), nil
+}
+
+// ReplacePayments removes the installments still unpaid in favour of the schedule version replacing them, it returns how many were removed
+func (r *paymentRepository) ReplacePayments(ctx context.Context, IDs []string, scheduleID string, deletedAt *time.Time) (int, error) {
+	result := r.db.WithContext(ctx).Model(&entities.Payment{}).
+		Where("id IN ? AND paid_at IS NULL AND deleted_at IS NULL", IDs).
+		Updates(map[string]interface{}{
+			"deleted_at":              deletedAt,
+			"replaced_by_schedule_id": scheduleID,
+		})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return int(result.RowsAffected), nil
+}
+
+func (r *paymentRepository) UpdatePayments(ctx context.Context, payments []*entities.Payment) error {
+	for _, payment := range payments {
+		err := r.db.WithContext(ctx).Model(payment).
+			Select("amount", "interest", "fee", "start_at", "end_at", "deferral_id").
+			Updates(payment).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetLateFeeDuePayments returns the unpaid installments of active loans that ended before dueBefore without a late fee
+func (r *paymentRepository) GetLateFeeDuePayments(ctx context.Context, dueBefore time.Time) ([]*entities.Payment, error) {
+	var payments []*entities.Payment
+	err := r.db.WithContext(ctx).Where("paid_at IS NULL AND deleted_at IS NULL AND late_fee_at IS NULL AND end_at < ?", dueBefore).
+		Where("loan_id IN (SELECT id FROM loans WHERE status = ?)", entities.LOAN_STATUS_ACTIVE).
+		Order("end_at ASC, id ASC").
+		Find(&payments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return payments, nil
+}
+
+// ChargeLateFeePayment adds the fee to an unpaid installment once, false when it was paid, cancelled or charged meanwhile
+func (r *paymentRepository) ChargeLateFeePayment(ctx context.Context, ID string, fee float64, chargedAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&entities.Payment{}).
+		Where("id = ? AND paid_at IS NULL AND deleted_at IS NULL AND late_fee_at IS NULL", ID).
+		Updates(map[string]interface{}{
+			"amount":      gorm.Expr("amount + ?", fee),
+			"fee":         gorm.Expr("fee + ?", fee),
+			"late_fee":    fee,
+			"late_fee_at": chargedAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// GetReminderDuePayments returns the unpaid installments of active loans ending in [now, dueBefore) not reminded yet
+func (r *paymentRepository) GetReminderDuePayments(ctx context.Context, now time.Time, dueBefore time.Time) ([]*entities.Payment, error) {
+	var payments []*entities.Payment
+	err := r.db.WithContext(ctx).Where("paid_at IS NULL AND deleted_at IS NULL AND reminded_at IS NULL AND end_at >= ? AND end_at < ?", now, dueBefore).
+		Where("loan_id IN (SELECT id FROM loans WHERE status = ?)", entities.LOAN_STATUS_ACTIVE).
+		Order("end_at ASC, id ASC").
+		Find(&payments).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return payments, nil
+}
+
+// UpdateRemindedAtPayment marks an unpaid installment reminded once, false when it was paid, cancelled or reminded meanwhile
+func (r *paymentRepository) UpdateRemindedAtPayment(ctx context.Context, ID string, remindedAt time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&entities.Payment{}).
+		Where("id = ? AND paid_at IS NULL AND deleted_at IS NULL AND reminded_at IS NULL", ID).
+		Update("reminded_at", remindedAt)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// GetUnpaidPaymentsEndingBetween returns the unpaid installments of active loans ending in [from, to)
+func (r *paymentRepository) GetUnpaidPaymentsEndingBetween(ctx context.Context, from time.Time, to time.Time) ([]*entities.Payment, error) {
+	var payments []*entities.Payment
+	err := r.db.WithContext(ctx).Where("paid_at IS NULL AND deleted_at IS NULL AND end_at >= ? AND end_at < ?", from, to).
+		Where("loan_id IN (SELECT id FROM loans WHERE status = ?)", entities.LOAN_STATUS_ACTIVE).
+		Order("end_at ASC, id ASC").
+		Find(&payments).Error
+	if err != nil {
 		return nil, err
 	}
 
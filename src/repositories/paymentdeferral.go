@@ -0,0 +1,38 @@
+package repositories
+
+import (
+	"context"
+	"github.com/verizhang/billing-engine/src/entities"
+	"gorm.io/gorm"
+)
+
+type PaymentDeferralRepository interface {
+	CreatePaymentDeferral(ctx context.Context, deferral *entities.PaymentDeferral) error
+	GetPaymentDeferralsByLoanID(ctx context.Context, loanID string) ([]*entities.PaymentDeferral, error)
+}
+
+type paymentDeferralRepository struct {
+	db *gorm.DB
+}
+
+func NewPaymentDeferralRepository(db *gorm.DB) PaymentDeferralRepository {
+	return &paymentDeferralRepository{
+		db: db,
+	}
+}
+
+func (r *paymentDeferralRepository) CreatePaymentDeferral(ctx context.Context, deferral *entities.PaymentDeferral) error {
+	if err := r.db.WithContext(ctx).Create(deferral).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *paymentDeferralRepository) GetPaymentDeferralsByLoanID(ctx context.Context, loanID string) ([]*entities.PaymentDeferral, error) {
+	var deferrals []*entities.PaymentDeferral
+	if err := r.db.WithContext(ctx).Where("loan_id = ? AND deleted_at IS NULL", loanID).Order("start_at ASC").Find(&deferrals).Error; err != nil {
+		return nil, err
+	}
+
+	return deferrals, nil
+}
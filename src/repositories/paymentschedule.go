@@ -0,0 +1,48 @@
+package repositories
+
+import (
+	"context"
+	"github.com/verizhang/billing-engine/src/entities"
+	"gorm.io/gorm"
+)
+
+type PaymentScheduleRepository interface {
+	CreatePaymentSchedule(ctx context.Context, schedule *entities.PaymentSchedule) error
+	GetCurrentPaymentScheduleByLoanID(ctx context.Context, loanID string) (*entities.PaymentSchedule, error)
+	GetPaymentSchedulesByLoanID(ctx context.Context, loanID string) ([]*entities.PaymentSchedule, error)
+}
+
+type paymentScheduleRepository struct {
+	db *gorm.DB
+}
+
+func NewPaymentScheduleRepository(db *gorm.DB) PaymentScheduleRepository {
+	return &paymentScheduleRepository{
+		db: db,
+	}
+}
+
+func (r *paymentScheduleRepository) CreatePaymentSchedule(ctx context.Context, schedule *entities.PaymentSchedule) error {
+	if err := r.db.WithContext(ctx).Create(schedule).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *paymentScheduleRepository) GetCurrentPaymentScheduleByLoanID(ctx context.Context, loanID string) (*entities.PaymentSchedule, error) {
+	var schedule entities.PaymentSchedule
+	if err := r.db.WithContext(ctx).Where("loan_id = ?", loanID).Order("version DESC").First(&schedule).Error; err != nil {
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+func (r *paymentScheduleRepository) GetPaymentSchedulesByLoanID(ctx context.Context, loanID string) ([]*entities.PaymentSchedule, error) {
+	var schedules []*entities.PaymentSchedule
+	if err := r.db.WithContext(ctx).Where("loan_id = ?", loanID).Order("version ASC").Find(&schedules).Error; err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
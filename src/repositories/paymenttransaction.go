@@ -0,0 +1,115 @@
+package repositories
+
+import (
+	"context"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/utils/pagination"
+	"gorm.io/gorm"
+	"time"
+)
+
+type PaymentTransactionRepository interface {
+	CreatePaymentTransaction(ctx context.Context, transaction *entities.PaymentTransaction) error
+	GetPaymentTransactions(ctx context.Context, filter *entities.PaymentTransactionFilter, cursor *pagination.Cursor, limit int) ([]*entities.PaymentTransaction, error)
+	GetPaymentTransactionByReference(ctx context.Context, channel string, reference string) (*entities.PaymentTransaction, error)
+	ReversePaymentTransactionsByPaymentIDs(ctx context.Context, paymentIDs []string, reversedAt *time.Time) error
+}
+
+type paymentTransactionRepository struct {
+	db *gorm.DB
+}
+
+func NewPaymentTransactionRepository(db *gorm.DB) PaymentTransactionRepository {
+	return &paymentTransactionRepository{
+		db: db,
+	}
+}
+
+func (r *paymentTransactionRepository) CreatePaymentTransaction(ctx context.Context, transaction *entities.PaymentTransaction) error {
+	if err := r.db.WithContext(ctx).Create(transaction).Error; err != nil {
+		return err
+	}
+
+	if len(transaction.Allocations) == 0 {
+		return nil
+	}
+
+	if err := r.db.WithContext(ctx).Create(transaction.Allocations).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetPaymentTransactions returns transactions ordered by paid_at and id descending with their allocations
+func (r *paymentTransactionRepository) GetPaymentTransactions(ctx context.Context, filter *entities.PaymentTransactionFilter, cursor *pagination.Cursor, limit int) ([]*entities.PaymentTransaction, error) {
+	var transactions []*entities.PaymentTransaction
+	query := r.db.WithContext(ctx).Where("deleted_at IS NULL")
+	if filter.LoanID != "" {
+		query = query.Where("loan_id = ?", filter.LoanID)
+	}
+	if filter.UserID != "" {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.From != nil {
+		query = query.Where("paid_at >= ?", filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("paid_at < ?", filter.To)
+	}
+	if cursor != nil {
+		query = query.Where("(paid_at, id) < (?, ?)", cursor.Time, cursor.ID)
+	}
+
+	err := query.Order("paid_at DESC, id DESC").Limit(limit).Find(&transactions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(transactions) == 0 {
+		return transactions, nil
+	}
+
+	var IDs []string
+	byID := map[string]*entities.PaymentTransaction{}
+	for _, transaction := range transactions {
+		IDs = append(IDs, transaction.ID)
+		byID[transaction.ID] = transaction
+	}
+
+	var allocations []*entities.PaymentAllocation
+	err = r.db.WithContext(ctx).Where("transaction_id IN ? AND deleted_at IS NULL", IDs).Order("id ASC").Find(&allocations).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, allocation := range allocations {
+		transaction := byID[allocation.TransactionID]
+		transaction.Allocations = append(transaction.Allocations, allocation)
+	}
+
+	return transactions, nil
+}
+
+func (r *paymentTransactionRepository) GetPaymentTransactionByReference(ctx context.Context, channel string, reference string) (*entities.PaymentTransaction, error) {
+	var transaction entities.PaymentTransaction
+	if err := r.db.WithContext(ctx).Where("channel = ? AND reference = ?", channel, reference).First(&transaction).Error; err != nil {
+		return nil, err
+	}
+
+	return &transaction, nil
+}
+
+// ReversePaymentTransactionsByPaymentIDs marks reversed the transactions allocated to the installments being cancelled
+func (r *paymentTransactionRepository) ReversePaymentTransactionsByPaymentIDs(ctx context.Context, paymentIDs []string, reversedAt *time.Time) error {
+	allocated := r.db.WithContext(ctx).Model(&entities.PaymentAllocation{}).
+		Select("transaction_id").
+		Where("payment_id IN ? AND deleted_at IS NULL", paymentIDs)
+	err := r.db.WithContext(ctx).Model(&entities.PaymentTransaction{}).
+		Where("id IN (?) AND reversed_at IS NULL AND deleted_at IS NULL", allocated).
+		Update("reversed_at", reversedAt).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
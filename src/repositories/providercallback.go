@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"context"
+	"github.com/verizhang/billing-engine/src/entities"
+	"gorm.io/gorm"
+)
+
+type ProviderCallbackRepository interface {
+	CreateProviderCallback(ctx context.Context, callback *entities.ProviderCallback) error
+}
+
+type providerCallbackRepository struct {
+	db *gorm.DB
+}
+
+func NewProviderCallbackRepository(db *gorm.DB) ProviderCallbackRepository {
+	return &providerCallbackRepository{
+		db: db,
+	}
+}
+
+func (r *providerCallbackRepository) CreateProviderCallback(ctx context.Context, callback *entities.ProviderCallback) error {
+	if err := r.db.WithContext(ctx).Create(callback).Error; err != nil {
+		return err
+	}
+	return nil
+}
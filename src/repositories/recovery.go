@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"context"
+	"github.com/verizhang/billing-engine/src/entities"
+	"gorm.io/gorm"
+	"time"
+)
+
+type LoanRecoveryRepository interface {
+	CreateLoanRecovery(ctx context.Context, recovery *entities.LoanRecovery) error
+	GetLoanRecoveriesByDate(ctx context.Context, from time.Time, to time.Time) ([]*entities.LoanRecovery, error)
+}
+
+type loanRecoveryRepository struct {
+	db *gorm.DB
+}
+
+func NewLoanRecoveryRepository(db *gorm.DB) LoanRecoveryRepository {
+	return &loanRecoveryRepository{
+		db: db,
+	}
+}
+
+func (r *loanRecoveryRepository) CreateLoanRecovery(ctx context.Context, recovery *entities.LoanRecovery) error {
+	if err := r.db.WithContext(ctx).Create(recovery).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *loanRecoveryRepository) GetLoanRecoveriesByDate(ctx context.Context, from time.Time, to time.Time) ([]*entities.LoanRecovery, error) {
+	var recoveries []*entities.LoanRecovery
+	err := r.db.WithContext(ctx).Where("recovered_at >= ? AND recovered_at < ?", from, to).Order("recovered_at ASC").Find(&recoveries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return recoveries, nil
+}
@@ -11,6 +11,18 @@ type UnitOfWork interface {
 	Rollback(tx *gorm.DB) error
 	LoanRepository(tx *gorm.DB) LoanRepository
 	PaymentRepository(tx *gorm.DB) PaymentRepository
+	PaymentScheduleRepository(tx *gorm.DB) PaymentScheduleRepository
+	PaymentDeferralRepository(tx *gorm.DB) PaymentDeferralRepository
+	LoanWriteOffRepository(tx *gorm.DB) LoanWriteOffRepository
+	LoanRecoveryRepository(tx *gorm.DB) LoanRecoveryRepository
+	PaymentTransactionRepository(tx *gorm.DB) PaymentTransactionRepository
+	AuditEventRepository(tx *gorm.DB) AuditEventRepository
+	OutboxEventRepository(tx *gorm.DB) OutboxEventRepository
+	WebhookSubscriptionRepository(tx *gorm.DB) WebhookSubscriptionRepository
+	WebhookDeliveryRepository(tx *gorm.DB) WebhookDeliveryRepository
+	BankStatementImportRepository(tx *gorm.DB) BankStatementImportRepository
+	BankStatementLineRepository(tx *gorm.DB) BankStatementLineRepository
+	NotificationPreferenceRepository(tx *gorm.DB) NotificationPreferenceRepository
 }
 
 type unitOfWork struct {
@@ -54,3 +66,51 @@ func (u *unitOfWork) LoanRepository(tx *gorm.DB) LoanRepository {
 func (u *unitOfWork) PaymentRepository(tx *gorm.DB) PaymentRepository {
 	return NewPaymentRepository(tx)
 }
+
+func (u *unitOfWork) PaymentScheduleRepository(tx *gorm.DB) PaymentScheduleRepository {
+	return NewPaymentScheduleRepository(tx)
+}
+
+func (u *unitOfWork) PaymentDeferralRepository(tx *gorm.DB) PaymentDeferralRepository {
+	return NewPaymentDeferralRepository(tx)
+}
+
+func (u *unitOfWork) LoanWriteOffRepository(tx *gorm.DB) LoanWriteOffRepository {
+	return NewLoanWriteOffRepository(tx)
+}
+
+func (u *unitOfWork) LoanRecoveryRepository(tx *gorm.DB) LoanRecoveryRepository {
+	return NewLoanRecoveryRepository(tx)
+}
+
+func (u *unitOfWork) PaymentTransactionRepository(tx *gorm.DB) PaymentTransactionRepository {
+	return NewPaymentTransactionRepository(tx)
+}
+
+func (u *unitOfWork) AuditEventRepository(tx *gorm.DB) AuditEventRepository {
+	return NewAuditEventRepository(tx)
+}
+
+func (u *unitOfWork) OutboxEventRepository(tx *gorm.DB) OutboxEventRepository {
+	return NewOutboxEventRepository(tx)
+}
+
+func (u *unitOfWork) WebhookSubscriptionRepository(tx *gorm.DB) WebhookSubscriptionRepository {
+	return NewWebhookSubscriptionRepository(tx)
+}
+
+func (u *unitOfWork) WebhookDeliveryRepository(tx *gorm.DB) WebhookDeliveryRepository {
+	return NewWebhookDeliveryRepository(tx)
+}
+
+func (u *unitOfWork) BankStatementImportRepository(tx *gorm.DB) BankStatementImportRepository {
+	return NewBankStatementImportRepository(tx)
+}
+
+func (u *unitOfWork) BankStatementLineRepository(tx *gorm.DB) BankStatementLineRepository {
+	return NewBankStatementLineRepository(tx)
+}
+
+func (u *unitOfWork) NotificationPreferenceRepository(tx *gorm.DB) NotificationPreferenceRepository {
+	return NewNotificationPreferenceRepository(tx)
+}
@@ -0,0 +1,218 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/utils/pagination"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// WEBHOOK_DISPATCHER_LOCK is the advisory lock key held by the dispatcher sending the webhook deliveries
+const WEBHOOK_DISPATCHER_LOCK = 7340002
+
+type WebhookSubscriptionRepository interface {
+	CreateWebhookSubscription(ctx context.Context, subscription *entities.WebhookSubscription) error
+	GetWebhookSubscriptionByID(ctx context.Context, ID string) (*entities.WebhookSubscription, error)
+	GetWebhookSubscriptions(ctx context.Context) ([]*entities.WebhookSubscription, error)
+	GetActiveWebhookSubscriptions(ctx context.Context) ([]*entities.WebhookSubscription, error)
+	UpdateWebhookSubscriptionByID(ctx context.Context, ID string, update *entities.WebhookSubscriptionUpdate) error
+	DeleteWebhookSubscriptionByID(ctx context.Context, ID string, deletedAt time.Time) error
+}
+
+type webhookSubscriptionRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookSubscriptionRepository(db *gorm.DB) WebhookSubscriptionRepository {
+	return &webhookSubscriptionRepository{
+		db: db,
+	}
+}
+
+func (r *webhookSubscriptionRepository) CreateWebhookSubscription(ctx context.Context, subscription *entities.WebhookSubscription) error {
+	if err := r.db.WithContext(ctx).Create(subscription).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *webhookSubscriptionRepository) GetWebhookSubscriptionByID(ctx context.Context, ID string) (*entities.WebhookSubscription, error) {
+	var subscription entities.WebhookSubscription
+	if err := r.db.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", ID).First(&subscription).Error; err != nil {
+		return nil, err
+	}
+
+	return &subscription, nil
+}
+
+func (r *webhookSubscriptionRepository) GetWebhookSubscriptions(ctx context.Context) ([]*entities.WebhookSubscription, error) {
+	var subscriptions []*entities.WebhookSubscription
+	if err := r.db.WithContext(ctx).Where("deleted_at IS NULL").Order("created_at ASC").Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+func (r *webhookSubscriptionRepository) GetActiveWebhookSubscriptions(ctx context.Context) ([]*entities.WebhookSubscription, error) {
+	var subscriptions []*entities.WebhookSubscription
+	if err := r.db.WithContext(ctx).Where("is_active = ? AND deleted_at IS NULL", true).Order("created_at ASC").Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+func (r *webhookSubscriptionRepository) UpdateWebhookSubscriptionByID(ctx context.Context, ID string, update *entities.WebhookSubscriptionUpdate) error {
+	values := map[string]interface{}{
+		"updated_at": time.Now(),
+	}
+	if update.URL != nil {
+		values["url"] = *update.URL
+	}
+	if update.EventTypes != nil {
+		eventTypes, err := json.Marshal(update.EventTypes)
+		if err != nil {
+			return err
+		}
+		values["event_types"] = string(eventTypes)
+	}
+	if update.Secret != nil {
+		values["secret"] = *update.Secret
+	}
+	if update.IsActive != nil {
+		values["is_active"] = *update.IsActive
+	}
+
+	err := r.db.WithContext(ctx).Model(&entities.WebhookSubscription{}).Where("id = ?", ID).Updates(values).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *webhookSubscriptionRepository) DeleteWebhookSubscriptionByID(ctx context.Context, ID string, deletedAt time.Time) error {
+	err := r.db.WithContext(ctx).Model(&entities.WebhookSubscription{}).Where("id = ?", ID).Updates(map[string]interface{}{
+		"is_active":  false,
+		"deleted_at": deletedAt,
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type WebhookDeliveryRepository interface {
+	CreateWebhookDeliveries(ctx context.Context, deliveries []*entities.WebhookDelivery) error
+	GetWebhookDeliveryByID(ctx context.Context, ID string) (*entities.WebhookDelivery, error)
+	ClaimDueWebhookDeliveries(ctx context.Context, now time.Time, leasedUntil time.Time, limit int) ([]*entities.WebhookDelivery, error)
+	GetWebhookDeliveries(ctx context.Context, filter *entities.WebhookDeliveryFilter, cursor *pagination.Cursor, limit int) ([]*entities.WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, delivery *entities.WebhookDelivery) error
+	TryLockDispatcher(ctx context.Context) (bool, error)
+}
+
+type webhookDeliveryRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookDeliveryRepository(db *gorm.DB) WebhookDeliveryRepository {
+	return &webhookDeliveryRepository{
+		db: db,
+	}
+}
+
+// CreateWebhookDeliveries skips the deliveries already created for the event, the relay may publish an event twice
+func (r *webhookDeliveryRepository) CreateWebhookDeliveries(ctx context.Context, deliveries []*entities.WebhookDelivery) error {
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(deliveries).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *webhookDeliveryRepository) GetWebhookDeliveryByID(ctx context.Context, ID string) (*entities.WebhookDelivery, error) {
+	var delivery entities.WebhookDelivery
+	if err := r.db.WithContext(ctx).Where("id = ?", ID).First(&delivery).Error; err != nil {
+		return nil, err
+	}
+
+	return &delivery, nil
+}
+
+// ClaimDueWebhookDeliveries leases the due pending deliveries by moving their next attempt to leasedUntil,
+// the attempt sets the real next attempt and a delivery left behind by a crash is due again once the lease ends
+func (r *webhookDeliveryRepository) ClaimDueWebhookDeliveries(ctx context.Context, now time.Time, leasedUntil time.Time, limit int) ([]*entities.WebhookDelivery, error) {
+	var deliveries []*entities.WebhookDelivery
+	err := r.db.WithContext(ctx).Where("status = ? AND next_attempt_at <= ?", entities.WEBHOOK_DELIVERY_STATUS_PENDING, now).
+		Order("next_attempt_at ASC, created_at ASC").
+		Limit(limit).
+		Find(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(deliveries) == 0 {
+		return deliveries, nil
+	}
+
+	IDs := make([]string, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		IDs = append(IDs, delivery.ID)
+		delivery.NextAttemptAt = leasedUntil
+	}
+	err = r.db.WithContext(ctx).Model(&entities.WebhookDelivery{}).Where("id IN ?", IDs).Update("next_attempt_at", leasedUntil).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// GetWebhookDeliveries returns deliveries ordered by created_at and id descending
+func (r *webhookDeliveryRepository) GetWebhookDeliveries(ctx context.Context, filter *entities.WebhookDeliveryFilter, cursor *pagination.Cursor, limit int) ([]*entities.WebhookDelivery, error) {
+	var deliveries []*entities.WebhookDelivery
+	query := r.db.WithContext(ctx).Where("subscription_id = ?", filter.SubscriptionID)
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if cursor != nil {
+		query = query.Where("(created_at, id) < (?, ?)", cursor.Time, cursor.ID)
+	}
+
+	err := query.Order("created_at DESC, id DESC").Limit(limit).Find(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (r *webhookDeliveryRepository) UpdateWebhookDelivery(ctx context.Context, delivery *entities.WebhookDelivery) error {
+	err := r.db.WithContext(ctx).Model(&entities.WebhookDelivery{}).Where("id = ?", delivery.ID).Updates(map[string]interface{}{
+		"status":           delivery.Status,
+		"attempts":         delivery.Attempts,
+		"next_attempt_at":  delivery.NextAttemptAt,
+		"last_status_code": delivery.LastStatusCode,
+		"last_error":       delivery.LastError,
+		"delivered_at":     delivery.DeliveredAt,
+		"updated_at":       delivery.UpdatedAt,
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// TryLockDispatcher takes the dispatcher lock until the end of the transaction, false when another dispatcher holds it
+func (r *webhookDeliveryRepository) TryLockDispatcher(ctx context.Context) (bool, error) {
+	var locked bool
+	if err := r.db.WithContext(ctx).Raw("SELECT pg_try_advisory_xact_lock(?)", WEBHOOK_DISPATCHER_LOCK).Scan(&locked).Error; err != nil {
+		return false, err
+	}
+
+	return locked, nil
+}
@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"context"
+	"github.com/verizhang/billing-engine/src/entities"
+	"gorm.io/gorm"
+	"time"
+)
+
+type LoanWriteOffRepository interface {
+	CreateLoanWriteOff(ctx context.Context, writeOff *entities.LoanWriteOff) error
+	GetLoanWriteOffByLoanID(ctx context.Context, loanID string) (*entities.LoanWriteOff, error)
+	GetLoanWriteOffsByDate(ctx context.Context, from time.Time, to time.Time) ([]*entities.LoanWriteOff, error)
+}
+
+type loanWriteOffRepository struct {
+	db *gorm.DB
+}
+
+func NewLoanWriteOffRepository(db *gorm.DB) LoanWriteOffRepository {
+	return &loanWriteOffRepository{
+		db: db,
+	}
+}
+
+func (r *loanWriteOffRepository) CreateLoanWriteOff(ctx context.Context, writeOff *entities.LoanWriteOff) error {
+	if err := r.db.WithContext(ctx).Create(writeOff).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *loanWriteOffRepository) GetLoanWriteOffByLoanID(ctx context.Context, loanID string) (*entities.LoanWriteOff, error) {
+	var writeOff entities.LoanWriteOff
+	if err := r.db.WithContext(ctx).Where("loan_id = ?", loanID).First(&writeOff).Error; err != nil {
+		return nil, err
+	}
+
+	return &writeOff, nil
+}
+
+func (r *loanWriteOffRepository) GetLoanWriteOffsByDate(ctx context.Context, from time.Time, to time.Time) ([]*entities.LoanWriteOff, error) {
+	var writeOffs []*entities.LoanWriteOff
+	err := r.db.WithContext(ctx).Where("written_off_at >= ? AND written_off_at < ?", from, to).Order("written_off_at ASC").Find(&writeOffs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return writeOffs, nil
+}
@@ -0,0 +1,104 @@
+package scheduler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verizhang/billing-engine/src/scheduler"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("accepts expressions and macros", func(t *testing.T) {
+		for _, spec := range []string{"* * * * *", "0 1 * * *", "*/15 8-17 * * 1-5", "0 0 1,15 * *", "5/20 * * * 7", "@daily", "@hourly"} {
+			_, err := scheduler.Parse(spec)
+			assert.NoError(t, err, spec)
+		}
+	})
+
+	t.Run("error on invalid expressions", func(t *testing.T) {
+		for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "*/0 * * * *", "5-1 * * * *", "a * * * *"} {
+			_, err := scheduler.Parse(spec)
+			assert.Error(t, err, spec)
+		}
+	})
+}
+
+func TestSchedule_Next(t *testing.T) {
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	tests := []struct {
+		name string
+		spec string
+		from time.Time
+		want time.Time
+	}{
+		{
+			name: "every minute starts at the next minute",
+			spec: "* * * * *",
+			from: time.Date(2026, 3, 2, 10, 15, 30, 0, time.UTC),
+			want: time.Date(2026, 3, 2, 10, 16, 0, 0, time.UTC),
+		},
+		{
+			name: "daily later the same day",
+			spec: "30 1 * * *",
+			from: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2026, 3, 2, 1, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "daily rolls to the next day",
+			spec: "@daily",
+			from: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "steps within a range",
+			spec: "*/20 9-10 * * *",
+			from: time.Date(2026, 3, 2, 10, 40, 0, 0, time.UTC),
+			want: time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "weekdays skip the weekend",
+			spec: "0 8 * * 1-5",
+			from: time.Date(2026, 3, 6, 9, 0, 0, 0, time.UTC),
+			want: time.Date(2026, 3, 9, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "7 is sunday",
+			spec: "0 0 * * 7",
+			from: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "restricted day of month or day of week",
+			spec: "0 0 15 * 1",
+			from: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "skips months without the day",
+			spec: "0 0 31 * *",
+			from: time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2026, 5, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "wall clock of the location",
+			spec: "0 1 * * *",
+			from: time.Date(2026, 3, 2, 0, 0, 0, 0, jakarta),
+			want: time.Date(2026, 3, 2, 1, 0, 0, 0, jakarta),
+		},
+		{
+			name: "never runs",
+			spec: "0 0 30 2 *",
+			from: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+			want: time.Time{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := scheduler.Parse(tt.spec)
+			assert.NoError(t, err)
+			assert.True(t, tt.want.Equal(schedule.Next(tt.from)), "got %v", schedule.Next(tt.from))
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RunFunc runs a job for the time it was scheduled at, the same time on every replica
+type RunFunc func(ctx context.Context, scheduledAt time.Time)
+
+type job struct {
+	name     string
+	schedule *Schedule
+	run      RunFunc
+}
+
+// Scheduler runs jobs on cron schedules in one location. It only fires the jobs, electing the replica
+// that runs a job is left to the RunFunc
+type Scheduler struct {
+	location *time.Location
+	jobs     []*job
+	wg       sync.WaitGroup
+}
+
+func New(location *time.Location) *Scheduler {
+	return &Scheduler{
+		location: location,
+	}
+}
+
+// Add registers a job on a cron expression, see Parse. An empty spec leaves the job out
+func (s *Scheduler) Add(name string, spec string, run RunFunc) error {
+	if spec == "" {
+		return nil
+	}
+
+	schedule, err := Parse(spec)
+	if err != nil {
+		return fmt.Errorf("job %s: %w", name, err)
+	}
+
+	s.jobs = append(s.jobs, &job{name: name, schedule: schedule, run: run})
+	return nil
+}
+
+// Start runs every job in its own goroutine until ctx is done. A job still running at its next time
+// skips that time, Wait returns once the running jobs are done
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, j := range s.jobs {
+		s.wg.Add(1)
+		go func(j *job) {
+			defer s.wg.Done()
+			s.loop(ctx, j)
+		}(j)
+	}
+}
+
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Scheduler) loop(ctx context.Context, j *job) {
+	for {
+		next := j.schedule.Next(time.Now().In(s.location))
+		if next.IsZero() {
+			return
+		}
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		j.run(ctx, next)
+	}
+}
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/repositories"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"gorm.io/gorm"
+)
+
+type AuditService interface {
+	ListAuditEvents(ctx context.Context, loanID string) ([]*entities.AuditEvent, error)
+	VerifyAuditTrail(ctx context.Context, loanID string) (*entities.AuditTrailVerification, error)
+}
+
+type auditService struct {
+	loanRepo  repositories.LoanRepository
+	auditRepo repositories.AuditEventRepository
+}
+
+func NewAuditService(loanRepo repositories.LoanRepository, auditRepo repositories.AuditEventRepository) AuditService {
+	return &auditService{
+		loanRepo:  loanRepo,
+		auditRepo: auditRepo,
+	}
+}
+
+func (s *auditService) ListAuditEvents(ctx context.Context, loanID string) ([]*entities.AuditEvent, error) {
+	err := s.checkLoan(ctx, loanID)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.auditRepo.GetAuditEventsByLoanID(ctx, loanID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return events, nil
+}
+
+func (s *auditService) VerifyAuditTrail(ctx context.Context, loanID string) (*entities.AuditTrailVerification, error) {
+	err := s.checkLoan(ctx, loanID)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.auditRepo.GetAuditEventsByLoanID(ctx, loanID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return verifyAuditChain(loanID, events)
+}
+
+func (s *auditService) checkLoan(ctx context.Context, loanID string) error {
+	_, err := s.loanRepo.GetLoanByID(ctx, loanID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errorhandler.NotFound(errorhandler.REASON_LOAN_NOT_FOUND, "loan not found")
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/verizhang/billing-engine/config"
+	"github.com/verizhang/billing-engine/src/entities"
+	mocks "github.com/verizhang/billing-engine/src/repositories/mocks"
+	"github.com/verizhang/billing-engine/src/services"
+	"github.com/verizhang/billing-engine/src/utils/auth"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"github.com/verizhang/billing-engine/src/utils/requestid"
+	"gorm.io/gorm"
+)
+
+// expectAuditEvents mocks appending to the audit chain of a loan or an entity without previous events
+func expectAuditEvents(uow *mocks.UnitOfWork, tx *gorm.DB) *mocks.AuditEventRepository {
+	auditRepo := new(mocks.AuditEventRepository)
+	uow.On("AuditEventRepository", tx).Return(auditRepo)
+	auditRepo.On("GetLastAuditEventByLoanID", mock.Anything, mock.Anything).Return(nil, gorm.ErrRecordNotFound).Maybe()
+	auditRepo.On("GetLastAuditEventByEntity", mock.Anything, mock.Anything, mock.Anything).Return(nil, gorm.ErrRecordNotFound).Maybe()
+	auditRepo.On("CreateAuditEvent", mock.Anything, mock.AnythingOfType("*entities.AuditEvent")).Return(nil)
+	return auditRepo
+}
+
+// auditChain is an in memory audit_events table
+type auditChain struct {
+	events []*entities.AuditEvent
+}
+
+func (c *auditChain) CreateAuditEvent(ctx context.Context, event *entities.AuditEvent) error {
+	stored := *event
+	c.events = append(c.events, &stored)
+	return nil
+}
+
+func (c *auditChain) GetLastAuditEventByLoanID(ctx context.Context, loanID string) (*entities.AuditEvent, error) {
+	if len(c.events) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return c.events[len(c.events)-1], nil
+}
+
+func (c *auditChain) GetLastAuditEventByEntity(ctx context.Context, entityType string, entityID string) (*entities.AuditEvent, error) {
+	for i := len(c.events) - 1; i >= 0; i-- {
+		if c.events[i].LoanID == nil && c.events[i].EntityType == entityType && c.events[i].EntityID == entityID {
+			return c.events[i], nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (c *auditChain) GetAuditEventsByLoanID(ctx context.Context, loanID string) ([]*entities.AuditEvent, error) {
+	return c.events, nil
+}
+
+func TestAuditService(t *testing.T) {
+	// writes off the same loan twice to chain two events
+	record := func(t *testing.T, ctx context.Context) *auditChain {
+		chain := &auditChain{}
+		uow := new(mocks.UnitOfWork)
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		writeOffRepo := new(mocks.LoanWriteOffRepository)
+		mockTx := &gorm.DB{}
+		endAt := time.Now().AddDate(0, 0, -200)
+
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(&entities.Loan{ID: "loan1", Status: entities.LOAN_STATUS_ACTIVE}, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return([]*entities.Payment{{ID: "payment1", Principal: 100, EndAt: &endAt}}, nil)
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		uow.On("AuditEventRepository", mockTx).Return(chain)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+		uow.On("LoanWriteOffRepository", mockTx).Return(writeOffRepo)
+		loanRepo.On("UpdateStatusLoanByID", mock.Anything, "loan1", entities.LOAN_STATUS_WRITTEN_OFF).Return(nil)
+		writeOffRepo.On("CreateLoanWriteOff", mock.Anything, mock.AnythingOfType("*entities.LoanWriteOff")).Return(nil)
+
+		service := services.NewWriteOffService(config.Config{}, uow, loanRepo, paymentRepo, nil, nil)
+		for i := 0; i < 2; i++ {
+			_, err := service.WriteOffLoan(ctx, "loan1")
+			assert.NoError(t, err)
+		}
+		return chain
+	}
+
+	verify := func(chain *auditChain) (*entities.AuditTrailVerification, error) {
+		loanRepo := new(mocks.LoanRepository)
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(&entities.Loan{ID: "loan1"}, nil)
+		return services.NewAuditService(loanRepo, chain).VerifyAuditTrail(context.Background(), "loan1")
+	}
+
+	t.Run("success records a linked event per change", func(t *testing.T) {
+		ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "ops1", Role: auth.ROLE_OPERATOR})
+		ctx = requestid.WithRequestID(ctx, "request1")
+		chain := record(t, ctx)
+
+		assert.Len(t, chain.events, 2)
+		first, second := chain.events[0], chain.events[1]
+		assert.Equal(t, int64(1), first.Sequence)
+		assert.Empty(t, first.PrevHash)
+		assert.Equal(t, int64(2), second.Sequence)
+		assert.Equal(t, first.Hash, second.PrevHash)
+		assert.Equal(t, "ops1", first.Actor)
+		assert.Equal(t, "request1", first.RequestID)
+		assert.Equal(t, entities.AUDIT_ACTION_LOAN_WRITTEN_OFF, first.Action)
+		assert.Equal(t, entities.AUDIT_ENTITY_LOAN_WRITE_OFF, first.EntityType)
+		assert.Nil(t, first.Before)
+		assert.Contains(t, *first.After, `"loan_id":"loan1"`)
+	})
+
+	t.Run("success verifies an intact chain", func(t *testing.T) {
+		result, err := verify(record(t, context.Background()))
+
+		assert.NoError(t, err)
+		assert.True(t, result.Valid)
+		assert.Equal(t, 2, result.Events)
+	})
+
+	t.Run("detects a changed event", func(t *testing.T) {
+		chain := record(t, context.Background())
+		tampered := `{"loan_id":"loan1","principal":0}`
+		chain.events[0].After = &tampered
+
+		result, err := verify(chain)
+
+		assert.NoError(t, err)
+		assert.False(t, result.Valid)
+		assert.Equal(t, int64(1), result.BrokenAtSequence)
+	})
+
+	t.Run("detects a rehashed event by the next link", func(t *testing.T) {
+		chain := record(t, context.Background())
+		chain.events[0].Actor = "someone"
+		chain.events[0].Hash = strings.Repeat("0", 64)
+
+		result, err := verify(chain)
+
+		assert.NoError(t, err)
+		assert.False(t, result.Valid)
+		assert.Equal(t, int64(1), result.BrokenAtSequence)
+	})
+
+	t.Run("detects a deleted event", func(t *testing.T) {
+		chain := record(t, context.Background())
+		chain.events = chain.events[1:]
+
+		result, err := verify(chain)
+
+		assert.NoError(t, err)
+		assert.False(t, result.Valid)
+		assert.Equal(t, int64(2), result.BrokenAtSequence)
+	})
+
+	t.Run("error when loan not found", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(nil, gorm.ErrRecordNotFound)
+
+		_, err := services.NewAuditService(loanRepo, &auditChain{}).ListAuditEvents(context.Background(), "loan1")
+
+		assert.Equal(t, errorhandler.NotFoundError, errors.Unwrap(err))
+	})
+}
@@ -0,0 +1,110 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/repositories"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"github.com/verizhang/billing-engine/src/utils/pagination"
+	"gorm.io/gorm"
+	"time"
+)
+
+// JobFunc is the work of a scheduled job, it returns how many items it processed
+type JobFunc func(ctx context.Context) (int, error)
+
+type JobService interface {
+	RunJob(ctx context.Context, job string, scheduledAt time.Time) (*entities.JobRun, error)
+	ListJobRuns(ctx context.Context, filter *entities.JobRunFilter, pageSize int, pageToken string) (*entities.JobRunPage, error)
+}
+
+type jobService struct {
+	jobRunRepo repositories.JobRunRepository
+	jobs       map[string]JobFunc
+	instance   string
+}
+
+func NewJobService(jobRunRepo repositories.JobRunRepository, jobs map[string]JobFunc, instance string) JobService {
+	return &jobService{
+		jobRunRepo: jobRunRepo,
+		jobs:       jobs,
+		instance:   instance,
+	}
+}
+
+// RunJob runs the job for its scheduled time and records the run. The replica taking the advisory lock of the job runs it,
+// the others get a nil run, as does a replica late for a scheduled time already run elsewhere.
+// A failed job is recorded as failed and its error returned with the run, a run left running by a stopped replica is failed first
+func (s *jobService) RunJob(ctx context.Context, job string, scheduledAt time.Time) (*entities.JobRun, error) {
+	work, ok := s.jobs[job]
+	if !ok {
+		return nil, errorhandler.InvalidField("job", fmt.Sprintf("unknown job %s", job))
+	}
+
+	var run *entities.JobRun
+	var jobErr error
+	// the job commits its own changes, only the lock is held for the length of the run
+	locked, err := s.jobRunRepo.WithJobLock(ctx, job, func() error {
+		now := time.Now()
+		err := s.jobRunRepo.FailRunningJobRuns(ctx, job, now, "interrupted, the replica running it stopped")
+		if err != nil {
+			return err
+		}
+
+		candidate := &entities.JobRun{
+			ID:          uuid.NewString(),
+			Job:         job,
+			ScheduledAt: scheduledAt,
+			StartedAt:   now,
+			Status:      entities.JOB_RUN_STATUS_RUNNING,
+			Instance:    s.instance,
+		}
+		err = s.jobRunRepo.CreateJobRun(ctx, candidate)
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		run = candidate
+
+		items, err := runJob(ctx, work)
+		s.finishRun(run, items, err)
+		jobErr = err
+
+		return s.jobRunRepo.UpdateJobRun(context.WithoutCancel(ctx), run)
+	})
+	if err != nil {
+		return run, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+	if !locked {
+		return nil, nil
+	}
+
+	return run, jobErr
+}
+
+func (s *jobService) ListJobRuns(ctx context.Context, filter *entities.JobRunFilter, pageSize int, pageToken string) (*entities.JobRunPage, error) {
+	cursor, err := pagination.DecodeCursor(pageToken)
+	if err != nil {
+		return nil, errorhandler.InvalidField("pageToken", err.Error())
+	}
+
+	pageSize = pagination.PageSize(pageSize)
+	runs, err := s.jobRunRepo.GetJobRuns(ctx, filter, cursor, pageSize+1)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	page := &entities.JobRunPage{Runs: runs}
+	if len(runs) > pageSize {
+		page.Runs = runs[:pageSize]
+		last := page.Runs[pageSize-1]
+		page.NextPageToken = pagination.EncodeCursor(last.StartedAt, last.ID)
+	}
+
+	return page, nil
+}
@@ -0,0 +1,153 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/verizhang/billing-engine/src/entities"
+	mocks "github.com/verizhang/billing-engine/src/repositories/mocks"
+	"github.com/verizhang/billing-engine/src/services"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"gorm.io/gorm"
+)
+
+func TestJobService_RunJob(t *testing.T) {
+	scheduledAt := time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC)
+
+	setup := func(locked bool) *mocks.JobRunRepository {
+		jobRunRepo := new(mocks.JobRunRepository)
+		jobRunRepo.On("WithJobLock", mock.Anything, entities.JOB_DELINQUENCY_SWEEP, mock.Anything).Return(func(ctx context.Context, job string, fn func() error) (bool, error) {
+			if !locked {
+				return false, nil
+			}
+			return true, fn()
+		})
+		jobRunRepo.On("FailRunningJobRuns", mock.Anything, entities.JOB_DELINQUENCY_SWEEP, mock.AnythingOfType("time.Time"), mock.Anything).Return(nil).Maybe()
+		return jobRunRepo
+	}
+
+	t.Run("success records the run with its items", func(t *testing.T) {
+		jobRunRepo := setup(true)
+		jobRunRepo.On("CreateJobRun", mock.Anything, mock.MatchedBy(func(run *entities.JobRun) bool {
+			return run.Job == entities.JOB_DELINQUENCY_SWEEP && run.ScheduledAt.Equal(scheduledAt) && run.Status == entities.JOB_RUN_STATUS_RUNNING && run.Instance == "replica1"
+		})).Return(nil)
+		jobRunRepo.On("UpdateJobRun", mock.Anything, mock.MatchedBy(func(run *entities.JobRun) bool {
+			return run.Status == entities.JOB_RUN_STATUS_SUCCEEDED && run.Items == 3 && run.FinishedAt != nil && run.Error == nil
+		})).Return(nil)
+
+		service := services.NewJobService(jobRunRepo, map[string]services.JobFunc{
+			entities.JOB_DELINQUENCY_SWEEP: func(ctx context.Context) (int, error) { return 3, nil },
+		}, "replica1")
+		run, err := service.RunJob(context.Background(), entities.JOB_DELINQUENCY_SWEEP, scheduledAt)
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.JOB_RUN_STATUS_SUCCEEDED, run.Status)
+		jobRunRepo.AssertCalled(t, "FailRunningJobRuns", mock.Anything, entities.JOB_DELINQUENCY_SWEEP, mock.Anything, mock.Anything)
+		jobRunRepo.AssertExpectations(t)
+	})
+
+	t.Run("records a failed or panicking job as failed", func(t *testing.T) {
+		jobs := map[string]services.JobFunc{
+			"error": func(ctx context.Context) (int, error) { return 1, errors.New("db error") },
+			"panic": func(ctx context.Context) (int, error) { panic("boom") },
+		}
+		for name, job := range jobs {
+			jobRunRepo := setup(true)
+			jobRunRepo.On("CreateJobRun", mock.Anything, mock.Anything).Return(nil)
+			jobRunRepo.On("UpdateJobRun", mock.Anything, mock.MatchedBy(func(run *entities.JobRun) bool {
+				return run.Status == entities.JOB_RUN_STATUS_FAILED && run.Error != nil
+			})).Return(nil)
+
+			service := services.NewJobService(jobRunRepo, map[string]services.JobFunc{entities.JOB_DELINQUENCY_SWEEP: job}, "replica1")
+			run, err := service.RunJob(context.Background(), entities.JOB_DELINQUENCY_SWEEP, scheduledAt)
+
+			assert.Error(t, err, name)
+			assert.Equal(t, entities.JOB_RUN_STATUS_FAILED, run.Status, name)
+			jobRunRepo.AssertExpectations(t)
+		}
+	})
+
+	t.Run("skips the run while another replica holds the lock", func(t *testing.T) {
+		jobRunRepo := setup(false)
+		ran := false
+
+		service := services.NewJobService(jobRunRepo, map[string]services.JobFunc{
+			entities.JOB_DELINQUENCY_SWEEP: func(ctx context.Context) (int, error) { ran = true; return 0, nil },
+		}, "replica1")
+		run, err := service.RunJob(context.Background(), entities.JOB_DELINQUENCY_SWEEP, scheduledAt)
+
+		assert.NoError(t, err)
+		assert.Nil(t, run)
+		assert.False(t, ran)
+		jobRunRepo.AssertNotCalled(t, "CreateJobRun", mock.Anything, mock.Anything)
+	})
+
+	t.Run("skips a scheduled time already run by another replica", func(t *testing.T) {
+		jobRunRepo := setup(true)
+		jobRunRepo.On("CreateJobRun", mock.Anything, mock.Anything).Return(gorm.ErrDuplicatedKey)
+		ran := false
+
+		service := services.NewJobService(jobRunRepo, map[string]services.JobFunc{
+			entities.JOB_DELINQUENCY_SWEEP: func(ctx context.Context) (int, error) { ran = true; return 0, nil },
+		}, "replica1")
+		run, err := service.RunJob(context.Background(), entities.JOB_DELINQUENCY_SWEEP, scheduledAt)
+
+		assert.NoError(t, err)
+		assert.Nil(t, run)
+		assert.False(t, ran)
+	})
+
+	t.Run("error when the run cannot be recorded", func(t *testing.T) {
+		jobRunRepo := setup(true)
+		jobRunRepo.On("CreateJobRun", mock.Anything, mock.Anything).Return(errors.New("connection reset"))
+
+		service := services.NewJobService(jobRunRepo, map[string]services.JobFunc{
+			entities.JOB_DELINQUENCY_SWEEP: func(ctx context.Context) (int, error) { return 0, nil },
+		}, "replica1")
+		run, err := service.RunJob(context.Background(), entities.JOB_DELINQUENCY_SWEEP, scheduledAt)
+
+		assert.ErrorIs(t, err, errorhandler.InternalServerError)
+		assert.Nil(t, run)
+	})
+
+	t.Run("error on unknown job", func(t *testing.T) {
+		service := services.NewJobService(nil, map[string]services.JobFunc{}, "replica1")
+		_, err := service.RunJob(context.Background(), "unknown", scheduledAt)
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+	})
+}
+
+func TestJobService_ListJobRuns(t *testing.T) {
+	t.Run("success returns a page with the next page token", func(t *testing.T) {
+		jobRunRepo := new(mocks.JobRunRepository)
+		now := time.Now()
+		runs := []*entities.JobRun{
+			{ID: "run3", StartedAt: now},
+			{ID: "run2", StartedAt: now.Add(-time.Hour)},
+			{ID: "run1", StartedAt: now.Add(-2 * time.Hour)},
+		}
+		filter := &entities.JobRunFilter{Job: entities.JOB_LATE_FEE_ACCRUAL}
+		jobRunRepo.On("GetJobRuns", mock.Anything, filter, mock.Anything, 3).Return(runs, nil)
+
+		service := services.NewJobService(jobRunRepo, nil, "replica1")
+		page, err := service.ListJobRuns(context.Background(), filter, 2, "")
+
+		assert.NoError(t, err)
+		assert.Len(t, page.Runs, 2)
+		assert.NotEmpty(t, page.NextPageToken)
+	})
+
+	t.Run("error on malformed page token", func(t *testing.T) {
+		service := services.NewJobService(new(mocks.JobRunRepository), nil, "replica1")
+		_, err := service.ListJobRuns(context.Background(), &entities.JobRunFilter{}, 10, "not-a-token")
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+	})
+}
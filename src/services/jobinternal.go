@@ -0,0 +1,33 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"time"
+)
+
+// runJob turns a panic of the job into a failed run instead of taking the process down
+func runJob(ctx context.Context, work JobFunc) (items int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: panic: %v", errorhandler.InternalServerError, r)
+		}
+	}()
+
+	return work(ctx)
+}
+
+func (s *jobService) finishRun(run *entities.JobRun, items int, err error) {
+	finishedAt := time.Now()
+	run.FinishedAt = &finishedAt
+	run.DurationMs = finishedAt.Sub(run.StartedAt).Milliseconds()
+	run.Items = items
+	run.Status = entities.JOB_RUN_STATUS_SUCCEEDED
+	if err != nil {
+		message := err.Error()
+		run.Status = entities.JOB_RUN_STATUS_FAILED
+		run.Error = &message
+	}
+}
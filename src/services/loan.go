@@ -2,117 +2,806 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/verizhang/billing-engine/config"
 	"github.com/verizhang/billing-engine/src/entities"
 	"github.com/verizhang/billing-engine/src/repositories"
 	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"github.com/verizhang/billing-engine/src/utils/pagination"
+	"gorm.io/gorm"
+	"log/slog"
 	"time"
 )
 
 type LoanService interface {
 	CreateLoan(ctx context.Context, userID string) error
+	QuoteLoan(ctx context.Context, amount float64, tenor int) (*entities.LoanQuote, error)
+	GetLoan(ctx context.Context, userID string, loanID string) (*entities.LoanDetail, error)
+	ListLoans(ctx context.Context, userID string, statuses []string, pageSize int, pageToken string) (*entities.LoanPage, error)
+	GetRepaymentSchedule(ctx context.Context, userID string, loanID string) (*entities.RepaymentSchedule, error)
 	GetOutstanding(ctx context.Context, userID string) (*entities.Outstanding, error)
+	GetOutstandingByLoanID(ctx context.Context, userID string, loanID string) (*entities.Outstanding, error)
 	IsDelinquent(ctx context.Context, userID string) (*entities.IsDelinquent, error)
+	IsDelinquentByLoanID(ctx context.Context, userID string, loanID string) (*entities.IsDelinquent, error)
+	RestructureLoan(ctx context.Context, userID string, loanID string, terms *entities.RestructureTerms) (*entities.PaymentSchedule, error)
+	DeferInstallments(ctx context.Context, userID string, loanID string, installments int) (*entities.PaymentDeferral, error)
+	TopUpLoan(ctx context.Context, userID string, loanID string, amount float64) (*entities.TopUp, error)
+	SweepDelinquentLoans(ctx context.Context) ([]*entities.Loan, error)
+	AccrueLateFees(ctx context.Context) ([]*entities.Payment, error)
 }
 
 type loanService struct {
-	uow         repositories.UnitOfWork
-	loanRepo    repositories.LoanRepository
-	paymentRepo repositories.PaymentRepository
+	cfg          config.Config
+	uow          repositories.UnitOfWork
+	loanRepo     repositories.LoanRepository
+	paymentRepo  repositories.PaymentRepository
+	scheduleRepo repositories.PaymentScheduleRepository
+	deferralRepo repositories.PaymentDeferralRepository
 }
 
-func NewLoanService(uow repositories.UnitOfWork, loanRepo repositories.LoanRepository, paymentRepo repositories.PaymentRepository) LoanService {
+func NewLoanService(cfg config.Config, uow repositories.UnitOfWork, loanRepo repositories.LoanRepository, paymentRepo repositories.PaymentRepository, scheduleRepo repositories.PaymentScheduleRepository, deferralRepo repositories.PaymentDeferralRepository) LoanService {
 	return &loanService{
-		uow:         uow,
-		loanRepo:    loanRepo,
-		paymentRepo: paymentRepo,
+		cfg:          cfg,
+		uow:          uow,
+		loanRepo:     loanRepo,
+		paymentRepo:  paymentRepo,
+		scheduleRepo: scheduleRepo,
+		deferralRepo: deferralRepo,
 	}
 }
 
 func (s *loanService) CreateLoan(ctx context.Context, userID string) error {
-	loans, err := s.loanRepo.GetActiveLoansByUserID(ctx, userID)
+	tx, err := s.uow.Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
 	}
 
-	if len(loans) > 0 {
-		return fmt.Errorf("%w: you already apply for loan", errorhandler.BadRequestError)
+	// the exposure is read under the lock of the user, a concurrent loan of the user waits for this one to commit
+	exposure, err := s.lockExposure(ctx, tx, userID, "")
+	if err != nil {
+		s.uow.Rollback(tx)
+		return err
 	}
 
-	tx, err := s.uow.Begin(ctx)
+	newExposure := entities.LOAN_AMOUNT + entities.LOAN_AMOUNT*entities.LOAN_INTEREST_RATE
+	if exposure+newExposure > s.cfg.LoanMaxExposure {
+		s.uow.Rollback(tx)
+		return errorhandler.BadRequest(errorhandler.REASON_EXPOSURE_LIMIT_EXCEEDED, "loan exceeds your maximum exposure")
+	}
+
+	now := time.Now()
+	loan, schedule, err := s.newLoan(userID, entities.LOAN_AMOUNT, now)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.createLoan(ctx, tx, loan, schedule, now)
 	if err != nil {
+		s.uow.Rollback(tx)
 		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
 	}
 
+	s.uow.Commit(tx)
+	return nil
+}
+
+func (s *loanService) QuoteLoan(ctx context.Context, amount float64, tenor int) (*entities.LoanQuote, error) {
+	if amount == 0 {
+		amount = entities.LOAN_AMOUNT
+	}
+	if tenor == 0 {
+		tenor = entities.PAYMENT_WEEKS
+	}
+	if amount < 0 {
+		return nil, errorhandler.InvalidField("amount", "must be positive")
+	}
+	if tenor < 0 {
+		return nil, errorhandler.InvalidField("tenor", "must be positive")
+	}
+
+	now := time.Now()
+	_, schedule, err := s.newLoan("", amount, now)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+	schedule.Tenor = tenor
+
+	payments := s.generatePayments(schedule, now)
+	disclosure, err := s.calculateDisclosure(schedule.Principal, payments, now)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	quote := &entities.LoanQuote{
+		Schedule:            schedule,
+		TotalInterest:       schedule.Interest,
+		TotalRepayable:      schedule.Principal + schedule.Interest,
+		APR:                 disclosure.APR,
+		EffectiveAnnualRate: disclosure.EffectiveAnnualRate,
+	}
+	for i, payment := range payments {
+		quote.Installments = append(quote.Installments, &entities.Installment{
+			Sequence: i + 1,
+			Payment:  payment,
+			DueAt:    payment.EndAt,
+			Status:   entities.INSTALLMENT_STATUS_UPCOMING,
+		})
+	}
+	quote.InstallmentAmount = quote.Installments[0].Payment.Amount
+
+	return quote, nil
+}
+
+func (s *loanService) GetLoan(ctx context.Context, userID string, loanID string) (*entities.LoanDetail, error) {
+	loan, err := s.getLoan(ctx, userID, loanID)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := s.scheduleRepo.GetCurrentPaymentScheduleByLoanID(ctx, loan.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	payments, err := s.paymentRepo.GetPaymentByLoanID(ctx, loan.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	detail := &entities.LoanDetail{
+		Loan:              loan,
+		Schedule:          schedule,
+		Outstanding:       s.sumOutstanding(loan, payments),
+		TotalInstallments: len(payments),
+	}
+	for _, payment := range payments {
+		if payment.PaidAt != nil {
+			detail.Paid = detail.Paid + payment.Amount
+			detail.PaidInstallments++
+		}
+	}
+
+	if len(payments) > 0 {
+		disclosure, err := s.calculateDisclosure(loan.Amount, payments, *loan.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+		detail.APR = disclosure.APR
+		detail.EffectiveAnnualRate = disclosure.EffectiveAnnualRate
+	}
+
+	return detail, nil
+}
+
+func (s *loanService) ListLoans(ctx context.Context, userID string, statuses []string, pageSize int, pageToken string) (*entities.LoanPage, error) {
+	for _, status := range statuses {
+		if !entities.IsValidLoanStatus(status) {
+			return nil, errorhandler.InvalidField("status", fmt.Sprintf("invalid loan status %s", status))
+		}
+	}
+
+	cursor, err := pagination.DecodeCursor(pageToken)
+	if err != nil {
+		return nil, errorhandler.InvalidField("pageToken", err.Error())
+	}
+
+	pageSize = pagination.PageSize(pageSize)
+	loans, err := s.loanRepo.GetLoansByUserID(ctx, userID, statuses, cursor, pageSize+1)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	page := &entities.LoanPage{Loans: loans}
+	if len(loans) > pageSize {
+		page.Loans = loans[:pageSize]
+		last := page.Loans[pageSize-1]
+		page.NextPageToken = pagination.EncodeCursor(*last.CreatedAt, last.ID)
+	}
+
+	return page, nil
+}
+
+func (s *loanService) GetRepaymentSchedule(ctx context.Context, userID string, loanID string) (*entities.RepaymentSchedule, error) {
+	loan, err := s.getLoan(ctx, userID, loanID)
+	if err != nil {
+		return nil, err
+	}
+
+	payments, err := s.paymentRepo.GetPaymentByLoanID(ctx, loan.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	schedule := &entities.RepaymentSchedule{LoanID: loan.ID}
+	now := time.Now()
+	for i, payment := range payments {
+		installment := s.toInstallment(i+1, payment, now)
+		schedule.Installments = append(schedule.Installments, installment)
+
+		switch installment.Status {
+		case entities.INSTALLMENT_STATUS_PAID:
+			schedule.PaidInstallments++
+		case entities.INSTALLMENT_STATUS_OVERDUE:
+			schedule.OverdueInstallments++
+		}
+		if schedule.NextDue == nil && installment.Status != entities.INSTALLMENT_STATUS_PAID {
+			schedule.NextDue = installment
+		}
+	}
+
+	return schedule, nil
+}
+
+func (s *loanService) GetOutstanding(ctx context.Context, userID string) (*entities.Outstanding, error) {
+	loans, err := s.getActiveLoans(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &entities.Outstanding{}
+	for _, loan := range loans {
+		outstanding, err := s.calculateOutstanding(ctx, loan)
+		if err != nil {
+			return nil, err
+		}
+		result.Outstanding = result.Outstanding + outstanding.Outstanding
+		result.Loans = append(result.Loans, outstanding)
+	}
+
+	return result, nil
+}
+
+func (s *loanService) GetOutstandingByLoanID(ctx context.Context, userID string, loanID string) (*entities.Outstanding, error) {
+	loan, err := s.getLoan(ctx, userID, loanID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.calculateOutstanding(ctx, loan)
+}
+
+func (s *loanService) IsDelinquent(ctx context.Context, userID string) (*entities.IsDelinquent, error) {
+	loans, err := s.getActiveLoans(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &entities.IsDelinquent{}
+	for _, loan := range loans {
+		isDelinquent, err := s.calculateIsDelinquent(ctx, loan)
+		if err != nil {
+			return nil, err
+		}
+		result.IsDelinquent = result.IsDelinquent || isDelinquent.IsDelinquent
+		result.Loans = append(result.Loans, isDelinquent)
+	}
+
+	return result, nil
+}
+
+func (s *loanService) IsDelinquentByLoanID(ctx context.Context, userID string, loanID string) (*entities.IsDelinquent, error) {
+	loan, err := s.getLoan(ctx, userID, loanID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.calculateIsDelinquent(ctx, loan)
+}
+
+func (s *loanService) RestructureLoan(ctx context.Context, userID string, loanID string, terms *entities.RestructureTerms) (*entities.PaymentSchedule, error) {
+	if terms.Tenor < 0 {
+		return nil, errorhandler.InvalidField("tenor", "must not be negative")
+	}
+	if terms.InterestRate != nil && *terms.InterestRate < 0 {
+		return nil, errorhandler.InvalidField("interestRate", "must not be negative")
+	}
+
+	loan, err := s.getLoan(ctx, userID, loanID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
 	loanRepo := s.uow.LoanRepository(tx)
 	paymentRepo := s.uow.PaymentRepository(tx)
+	scheduleRepo := s.uow.PaymentScheduleRepository(tx)
+
+	// the loan, its schedule and installments are read again under the lock of the user, a concurrent deferral,
+	// late fee or restructure of the loan waits for this one to commit
+	err = loanRepo.LockUserLoans(ctx, userID)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	loan, err = loanRepo.GetLoanByID(ctx, loan.ID)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	if !loan.IsActive {
+		s.uow.Rollback(tx)
+		return nil, errorhandler.BadRequest(errorhandler.REASON_LOAN_NOT_ACTIVE, "loan is not active")
+	}
+
+	currentSchedule, err := scheduleRepo.GetCurrentPaymentScheduleByLoanID(ctx, loan.ID)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	payments, err := paymentRepo.GetPaymentByLoanID(ctx, loan.ID)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
 
 	now := time.Now()
-	loanID, err := uuid.NewUUID()
+	restructured, principal, startAt := s.getRestructuredPayments(payments, terms.CapitaliseArrears, now)
+	if len(restructured) == 0 {
+		s.uow.Rollback(tx)
+		return nil, errorhandler.BadRequest(errorhandler.REASON_BAD_REQUEST, "no remaining installment to restructure")
+	}
+
+	tenor := terms.Tenor
+	if tenor == 0 {
+		tenor = len(restructured)
+	}
+
+	interestRate := currentSchedule.InterestRate
+	if terms.InterestRate != nil {
+		interestRate = *terms.InterestRate
+	}
+
+	scheduleID, err := uuid.NewUUID()
 	if err != nil {
-		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	schedule := &entities.PaymentSchedule{
+		ID:                 scheduleID.String(),
+		LoanID:             loan.ID,
+		Version:            currentSchedule.Version + 1,
+		PreviousScheduleID: &currentSchedule.ID,
+		Principal:          principal,
+		Interest:           principal * interestRate,
+		InterestRate:       interestRate,
+		Tenor:              tenor,
+		CreatedAt:          &now,
 	}
 
-	err = loanRepo.CreateLoan(ctx, &entities.Loan{
-		ID:        loanID.String(),
-		UserID:    userID,
-		Amount:    entities.LOAN_AMOUNT,
-		Interest:  entities.LOAN_AMOUNT * entities.LOAN_INTEREST_RATE,
-		IsActive:  true,
-		CreatedAt: &now,
-	})
+	// the loan interest absorbs the difference between the replaced installments and the new schedule,
+	// so the outstanding stays equal to the sum of the unpaid installments
+	replacedAmount := float64(0)
+	var restructuredIDs []string
+	for _, payment := range restructured {
+		replacedAmount = replacedAmount + payment.Amount
+		restructuredIDs = append(restructuredIDs, payment.ID)
+	}
+	interest := loan.Interest + schedule.Principal + schedule.Interest - replacedAmount
+
+	err = scheduleRepo.CreatePaymentSchedule(ctx, schedule)
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		s.uow.Rollback(tx)
+		return nil, errorhandler.BadRequest(errorhandler.REASON_LOAN_CHANGED, "the loan was restructured meanwhile, please retry")
+	}
 	if err != nil {
 		s.uow.Rollback(tx)
-		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
 	}
 
-	err = paymentRepo.CreatePayments(ctx, s.generatePayments(loanID.String(), now))
+	// the replaced installments link to the schedule version replacing them, created first for the reference
+	deleted, err := paymentRepo.ReplacePayments(ctx, restructuredIDs, schedule.ID, &now)
 	if err != nil {
 		s.uow.Rollback(tx)
-		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+	if deleted != len(restructuredIDs) {
+		s.uow.Rollback(tx)
+		return nil, errorhandler.BadRequest(errorhandler.REASON_LOAN_CHANGED, "an installment was paid meanwhile, please retry")
 	}
 
-	s.uow.Commit(tx)
-	return nil
+	// the transactions paying a cancelled installment no longer count towards the loan
+	err = s.uow.PaymentTransactionRepository(tx).ReversePaymentTransactionsByPaymentIDs(ctx, restructuredIDs, &now)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = paymentRepo.CreatePayments(ctx, s.generatePayments(schedule, startAt))
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = loanRepo.UpdateInterestLoanByID(ctx, loan.ID, interest)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = recordAuditEvent(ctx, s.uow, tx, &entities.AuditEvent{
+		LoanID:     &loan.ID,
+		Action:     entities.AUDIT_ACTION_LOAN_RESTRUCTURED,
+		EntityType: entities.AUDIT_ENTITY_PAYMENT_SCHEDULE,
+		EntityID:   schedule.ID,
+	}, currentSchedule, schedule)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.uow.Commit(tx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return schedule, nil
 }
 
-func (s *loanService) GetOutstanding(ctx context.Context, userID string) (*entities.Outstanding, error) {
-	loan, err := s.getActiveLoan(ctx, userID)
+func (s *loanService) DeferInstallments(ctx context.Context, userID string, loanID string, installments int) (*entities.PaymentDeferral, error) {
+	if installments <= 0 {
+		return nil, errorhandler.InvalidField("installments", "must be greater than zero")
+	}
+
+	loan, err := s.getLoan(ctx, userID, loanID)
 	if err != nil {
 		return nil, err
 	}
 
-	outstanding := loan.Amount + loan.Interest
+	if !loan.IsActive {
+		return nil, errorhandler.BadRequest(errorhandler.REASON_LOAN_NOT_ACTIVE, "loan is not active")
+	}
 
-	payments, err := s.paymentRepo.GetPaymentByLoanID(ctx, loan.ID)
+	policy, ok := s.cfg.DeferralPolicies[loan.Product]
+	if !ok {
+		return nil, errorhandler.BadRequest(errorhandler.REASON_DEFERRAL_NOT_AVAILABLE, "payment deferral is not available for this loan")
+	}
+
+	if installments > policy.MaxInstallments {
+		return nil, errorhandler.InvalidField("installments", fmt.Sprintf("at most %d installments can be deferred at once", policy.MaxInstallments))
+	}
+
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	loanRepo := s.uow.LoanRepository(tx)
+	paymentRepo := s.uow.PaymentRepository(tx)
+	deferralRepo := s.uow.PaymentDeferralRepository(tx)
+
+	// the deferrals are counted under the lock of the user, a concurrent deferral of the loan waits for this one to commit
+	err = loanRepo.LockUserLoans(ctx, userID)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	deferrals, err := deferralRepo.GetPaymentDeferralsByLoanID(ctx, loan.ID)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	if len(deferrals) >= policy.MaxDeferrals {
+		s.uow.Rollback(tx)
+		return nil, errorhandler.BadRequest(errorhandler.REASON_DEFERRAL_LIMIT_REACHED, "maximum number of deferrals has been reached")
+	}
+
+	payments, err := paymentRepo.GetPaymentByLoanID(ctx, loan.ID)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	if !policy.AllowWhileDelinquent && s.compareDelinquent(payments) {
+		s.uow.Rollback(tx)
+		return nil, errorhandler.BadRequest(errorhandler.REASON_LOAN_DELINQUENT, "payment deferral is not allowed while the loan is delinquent")
+	}
+
+	now := time.Now()
+	deferred, later := s.getDeferredPayments(payments, installments, now)
+	if len(deferred) < installments {
+		s.uow.Rollback(tx)
+		return nil, errorhandler.InvalidField("installments", "not enough upcoming installments to defer")
+	}
+
+	deferralID, err := uuid.NewUUID()
 	if err != nil {
+		s.uow.Rollback(tx)
 		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
 	}
 
+	startAt := *deferred[0].StartAt
+	endAt := startAt.AddDate(0, 0, 7*installments).Add(-time.Nanosecond)
+	interest := float64(0)
+	for _, payment := range deferred {
+		interest = interest + payment.Principal*policy.InterestRate
+	}
+
+	deferral := &entities.PaymentDeferral{
+		ID:           deferralID.String(),
+		LoanID:       loan.ID,
+		Installments: installments,
+		StartAt:      &startAt,
+		EndAt:        &endAt,
+		Fee:          policy.Fee,
+		Interest:     interest,
+		CreatedAt:    &now,
+	}
+
+	s.deferPayments(deferral, deferred, later)
+
+	err = deferralRepo.CreatePaymentDeferral(ctx, deferral)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = paymentRepo.UpdatePayments(ctx, append(append([]*entities.Payment{}, deferred...), later...))
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	if deferral.Interest > 0 {
+		err = loanRepo.AddInterestLoanByID(ctx, loan.ID, deferral.Interest)
+		if err != nil {
+			s.uow.Rollback(tx)
+			return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+	}
+
+	if deferral.Fee > 0 {
+		err = loanRepo.AddFeeLoanByID(ctx, loan.ID, deferral.Fee)
+		if err != nil {
+			s.uow.Rollback(tx)
+			return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+	}
+
+	err = recordAuditEvent(ctx, s.uow, tx, &entities.AuditEvent{
+		LoanID:     &loan.ID,
+		Action:     entities.AUDIT_ACTION_INSTALLMENTS_DEFERRED,
+		EntityType: entities.AUDIT_ENTITY_PAYMENT_DEFERRAL,
+		EntityID:   deferral.ID,
+	}, nil, deferral)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.uow.Commit(tx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return deferral, nil
+}
+
+func (s *loanService) TopUpLoan(ctx context.Context, userID string, loanID string, amount float64) (*entities.TopUp, error) {
+	if amount <= 0 {
+		return nil, errorhandler.InvalidField("amount", "must be greater than zero")
+	}
+
+	loan, err := s.getLoan(ctx, userID, loanID)
+	if err != nil {
+		return nil, err
+	}
+
+	if loan.Status != entities.LOAN_STATUS_ACTIVE {
+		return nil, errorhandler.BadRequest(errorhandler.REASON_LOAN_NOT_ACTIVE, "only an active loan can be topped up")
+	}
+
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	// the exposure is read under the lock of the user, a concurrent loan or top up of the user waits for this one to commit
+	exposure, err := s.lockExposure(ctx, tx, userID, loan.ID)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, err
+	}
+
+	payments, err := s.uow.PaymentRepository(tx).GetPaymentByLoanID(ctx, loan.ID)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	if s.compareDelinquent(payments) {
+		s.uow.Rollback(tx)
+		return nil, errorhandler.BadRequest(errorhandler.REASON_LOAN_DELINQUENT, "a delinquent loan can not be topped up")
+	}
+
+	now := time.Now()
+
+	// the old loan is paid off at its remaining principal, the interest of the installments not due yet is not earned
+	// while an overdue installment is owed in full with its interest and fees
+	payoff := float64(0)
+	var unpaidIDs []string
 	for _, payment := range payments {
 		if payment.PaidAt != nil {
-			outstanding = outstanding - payment.Amount
+			continue
+		}
+		if payment.EndAt.Before(now) {
+			payoff = payoff + payment.Amount
+		} else {
+			payoff = payoff + payment.Principal
 		}
+		unpaidIDs = append(unpaidIDs, payment.ID)
+	}
+
+	principal := payoff + amount
+	if exposure+principal+principal*entities.LOAN_INTEREST_RATE > s.cfg.LoanMaxExposure {
+		s.uow.Rollback(tx)
+		return nil, errorhandler.BadRequest(errorhandler.REASON_EXPOSURE_LIMIT_EXCEEDED, "loan exceeds your maximum exposure")
 	}
 
-	return &entities.Outstanding{
-		Outstanding: outstanding,
+	newLoan, schedule, err := s.newLoan(userID, principal, now)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.createLoan(ctx, tx, newLoan, schedule, now)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	// the remaining installments of the old loan are settled by the new loan principal
+	// a payment committed since the read leaves fewer rows to update, the payoff is stale then
+	if len(unpaidIDs) > 0 {
+		deleted, err := s.uow.PaymentRepository(tx).SoftDeletePayments(ctx, unpaidIDs, &now)
+		if err != nil {
+			s.uow.Rollback(tx)
+			return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+		if deleted != len(unpaidIDs) {
+			s.uow.Rollback(tx)
+			return nil, errorhandler.BadRequest(errorhandler.REASON_LOAN_CHANGED, "an installment was paid meanwhile, please retry")
+		}
+
+		err = s.uow.PaymentTransactionRepository(tx).ReversePaymentTransactionsByPaymentIDs(ctx, unpaidIDs, &now)
+		if err != nil {
+			s.uow.Rollback(tx)
+			return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+	}
+
+	ok, err := s.uow.LoanRepository(tx).UpdateRefinancedLoanByID(ctx, loan.ID, newLoan.ID)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+	if !ok {
+		s.uow.Rollback(tx)
+		return nil, errorhandler.BadRequest(errorhandler.REASON_LOAN_NOT_ACTIVE, "only an active loan can be topped up")
+	}
+
+	refinanced := *loan
+	refinanced.Status = entities.LOAN_STATUS_REFINANCED
+	refinanced.IsActive = false
+	refinanced.RefinancedByLoanID = &newLoan.ID
+	err = recordAuditEvent(ctx, s.uow, tx, &entities.AuditEvent{
+		LoanID:     &loan.ID,
+		Action:     entities.AUDIT_ACTION_LOAN_REFINANCED,
+		EntityType: entities.AUDIT_ENTITY_LOAN,
+		EntityID:   loan.ID,
+	}, loan, &refinanced)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.uow.Commit(tx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return &entities.TopUp{
+		Loan:            newLoan,
+		Schedule:        schedule,
+		RefinancedLoan:  loan,
+		PayoffAmount:    payoff,
+		NetDisbursement: amount,
 	}, nil
 }
 
-func (s *loanService) IsDelinquent(ctx context.Context, userID string) (*entities.IsDelinquent, error) {
-	loan, err := s.getActiveLoan(ctx, userID)
+// SweepDelinquentLoans marks the loans that turned delinquent since the last sweep and publishes LoanBecameDelinquent for each,
+// loans back on schedule are unmarked so a later delinquency is published again. A loan that fails is logged and does not hold back
+// the others, the failures are returned together. It returns the newly delinquent loans
+func (s *loanService) SweepDelinquentLoans(ctx context.Context) ([]*entities.Loan, error) {
+	now := time.Now()
+
+	loans, err := s.loanRepo.GetPastDueLoans(ctx, now.AddDate(0, 0, -entities.LOAN_DELINQUENT_DAYS))
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
 	}
 
-	payments, err := s.paymentRepo.GetPaymentByLoanID(ctx, loan.ID)
+	var delinquent []*entities.Loan
+	var failures []error
+	for _, loan := range loans {
+		if loan.DelinquentAt != nil {
+			continue
+		}
+
+		err = s.markDelinquent(ctx, loan, now)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to mark the loan delinquent", "loan_id", loan.ID, "error", err)
+			failures = append(failures, fmt.Errorf("loan %s: %w", loan.ID, err))
+			continue
+		}
+		delinquent = append(delinquent, loan)
+	}
+
+	marked, err := s.loanRepo.GetDelinquentLoans(ctx)
+	if err != nil {
+		failures = append(failures, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error()))
+		return delinquent, errors.Join(failures...)
+	}
+
+	for _, loan := range marked {
+		payments, err := s.paymentRepo.GetPaymentByLoanID(ctx, loan.ID)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to read the installments of the delinquent loan", "loan_id", loan.ID, "error", err)
+			failures = append(failures, fmt.Errorf("loan %s: %w: %s", loan.ID, errorhandler.InternalServerError, err.Error()))
+			continue
+		}
+		if s.compareDelinquent(payments) {
+			continue
+		}
+
+		err = s.clearDelinquent(ctx, loan)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to clear the delinquency of the loan", "loan_id", loan.ID, "error", err)
+			failures = append(failures, fmt.Errorf("loan %s: %w", loan.ID, err))
+		}
+	}
+
+	return delinquent, errors.Join(failures...)
+}
+
+// AccrueLateFees charges LATE_FEE_AMOUNT once on every installment unpaid LATE_FEE_GRACE_DAYS after its end and publishes
+// LateFeeCharged for each. The fee is added to the installment and the loan like a deferral fee. An installment that fails is logged
+// and does not hold back the others, the failures are returned together. It returns the charged installments
+func (s *loanService) AccrueLateFees(ctx context.Context) ([]*entities.Payment, error) {
+	if s.cfg.LateFeeAmount <= 0 {
+		return nil, nil
+	}
+
+	now := time.Now()
+	payments, err := s.paymentRepo.GetLateFeeDuePayments(ctx, now.AddDate(0, 0, -s.cfg.LateFeeGraceDays))
 	if err != nil {
 		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
 	}
 
-	isDelinquent := s.compareDelinquent(payments)
+	var charged []*entities.Payment
+	var failures []error
+	for _, payment := range payments {
+		ok, err := s.chargeLateFee(ctx, payment, s.cfg.LateFeeAmount, now)
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to charge the late fee", "payment_id", payment.ID, "error", err)
+			failures = append(failures, fmt.Errorf("payment %s: %w", payment.ID, err))
+			continue
+		}
+		if ok {
+			charged = append(charged, payment)
+		}
+	}
 
-	return &entities.IsDelinquent{IsDelinquent: isDelinquent}, nil
+	return charged, errors.Join(failures...)
 }
@@ -3,25 +3,46 @@ package services_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/verizhang/billing-engine/config"
 	"github.com/verizhang/billing-engine/src/entities"
 	mocks "github.com/verizhang/billing-engine/src/repositories/mocks"
 	"github.com/verizhang/billing-engine/src/services"
 	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"github.com/verizhang/billing-engine/src/utils/pagination"
+	"github.com/verizhang/billing-engine/src/utils/paymentref"
 	"gorm.io/gorm"
 )
 
+// loanConfig is the configuration of the tests creating loans, with the default payment reference format
+var loanConfig = config.Config{
+	LoanMaxExposure:            15000000,
+	PaymentReferencePrefix:     "8808",
+	PaymentReferenceLength:     16,
+	PaymentReferenceCheckDigit: paymentref.CHECK_DIGIT_LUHN,
+}
+
+// expectTransactionReversals mocks reversing the transactions of the installments a change cancels
+func expectTransactionReversals(uow *mocks.UnitOfWork, tx *gorm.DB) *mocks.PaymentTransactionRepository {
+	txRepo := new(mocks.PaymentTransactionRepository)
+	uow.On("PaymentTransactionRepository", tx).Return(txRepo).Maybe()
+	txRepo.On("ReversePaymentTransactionsByPaymentIDs", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	return txRepo
+}
+
 func TestLoanService_CreateLoan(t *testing.T) {
 	// Helper function to create service with mocks
 	createService := func(uow *mocks.UnitOfWork, loanRepo *mocks.LoanRepository, paymentRepo *mocks.PaymentRepository) services.LoanService {
 		if uow == nil {
 			uow = new(mocks.UnitOfWork)
 		}
-		return services.NewLoanService(uow, loanRepo, paymentRepo)
+		return services.NewLoanService(loanConfig, uow, loanRepo, paymentRepo, nil, nil)
 	}
 
 	t.Run("success create loan", func(t *testing.T) {
@@ -36,14 +57,21 @@ func TestLoanService_CreateLoan(t *testing.T) {
 		// Mock expectations
 		uow.On("Begin", mock.Anything).Return(mockTx, nil)
 		uow.On("Commit", mockTx).Return(nil)
+		expectAuditEvents(uow, mockTx)
+		expectOutboxEvents(uow, mockTx)
 		loanRepo.On("GetActiveLoansByUserID", mock.Anything, "user1").Return([]*entities.Loan{}, nil)
+		loanRepo.On("LockUserLoans", mock.Anything, "user1").Return(nil)
 
 		// Mock repository creation within UoW
+		scheduleRepo := new(mocks.PaymentScheduleRepository)
 		uow.On("LoanRepository", mockTx).Return(loanRepo)
 		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		uow.On("PaymentScheduleRepository", mockTx).Return(scheduleRepo)
 
 		// Mock repository calls
+		loanRepo.On("GetLoanByPaymentReference", mock.Anything, mock.Anything).Return(nil, gorm.ErrRecordNotFound)
 		loanRepo.On("CreateLoan", mock.Anything, mock.AnythingOfType("*entities.Loan")).Return(nil)
+		scheduleRepo.On("CreatePaymentSchedule", mock.Anything, mock.AnythingOfType("*entities.PaymentSchedule")).Return(nil)
 		paymentRepo.On("CreatePayments", mock.Anything, mock.AnythingOfType("[]*entities.Payment")).Return(nil)
 
 		// Execute
@@ -55,6 +83,40 @@ func TestLoanService_CreateLoan(t *testing.T) {
 		uow.AssertExpectations(t)
 		loanRepo.AssertExpectations(t)
 		paymentRepo.AssertExpectations(t)
+
+		format := paymentref.Format{Prefix: "8808", Length: 16, CheckDigit: paymentref.CHECK_DIGIT_LUHN}
+		loanRepo.AssertCalled(t, "CreateLoan", mock.Anything, mock.MatchedBy(func(loan *entities.Loan) bool {
+			return loan.PaymentReference != nil && format.Valid(*loan.PaymentReference)
+		}))
+	})
+
+	t.Run("success draw another payment reference when taken", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		scheduleRepo := new(mocks.PaymentScheduleRepository)
+		mockTx := &gorm.DB{}
+
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		expectAuditEvents(uow, mockTx)
+		expectOutboxEvents(uow, mockTx)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		uow.On("PaymentScheduleRepository", mockTx).Return(scheduleRepo)
+		loanRepo.On("GetActiveLoansByUserID", mock.Anything, "user1").Return([]*entities.Loan{}, nil)
+		loanRepo.On("LockUserLoans", mock.Anything, "user1").Return(nil)
+		loanRepo.On("GetLoanByPaymentReference", mock.Anything, mock.Anything).Return(&entities.Loan{ID: "loan0"}, nil).Once()
+		loanRepo.On("GetLoanByPaymentReference", mock.Anything, mock.Anything).Return(nil, gorm.ErrRecordNotFound).Once()
+		loanRepo.On("CreateLoan", mock.Anything, mock.Anything).Return(nil)
+		scheduleRepo.On("CreatePaymentSchedule", mock.Anything, mock.Anything).Return(nil)
+		paymentRepo.On("CreatePayments", mock.Anything, mock.Anything).Return(nil)
+
+		service := createService(uow, loanRepo, paymentRepo)
+		err := service.CreateLoan(context.Background(), "user1")
+
+		assert.NoError(t, err)
+		loanRepo.AssertNumberOfCalls(t, "GetLoanByPaymentReference", 2)
 	})
 
 	t.Run("error when begin transaction fails", func(t *testing.T) {
@@ -62,6 +124,7 @@ func TestLoanService_CreateLoan(t *testing.T) {
 		loanRepo := new(mocks.LoanRepository)
 
 		loanRepo.On("GetActiveLoansByUserID", mock.Anything, "user1").Return([]*entities.Loan{}, nil)
+		loanRepo.On("LockUserLoans", mock.Anything, "user1").Return(nil)
 		uow.On("Begin", mock.Anything).Return(nil, errors.New("transaction error"))
 
 		service := createService(uow, loanRepo, nil)
@@ -83,8 +146,11 @@ func TestLoanService_CreateLoan(t *testing.T) {
 		// Mock repository creation within UoW
 		uow.On("LoanRepository", mockTx).Return(loanRepo)
 		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		uow.On("PaymentScheduleRepository", mockTx).Return(new(mocks.PaymentScheduleRepository))
 
 		loanRepo.On("GetActiveLoansByUserID", mock.Anything, "user1").Return([]*entities.Loan{}, nil)
+		loanRepo.On("LockUserLoans", mock.Anything, "user1").Return(nil)
+		loanRepo.On("GetLoanByPaymentReference", mock.Anything, mock.Anything).Return(nil, gorm.ErrRecordNotFound)
 		loanRepo.On("CreateLoan", mock.Anything, mock.Anything).Return(errors.New("create error"))
 
 		service := createService(uow, loanRepo, paymentRepo)
@@ -98,14 +164,19 @@ func TestLoanService_CreateLoan(t *testing.T) {
 		uow := new(mocks.UnitOfWork)
 		loanRepo := new(mocks.LoanRepository)
 		paymentRepo := new(mocks.PaymentRepository)
+		scheduleRepo := new(mocks.PaymentScheduleRepository)
 		mockTx := &gorm.DB{}
 
 		uow.On("Begin", mock.Anything).Return(mockTx, nil)
 		uow.On("Rollback", mockTx).Return(nil)
 		uow.On("LoanRepository", mockTx).Return(loanRepo)
 		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		uow.On("PaymentScheduleRepository", mockTx).Return(scheduleRepo)
 		loanRepo.On("GetActiveLoansByUserID", mock.Anything, "user1").Return([]*entities.Loan{}, nil)
+		loanRepo.On("LockUserLoans", mock.Anything, "user1").Return(nil)
+		loanRepo.On("GetLoanByPaymentReference", mock.Anything, mock.Anything).Return(nil, gorm.ErrRecordNotFound)
 		loanRepo.On("CreateLoan", mock.Anything, mock.Anything).Return(nil)
+		scheduleRepo.On("CreatePaymentSchedule", mock.Anything, mock.Anything).Return(nil)
 		paymentRepo.On("CreatePayments", mock.Anything, mock.Anything).Return(errors.New("payment error"))
 
 		service := createService(uow, loanRepo, paymentRepo)
@@ -114,11 +185,39 @@ func TestLoanService_CreateLoan(t *testing.T) {
 		assert.Error(t, err)
 		uow.AssertCalled(t, "Rollback", mockTx)
 	})
+
+	t.Run("error when loan exceeds maximum exposure", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		mockTx := &gorm.DB{}
+
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Rollback", mockTx).Return(nil)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+
+		loans := []*entities.Loan{
+			{ID: "loan1", Amount: 5000000, Interest: 500000},
+			{ID: "loan2", Amount: 5000000, Interest: 500000},
+		}
+		loanRepo.On("GetActiveLoansByUserID", mock.Anything, "user1").Return(loans, nil)
+		loanRepo.On("LockUserLoans", mock.Anything, "user1").Return(nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, mock.Anything).Return([]*entities.Payment{}, nil)
+
+		service := createService(uow, loanRepo, paymentRepo)
+		err := service.CreateLoan(context.Background(), "user1")
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+		loanRepo.AssertCalled(t, "LockUserLoans", mock.Anything, "user1")
+		uow.AssertCalled(t, "Rollback", mockTx)
+	})
 }
 
 func TestLoanService_GetOutstanding(t *testing.T) {
 	createService := func(loanRepo *mocks.LoanRepository, paymentRepo *mocks.PaymentRepository) services.LoanService {
-		return services.NewLoanService(nil, loanRepo, paymentRepo)
+		return services.NewLoanService(config.Config{}, nil, loanRepo, paymentRepo, nil, nil)
 	}
 
 	t.Run("success with no payments", func(t *testing.T) {
@@ -155,11 +254,97 @@ func TestLoanService_GetOutstanding(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, errorhandler.InternalServerError, errors.Unwrap(err))
 	})
+
+	t.Run("success sum across multiple loans", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+
+		now := time.Now()
+		loans := []*entities.Loan{
+			{ID: "loan1", Amount: 1000, Interest: 100},
+			{ID: "loan2", Amount: 2000, Interest: 200},
+		}
+		loanRepo.On("GetActiveLoansByUserID", mock.Anything, "user1").Return(loans, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return([]*entities.Payment{{Amount: 100, PaidAt: &now}}, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan2").Return([]*entities.Payment{}, nil)
+
+		service := createService(loanRepo, paymentRepo)
+		result, err := service.GetOutstanding(context.Background(), "user1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(3200), result.Outstanding)
+		assert.Len(t, result.Loans, 2)
+		assert.Equal(t, float64(1000), result.Loans[0].Outstanding)
+	})
+}
+
+func TestLoanService_GetOutstandingByLoanID(t *testing.T) {
+	createService := func(loanRepo *mocks.LoanRepository, paymentRepo *mocks.PaymentRepository) services.LoanService {
+		return services.NewLoanService(config.Config{}, nil, loanRepo, paymentRepo, nil, nil)
+	}
+
+	t.Run("success", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+
+		loan := &entities.Loan{ID: "loan2", UserID: "user1", Amount: 2000, Interest: 200}
+		loanRepo.On("GetLoanByID", mock.Anything, "loan2").Return(loan, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan2").Return([]*entities.Payment{}, nil)
+
+		service := createService(loanRepo, paymentRepo)
+		result, err := service.GetOutstandingByLoanID(context.Background(), "user1", "loan2")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "loan2", result.LoanID)
+		assert.Equal(t, float64(2200), result.Outstanding)
+	})
+
+	t.Run("success nothing outstanding on a refinanced loan", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+
+		now := time.Now()
+		loan := &entities.Loan{ID: "loan2", UserID: "user1", Amount: 2000, Interest: 200, Status: entities.LOAN_STATUS_REFINANCED}
+		loanRepo.On("GetLoanByID", mock.Anything, "loan2").Return(loan, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan2").Return([]*entities.Payment{
+			{ID: "payment1", Amount: 1100, PaidAt: &now},
+		}, nil)
+
+		service := createService(loanRepo, paymentRepo)
+		result, err := service.GetOutstandingByLoanID(context.Background(), "user1", "loan2")
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(0), result.Outstanding)
+	})
+
+	t.Run("error when loan belongs to another user", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+
+		loan := &entities.Loan{ID: "loan2", UserID: "user2"}
+		loanRepo.On("GetLoanByID", mock.Anything, "loan2").Return(loan, nil)
+
+		service := createService(loanRepo, nil)
+		_, err := service.GetOutstandingByLoanID(context.Background(), "user1", "loan2")
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.NotFoundError, errors.Unwrap(err))
+	})
+
+	t.Run("error when loan not found", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		loanRepo.On("GetLoanByID", mock.Anything, "loan2").Return(nil, gorm.ErrRecordNotFound)
+
+		service := createService(loanRepo, nil)
+		_, err := service.GetOutstandingByLoanID(context.Background(), "user1", "loan2")
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.NotFoundError, errors.Unwrap(err))
+	})
 }
 
 func TestLoanService_IsDelinquent(t *testing.T) {
 	createService := func(loanRepo *mocks.LoanRepository, paymentRepo *mocks.PaymentRepository) services.LoanService {
-		return services.NewLoanService(nil, loanRepo, paymentRepo)
+		return services.NewLoanService(config.Config{}, nil, loanRepo, paymentRepo, nil, nil)
 	}
 
 	t.Run("delinquent when payment overdue", func(t *testing.T) {
@@ -181,4 +366,944 @@ func TestLoanService_IsDelinquent(t *testing.T) {
 		assert.NoError(t, err)
 		assert.True(t, result.IsDelinquent)
 	})
+
+	t.Run("not delinquent when earlier installments are paid", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+
+		loan := &entities.Loan{ID: "loan1"}
+		paidEndAt := time.Now().AddDate(0, 0, -30)
+		paidAt := time.Now().AddDate(0, 0, -31)
+		nextEndAt := time.Now().AddDate(0, 0, 3)
+		payments := []*entities.Payment{
+			{PaidAt: &paidAt, EndAt: &paidEndAt},
+			{PaidAt: &paidAt, EndAt: &paidEndAt},
+			{PaidAt: nil, EndAt: &nextEndAt},
+		}
+
+		loanRepo.On("GetActiveLoansByUserID", mock.Anything, "user1").Return([]*entities.Loan{loan}, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(payments, nil)
+
+		service := createService(loanRepo, paymentRepo)
+		result, err := service.IsDelinquent(context.Background(), "user1")
+
+		assert.NoError(t, err)
+		assert.False(t, result.IsDelinquent)
+	})
+}
+
+func TestLoanService_RestructureLoan(t *testing.T) {
+	createService := func(uow *mocks.UnitOfWork, loanRepo *mocks.LoanRepository, paymentRepo *mocks.PaymentRepository, scheduleRepo *mocks.PaymentScheduleRepository) services.LoanService {
+		return services.NewLoanService(config.Config{}, uow, loanRepo, paymentRepo, scheduleRepo, nil)
+	}
+
+	newPayments := func(now time.Time) []*entities.Payment {
+		paidStartAt := now.AddDate(0, 0, -21)
+		paidEndAt := now.AddDate(0, 0, -14)
+		overdueStartAt := now.AddDate(0, 0, -14)
+		overdueEndAt := now.AddDate(0, 0, -7)
+		currentStartAt := now.AddDate(0, 0, -1)
+		currentEndAt := now.AddDate(0, 0, 6)
+		nextStartAt := now.AddDate(0, 0, 6)
+		nextEndAt := now.AddDate(0, 0, 13)
+		return []*entities.Payment{
+			{ID: "payment1", Amount: 110, Principal: 100, Interest: 10, StartAt: &paidStartAt, EndAt: &paidEndAt, PaidAt: &paidEndAt},
+			{ID: "payment2", Amount: 110, Principal: 100, Interest: 10, StartAt: &overdueStartAt, EndAt: &overdueEndAt},
+			{ID: "payment3", Amount: 110, Principal: 100, Interest: 10, StartAt: &currentStartAt, EndAt: &currentEndAt},
+			{ID: "payment4", Amount: 110, Principal: 100, Interest: 10, StartAt: &nextStartAt, EndAt: &nextEndAt},
+		}
+	}
+
+	t.Run("success restructure remaining installments", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		scheduleRepo := new(mocks.PaymentScheduleRepository)
+		mockTx := &gorm.DB{}
+
+		now := time.Now()
+		loan := &entities.Loan{ID: "loan1", UserID: "user1", Amount: 400, Interest: 40, IsActive: true}
+		currentSchedule := &entities.PaymentSchedule{ID: "schedule1", LoanID: "loan1", Version: 1, InterestRate: 0.1, Tenor: 4}
+		rate := 0.05
+
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(loan, nil)
+		loanRepo.On("LockUserLoans", mock.Anything, "user1").Return(nil)
+		scheduleRepo.On("GetCurrentPaymentScheduleByLoanID", mock.Anything, "loan1").Return(currentSchedule, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(newPayments(now), nil)
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		expectAuditEvents(uow, mockTx)
+		expectOutboxEvents(uow, mockTx)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		uow.On("PaymentScheduleRepository", mockTx).Return(scheduleRepo)
+		expectTransactionReversals(uow, mockTx)
+		paymentRepo.On("ReplacePayments", mock.Anything, []string{"payment3", "payment4"}, mock.AnythingOfType("string"), mock.Anything).Return(2, nil)
+		scheduleRepo.On("CreatePaymentSchedule", mock.Anything, mock.AnythingOfType("*entities.PaymentSchedule")).Return(nil)
+		paymentRepo.On("CreatePayments", mock.Anything, mock.MatchedBy(func(payments []*entities.Payment) bool {
+			return len(payments) == 4 && payments[0].ScheduleID != "" && payments[0].Amount == 52.5
+		})).Return(nil)
+		loanRepo.On("UpdateInterestLoanByID", mock.Anything, "loan1", float64(30)).Return(nil)
+
+		service := createService(uow, loanRepo, paymentRepo, scheduleRepo)
+		result, err := service.RestructureLoan(context.Background(), "user1", "loan1", &entities.RestructureTerms{
+			Tenor:        4,
+			InterestRate: &rate,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.Version)
+		assert.Equal(t, "schedule1", *result.PreviousScheduleID)
+		assert.Equal(t, float64(200), result.Principal)
+		assert.Equal(t, float64(10), result.Interest)
+		paymentRepo.AssertCalled(t, "ReplacePayments", mock.Anything, []string{"payment3", "payment4"}, result.ID, mock.Anything)
+		uow.AssertExpectations(t)
+		loanRepo.AssertExpectations(t)
+		paymentRepo.AssertExpectations(t)
+		scheduleRepo.AssertExpectations(t)
+	})
+
+	t.Run("success capitalise arrears", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		scheduleRepo := new(mocks.PaymentScheduleRepository)
+		mockTx := &gorm.DB{}
+
+		now := time.Now()
+		loan := &entities.Loan{ID: "loan1", UserID: "user1", Amount: 400, Interest: 40, IsActive: true}
+		currentSchedule := &entities.PaymentSchedule{ID: "schedule1", LoanID: "loan1", Version: 1, InterestRate: 0.1, Tenor: 4}
+
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(loan, nil)
+		loanRepo.On("LockUserLoans", mock.Anything, "user1").Return(nil)
+		scheduleRepo.On("GetCurrentPaymentScheduleByLoanID", mock.Anything, "loan1").Return(currentSchedule, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(newPayments(now), nil)
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		expectAuditEvents(uow, mockTx)
+		expectOutboxEvents(uow, mockTx)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		uow.On("PaymentScheduleRepository", mockTx).Return(scheduleRepo)
+		expectTransactionReversals(uow, mockTx)
+		paymentRepo.On("ReplacePayments", mock.Anything, []string{"payment2", "payment3", "payment4"}, mock.AnythingOfType("string"), mock.Anything).Return(3, nil)
+		scheduleRepo.On("CreatePaymentSchedule", mock.Anything, mock.AnythingOfType("*entities.PaymentSchedule")).Return(nil)
+		paymentRepo.On("CreatePayments", mock.Anything, mock.AnythingOfType("[]*entities.Payment")).Return(nil)
+		loanRepo.On("UpdateInterestLoanByID", mock.Anything, "loan1", mock.Anything).Return(nil)
+
+		service := createService(uow, loanRepo, paymentRepo, scheduleRepo)
+		result, err := service.RestructureLoan(context.Background(), "user1", "loan1", &entities.RestructureTerms{
+			CapitaliseArrears: true,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(310), result.Principal)
+		assert.Equal(t, 3, result.Tenor)
+	})
+
+	t.Run("error when loan is not active", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		loanRepo := new(mocks.LoanRepository)
+		mockTx := &gorm.DB{}
+
+		loan := &entities.Loan{ID: "loan1", UserID: "user1", IsActive: false}
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(loan, nil)
+		loanRepo.On("LockUserLoans", mock.Anything, "user1").Return(nil)
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Rollback", mockTx).Return(nil)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+		uow.On("PaymentRepository", mockTx).Return(new(mocks.PaymentRepository))
+		uow.On("PaymentScheduleRepository", mockTx).Return(new(mocks.PaymentScheduleRepository))
+
+		service := createService(uow, loanRepo, nil, nil)
+		_, err := service.RestructureLoan(context.Background(), "user1", "loan1", &entities.RestructureTerms{})
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+		uow.AssertCalled(t, "Rollback", mockTx)
+	})
+
+	t.Run("error when the loan was restructured meanwhile - should rollback", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		scheduleRepo := new(mocks.PaymentScheduleRepository)
+		mockTx := &gorm.DB{}
+
+		now := time.Now()
+		loan := &entities.Loan{ID: "loan1", UserID: "user1", Amount: 400, Interest: 40, IsActive: true}
+		currentSchedule := &entities.PaymentSchedule{ID: "schedule1", LoanID: "loan1", Version: 1, InterestRate: 0.1, Tenor: 4}
+
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(loan, nil)
+		loanRepo.On("LockUserLoans", mock.Anything, "user1").Return(nil)
+		scheduleRepo.On("GetCurrentPaymentScheduleByLoanID", mock.Anything, "loan1").Return(currentSchedule, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(newPayments(now), nil)
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Rollback", mockTx).Return(nil)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		uow.On("PaymentScheduleRepository", mockTx).Return(scheduleRepo)
+		scheduleRepo.On("CreatePaymentSchedule", mock.Anything, mock.Anything).Return(gorm.ErrDuplicatedKey)
+
+		service := createService(uow, loanRepo, paymentRepo, scheduleRepo)
+		_, err := service.RestructureLoan(context.Background(), "user1", "loan1", &entities.RestructureTerms{})
+
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+		uow.AssertCalled(t, "Rollback", mockTx)
+		paymentRepo.AssertNotCalled(t, "ReplacePayments", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("error when create schedule fails - should rollback", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		scheduleRepo := new(mocks.PaymentScheduleRepository)
+		mockTx := &gorm.DB{}
+
+		now := time.Now()
+		loan := &entities.Loan{ID: "loan1", UserID: "user1", Amount: 400, Interest: 40, IsActive: true}
+		currentSchedule := &entities.PaymentSchedule{ID: "schedule1", LoanID: "loan1", Version: 1, InterestRate: 0.1, Tenor: 4}
+
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(loan, nil)
+		loanRepo.On("LockUserLoans", mock.Anything, "user1").Return(nil)
+		scheduleRepo.On("GetCurrentPaymentScheduleByLoanID", mock.Anything, "loan1").Return(currentSchedule, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(newPayments(now), nil)
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Rollback", mockTx).Return(nil)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		uow.On("PaymentScheduleRepository", mockTx).Return(scheduleRepo)
+		scheduleRepo.On("CreatePaymentSchedule", mock.Anything, mock.Anything).Return(errors.New("create error"))
+
+		service := createService(uow, loanRepo, paymentRepo, scheduleRepo)
+		_, err := service.RestructureLoan(context.Background(), "user1", "loan1", &entities.RestructureTerms{})
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.InternalServerError, errors.Unwrap(err))
+		uow.AssertCalled(t, "Rollback", mockTx)
+		paymentRepo.AssertNotCalled(t, "ReplacePayments", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestLoanService_DeferInstallments(t *testing.T) {
+	cfg := config.Config{
+		DeferralPolicies: config.DeferralPolicies{
+			entities.LOAN_PRODUCT_DEFAULT: {MaxDeferrals: 1, MaxInstallments: 2, Fee: 50, InterestRate: 0.01},
+		},
+	}
+	createService := func(uow *mocks.UnitOfWork, loanRepo *mocks.LoanRepository, paymentRepo *mocks.PaymentRepository, deferralRepo *mocks.PaymentDeferralRepository) services.LoanService {
+		return services.NewLoanService(cfg, uow, loanRepo, paymentRepo, nil, deferralRepo)
+	}
+
+	newPayments := func(now time.Time) []*entities.Payment {
+		var payments []*entities.Payment
+		for i := 0; i < 4; i++ {
+			startAt := now.AddDate(0, 0, 7*i-1)
+			endAt := startAt.AddDate(0, 0, 7).Add(-time.Nanosecond)
+			payments = append(payments, &entities.Payment{
+				ID:        fmt.Sprintf("payment%d", i+1),
+				Amount:    110,
+				Principal: 100,
+				Interest:  10,
+				StartAt:   &startAt,
+				EndAt:     &endAt,
+			})
+		}
+		return payments
+	}
+
+	// beginTx mocks the transaction the deferrals of the loan are counted in under the lock of the user
+	beginTx := func(loanRepo *mocks.LoanRepository, paymentRepo *mocks.PaymentRepository, deferralRepo *mocks.PaymentDeferralRepository) (*mocks.UnitOfWork, *gorm.DB) {
+		uow := new(mocks.UnitOfWork)
+		mockTx := &gorm.DB{}
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Rollback", mockTx).Return(nil).Maybe()
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		uow.On("PaymentDeferralRepository", mockTx).Return(deferralRepo)
+		loanRepo.On("LockUserLoans", mock.Anything, "user1").Return(nil)
+		return uow, mockTx
+	}
+
+	t.Run("success defer installments and the later ones by the deferred weeks", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		deferralRepo := new(mocks.PaymentDeferralRepository)
+		mockTx := &gorm.DB{}
+
+		now := time.Now()
+		payments := newPayments(now)
+		firstStartAt := *payments[0].StartAt
+		lastEndAt := *payments[3].EndAt
+		loan := &entities.Loan{ID: "loan1", UserID: "user1", Product: entities.LOAN_PRODUCT_DEFAULT, Amount: 400, Interest: 40, IsActive: true}
+
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(loan, nil)
+		loanRepo.On("LockUserLoans", mock.Anything, "user1").Return(nil)
+		deferralRepo.On("GetPaymentDeferralsByLoanID", mock.Anything, "loan1").Return([]*entities.PaymentDeferral{}, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(payments, nil)
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		expectAuditEvents(uow, mockTx)
+		expectOutboxEvents(uow, mockTx)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		uow.On("PaymentDeferralRepository", mockTx).Return(deferralRepo)
+		deferralRepo.On("CreatePaymentDeferral", mock.Anything, mock.AnythingOfType("*entities.PaymentDeferral")).Return(nil)
+		paymentRepo.On("UpdatePayments", mock.Anything, mock.AnythingOfType("[]*entities.Payment")).Return(nil)
+		loanRepo.On("AddInterestLoanByID", mock.Anything, "loan1", float64(2)).Return(nil)
+		loanRepo.On("AddFeeLoanByID", mock.Anything, "loan1", float64(50)).Return(nil)
+
+		service := createService(uow, loanRepo, paymentRepo, deferralRepo)
+		result, err := service.DeferInstallments(context.Background(), "user1", "loan1", 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, firstStartAt, *result.StartAt)
+		assert.Equal(t, float64(2), result.Interest)
+		// the deferred and later installments move two weeks back, the holiday takes the windows of the deferred ones
+		assert.Equal(t, firstStartAt.AddDate(0, 0, 14), *payments[0].StartAt)
+		assert.Equal(t, firstStartAt.AddDate(0, 0, 28), *payments[2].StartAt)
+		assert.Equal(t, lastEndAt.AddDate(0, 0, 14), *payments[3].EndAt)
+		assert.Nil(t, payments[2].DeferralID)
+		paymentRepo.AssertCalled(t, "UpdatePayments", mock.Anything, mock.MatchedBy(func(updated []*entities.Payment) bool {
+			return len(updated) == 4
+		}))
+		assert.Equal(t, float64(162), payments[0].Amount)
+		assert.Equal(t, result.ID, *payments[1].DeferralID)
+		uow.AssertExpectations(t)
+		loanRepo.AssertExpectations(t)
+	})
+
+	t.Run("error when maximum deferrals reached", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		deferralRepo := new(mocks.PaymentDeferralRepository)
+
+		loan := &entities.Loan{ID: "loan1", UserID: "user1", Product: entities.LOAN_PRODUCT_DEFAULT, IsActive: true}
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(loan, nil)
+		deferralRepo.On("GetPaymentDeferralsByLoanID", mock.Anything, "loan1").Return([]*entities.PaymentDeferral{{ID: "deferral1"}}, nil)
+		uow, mockTx := beginTx(loanRepo, nil, deferralRepo)
+
+		service := createService(uow, loanRepo, nil, deferralRepo)
+		_, err := service.DeferInstallments(context.Background(), "user1", "loan1", 1)
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+		loanRepo.AssertCalled(t, "LockUserLoans", mock.Anything, "user1")
+		uow.AssertCalled(t, "Rollback", mockTx)
+	})
+
+	t.Run("error when loan is delinquent", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		deferralRepo := new(mocks.PaymentDeferralRepository)
+
+		overdueEndAt := time.Now().AddDate(0, 0, -20)
+		loan := &entities.Loan{ID: "loan1", UserID: "user1", Product: entities.LOAN_PRODUCT_DEFAULT, IsActive: true}
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(loan, nil)
+		deferralRepo.On("GetPaymentDeferralsByLoanID", mock.Anything, "loan1").Return([]*entities.PaymentDeferral{}, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return([]*entities.Payment{{ID: "payment1", EndAt: &overdueEndAt}}, nil)
+		uow, _ := beginTx(loanRepo, paymentRepo, deferralRepo)
+
+		service := createService(uow, loanRepo, paymentRepo, deferralRepo)
+		_, err := service.DeferInstallments(context.Background(), "user1", "loan1", 1)
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+	})
+
+	t.Run("error when product has no deferral policy", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+
+		loan := &entities.Loan{ID: "loan1", UserID: "user1", Product: "payday", IsActive: true}
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(loan, nil)
+
+		service := createService(nil, loanRepo, nil, nil)
+		_, err := service.DeferInstallments(context.Background(), "user1", "loan1", 1)
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+	})
+}
+
+func TestLoanService_TopUpLoan(t *testing.T) {
+	createService := func(uow *mocks.UnitOfWork, loanRepo *mocks.LoanRepository, paymentRepo *mocks.PaymentRepository) services.LoanService {
+		return services.NewLoanService(loanConfig, uow, loanRepo, paymentRepo, nil, nil)
+	}
+
+	t.Run("success refinance outstanding balance with new cash", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		scheduleRepo := new(mocks.PaymentScheduleRepository)
+		mockTx := &gorm.DB{}
+
+		now := time.Now()
+		endAt := now.AddDate(0, 0, 7)
+		loan := &entities.Loan{ID: "loan1", UserID: "user1", Amount: 1000, Interest: 100, IsActive: true, Status: entities.LOAN_STATUS_ACTIVE}
+		payments := []*entities.Payment{
+			{ID: "payment1", Amount: 550, Principal: 500, Interest: 50, PaidAt: &now, EndAt: &now},
+			{ID: "payment2", Amount: 550, Principal: 500, Interest: 50, EndAt: &endAt},
+		}
+
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(loan, nil)
+		loanRepo.On("GetActiveLoansByUserID", mock.Anything, "user1").Return([]*entities.Loan{loan}, nil)
+		loanRepo.On("LockUserLoans", mock.Anything, "user1").Return(nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(payments, nil)
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		expectAuditEvents(uow, mockTx)
+		expectOutboxEvents(uow, mockTx)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		uow.On("PaymentScheduleRepository", mockTx).Return(scheduleRepo)
+		loanRepo.On("GetLoanByPaymentReference", mock.Anything, mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+		loanRepo.On("CreateLoan", mock.Anything, mock.MatchedBy(func(newLoan *entities.Loan) bool {
+			return newLoan.Amount == 2500 && newLoan.Status == entities.LOAN_STATUS_ACTIVE
+		})).Return(nil)
+		scheduleRepo.On("CreatePaymentSchedule", mock.Anything, mock.AnythingOfType("*entities.PaymentSchedule")).Return(nil)
+		paymentRepo.On("CreatePayments", mock.Anything, mock.AnythingOfType("[]*entities.Payment")).Return(nil)
+		reversalRepo := expectTransactionReversals(uow, mockTx)
+		paymentRepo.On("SoftDeletePayments", mock.Anything, []string{"payment2"}, mock.Anything).Return(1, nil)
+		loanRepo.On("UpdateRefinancedLoanByID", mock.Anything, "loan1", mock.AnythingOfType("string")).Return(true, nil)
+
+		service := createService(uow, loanRepo, paymentRepo)
+		result, err := service.TopUpLoan(context.Background(), "user1", "loan1", 2000)
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(500), result.PayoffAmount)
+		assert.Equal(t, float64(2000), result.NetDisbursement)
+		assert.Equal(t, "loan1", result.RefinancedLoan.ID)
+		reversalRepo.AssertCalled(t, "ReversePaymentTransactionsByPaymentIDs", mock.Anything, []string{"payment2"}, mock.Anything)
+		uow.AssertExpectations(t)
+		loanRepo.AssertExpectations(t)
+		paymentRepo.AssertExpectations(t)
+	})
+
+	t.Run("success pay off an overdue installment with its interest and late fee", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		scheduleRepo := new(mocks.PaymentScheduleRepository)
+		mockTx := &gorm.DB{}
+
+		now := time.Now()
+		overdueAt := now.AddDate(0, 0, -3)
+		endAt := now.AddDate(0, 0, 4)
+		loan := &entities.Loan{ID: "loan1", UserID: "user1", Amount: 1000, Interest: 100, IsActive: true, Status: entities.LOAN_STATUS_ACTIVE}
+		payments := []*entities.Payment{
+			{ID: "payment1", Amount: 560, Principal: 500, Interest: 50, Fee: 10, LateFee: 10, EndAt: &overdueAt, LateFeeAt: &now},
+			{ID: "payment2", Amount: 550, Principal: 500, Interest: 50, EndAt: &endAt},
+		}
+
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(loan, nil)
+		loanRepo.On("GetActiveLoansByUserID", mock.Anything, "user1").Return([]*entities.Loan{loan}, nil)
+		loanRepo.On("LockUserLoans", mock.Anything, "user1").Return(nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(payments, nil)
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		expectAuditEvents(uow, mockTx)
+		expectOutboxEvents(uow, mockTx)
+		expectTransactionReversals(uow, mockTx)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		uow.On("PaymentScheduleRepository", mockTx).Return(scheduleRepo)
+		loanRepo.On("GetLoanByPaymentReference", mock.Anything, mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+		loanRepo.On("CreateLoan", mock.Anything, mock.MatchedBy(func(newLoan *entities.Loan) bool {
+			return newLoan.Amount == 2060
+		})).Return(nil)
+		scheduleRepo.On("CreatePaymentSchedule", mock.Anything, mock.AnythingOfType("*entities.PaymentSchedule")).Return(nil)
+		paymentRepo.On("CreatePayments", mock.Anything, mock.AnythingOfType("[]*entities.Payment")).Return(nil)
+		paymentRepo.On("SoftDeletePayments", mock.Anything, []string{"payment1", "payment2"}, mock.Anything).Return(2, nil)
+		loanRepo.On("UpdateRefinancedLoanByID", mock.Anything, "loan1", mock.AnythingOfType("string")).Return(true, nil)
+
+		service := createService(uow, loanRepo, paymentRepo)
+		result, err := service.TopUpLoan(context.Background(), "user1", "loan1", 1000)
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(1060), result.PayoffAmount)
+		assert.Equal(t, float64(1000), result.NetDisbursement)
+		loanRepo.AssertExpectations(t)
+	})
+
+	t.Run("error when the loan was paid or topped up meanwhile - should rollback", func(t *testing.T) {
+		for name, refinanced := range map[string]bool{"installment paid": false, "loan refinanced": true} {
+			uow := new(mocks.UnitOfWork)
+			loanRepo := new(mocks.LoanRepository)
+			paymentRepo := new(mocks.PaymentRepository)
+			scheduleRepo := new(mocks.PaymentScheduleRepository)
+			mockTx := &gorm.DB{}
+
+			now := time.Now()
+			endAt := now.AddDate(0, 0, 7)
+			loan := &entities.Loan{ID: "loan1", UserID: "user1", Amount: 1000, Interest: 100, IsActive: true, Status: entities.LOAN_STATUS_ACTIVE}
+			payments := []*entities.Payment{
+				{ID: "payment1", Amount: 550, EndAt: &endAt},
+				{ID: "payment2", Amount: 550, EndAt: &endAt},
+			}
+
+			loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(loan, nil)
+			loanRepo.On("GetActiveLoansByUserID", mock.Anything, "user1").Return([]*entities.Loan{loan}, nil)
+			loanRepo.On("LockUserLoans", mock.Anything, "user1").Return(nil)
+			paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(payments, nil)
+			uow.On("Begin", mock.Anything).Return(mockTx, nil)
+			uow.On("Rollback", mockTx).Return(nil)
+			expectAuditEvents(uow, mockTx)
+			expectOutboxEvents(uow, mockTx)
+			uow.On("LoanRepository", mockTx).Return(loanRepo)
+			uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+			uow.On("PaymentScheduleRepository", mockTx).Return(scheduleRepo)
+			loanRepo.On("GetLoanByPaymentReference", mock.Anything, mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+			loanRepo.On("CreateLoan", mock.Anything, mock.Anything).Return(nil)
+			scheduleRepo.On("CreatePaymentSchedule", mock.Anything, mock.Anything).Return(nil)
+			paymentRepo.On("CreatePayments", mock.Anything, mock.Anything).Return(nil)
+			expectTransactionReversals(uow, mockTx)
+			if refinanced {
+				paymentRepo.On("SoftDeletePayments", mock.Anything, []string{"payment1", "payment2"}, mock.Anything).Return(2, nil)
+				loanRepo.On("UpdateRefinancedLoanByID", mock.Anything, "loan1", mock.Anything).Return(false, nil)
+			} else {
+				paymentRepo.On("SoftDeletePayments", mock.Anything, []string{"payment1", "payment2"}, mock.Anything).Return(1, nil)
+			}
+
+			service := createService(uow, loanRepo, paymentRepo)
+			_, err := service.TopUpLoan(context.Background(), "user1", "loan1", 1000)
+
+			assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err), name)
+			uow.AssertCalled(t, "Rollback", mockTx)
+			uow.AssertNotCalled(t, "Commit", mockTx)
+		}
+	})
+
+	t.Run("error when top up exceeds maximum exposure", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		mockTx := &gorm.DB{}
+
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Rollback", mockTx).Return(nil)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+
+		loan := &entities.Loan{ID: "loan1", UserID: "user1", Amount: 1000, Interest: 100, IsActive: true, Status: entities.LOAN_STATUS_ACTIVE}
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(loan, nil)
+		loanRepo.On("GetActiveLoansByUserID", mock.Anything, "user1").Return([]*entities.Loan{loan}, nil)
+		loanRepo.On("LockUserLoans", mock.Anything, "user1").Return(nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return([]*entities.Payment{}, nil)
+
+		service := createService(uow, loanRepo, paymentRepo)
+		_, err := service.TopUpLoan(context.Background(), "user1", "loan1", 15000000)
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+		uow.AssertCalled(t, "Rollback", mockTx)
+		uow.AssertNotCalled(t, "Commit", mockTx)
+	})
+
+	t.Run("error when create loan fails - should rollback", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		mockTx := &gorm.DB{}
+
+		loan := &entities.Loan{ID: "loan1", UserID: "user1", Amount: 1000, Interest: 100, IsActive: true, Status: entities.LOAN_STATUS_ACTIVE}
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(loan, nil)
+		loanRepo.On("GetActiveLoansByUserID", mock.Anything, "user1").Return([]*entities.Loan{loan}, nil)
+		loanRepo.On("LockUserLoans", mock.Anything, "user1").Return(nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return([]*entities.Payment{}, nil)
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Rollback", mockTx).Return(nil)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		loanRepo.On("GetLoanByPaymentReference", mock.Anything, mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+		loanRepo.On("CreateLoan", mock.Anything, mock.Anything).Return(errors.New("create error"))
+
+		service := createService(uow, loanRepo, paymentRepo)
+		_, err := service.TopUpLoan(context.Background(), "user1", "loan1", 1000)
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.InternalServerError, errors.Unwrap(err))
+		uow.AssertCalled(t, "Rollback", mockTx)
+		loanRepo.AssertNotCalled(t, "UpdateRefinancedLoanByID", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestLoanService_GetLoan(t *testing.T) {
+	t.Run("success with paid installments", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		scheduleRepo := new(mocks.PaymentScheduleRepository)
+
+		now := time.Now().UTC()
+		createdAt := now.AddDate(0, 0, -10)
+		firstEndAt := createdAt.AddDate(0, 0, 7).Add(-time.Nanosecond)
+		secondEndAt := createdAt.AddDate(0, 0, 14).Add(-time.Nanosecond)
+		loan := &entities.Loan{ID: "loan1", UserID: "user1", Amount: 1000, Interest: 100, Status: entities.LOAN_STATUS_ACTIVE, CreatedAt: &createdAt}
+		schedule := &entities.PaymentSchedule{ID: "schedule1", Version: 2, InterestRate: 0.1}
+		payments := []*entities.Payment{
+			{ID: "payment1", Amount: 550, EndAt: &firstEndAt, PaidAt: &now},
+			{ID: "payment2", Amount: 550, EndAt: &secondEndAt},
+		}
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(loan, nil)
+		scheduleRepo.On("GetCurrentPaymentScheduleByLoanID", mock.Anything, "loan1").Return(schedule, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(payments, nil)
+
+		service := services.NewLoanService(config.Config{}, nil, loanRepo, paymentRepo, scheduleRepo, nil)
+		result, err := service.GetLoan(context.Background(), "user1", "loan1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(550), result.Outstanding)
+		assert.Equal(t, float64(550), result.Paid)
+		assert.Equal(t, 1, result.PaidInstallments)
+		assert.Equal(t, 2, result.TotalInstallments)
+		assert.Equal(t, 2, result.Schedule.Version)
+		assert.InDelta(t, 3.430159, result.APR, 1e-6)
+		assert.InDelta(t, 26.709245, result.EffectiveAnnualRate, 1e-5)
+	})
+
+	t.Run("success nothing outstanding on a refinanced loan", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		scheduleRepo := new(mocks.PaymentScheduleRepository)
+
+		now := time.Now().UTC()
+		createdAt := now.AddDate(0, 0, -10)
+		endAt := createdAt.AddDate(0, 0, 7).Add(-time.Nanosecond)
+		loan := &entities.Loan{ID: "loan1", UserID: "user1", Amount: 1000, Interest: 100, Status: entities.LOAN_STATUS_REFINANCED, CreatedAt: &createdAt}
+		payments := []*entities.Payment{
+			{ID: "payment1", Amount: 550, EndAt: &endAt, PaidAt: &now},
+		}
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(loan, nil)
+		scheduleRepo.On("GetCurrentPaymentScheduleByLoanID", mock.Anything, "loan1").Return(&entities.PaymentSchedule{ID: "schedule1", Version: 1}, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(payments, nil)
+
+		service := services.NewLoanService(config.Config{}, nil, loanRepo, paymentRepo, scheduleRepo, nil)
+		result, err := service.GetLoan(context.Background(), "user1", "loan1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(0), result.Outstanding)
+		assert.Equal(t, float64(550), result.Paid)
+	})
+}
+
+func TestLoanService_ListLoans(t *testing.T) {
+	newLoans := func(count int) []*entities.Loan {
+		var loans []*entities.Loan
+		for i := 0; i < count; i++ {
+			createdAt := time.Now().AddDate(0, 0, -i)
+			loans = append(loans, &entities.Loan{ID: fmt.Sprintf("loan%d", i+1), CreatedAt: &createdAt})
+		}
+		return loans
+	}
+
+	t.Run("success returns next page token when more loans exist", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		loans := newLoans(3)
+		loanRepo.On("GetLoansByUserID", mock.Anything, "user1", []string{entities.LOAN_STATUS_ACTIVE}, (*pagination.Cursor)(nil), 3).Return(loans, nil)
+
+		service := services.NewLoanService(config.Config{}, nil, loanRepo, nil, nil, nil)
+		result, err := service.ListLoans(context.Background(), "user1", []string{entities.LOAN_STATUS_ACTIVE}, 2, "")
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Loans, 2)
+		cursor, err := pagination.DecodeCursor(result.NextPageToken)
+		assert.NoError(t, err)
+		assert.Equal(t, "loan2", cursor.ID)
+	})
+
+	t.Run("success last page has no next page token", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		createdAt := time.Now()
+		token := pagination.EncodeCursor(createdAt, "loan2")
+		loanRepo.On("GetLoansByUserID", mock.Anything, "user1", []string(nil), mock.AnythingOfType("*pagination.Cursor"), 3).Return(newLoans(1), nil)
+
+		service := services.NewLoanService(config.Config{}, nil, loanRepo, nil, nil, nil)
+		result, err := service.ListLoans(context.Background(), "user1", nil, 2, token)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Loans, 1)
+		assert.Empty(t, result.NextPageToken)
+	})
+
+	t.Run("error on invalid status", func(t *testing.T) {
+		service := services.NewLoanService(config.Config{}, nil, nil, nil, nil, nil)
+		_, err := service.ListLoans(context.Background(), "user1", []string{"unknown"}, 0, "")
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+	})
+
+	t.Run("error on invalid page token", func(t *testing.T) {
+		service := services.NewLoanService(config.Config{}, nil, nil, nil, nil, nil)
+		_, err := service.ListLoans(context.Background(), "user1", nil, 0, "%%%")
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+	})
+}
+
+func TestLoanService_GetRepaymentSchedule(t *testing.T) {
+	t.Run("success derives installment statuses and summary", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+
+		now := time.Now()
+		window := func(weeks int) (*time.Time, *time.Time) {
+			startAt := now.AddDate(0, 0, 7*weeks)
+			endAt := startAt.AddDate(0, 0, 7).Add(-time.Nanosecond)
+			return &startAt, &endAt
+		}
+		paidStartAt, paidEndAt := window(-3)
+		overdueStartAt, overdueEndAt := window(-2)
+		dueStartAt, dueEndAt := window(0)
+		upcomingStartAt, upcomingEndAt := window(1)
+		payments := []*entities.Payment{
+			{ID: "payment1", Amount: 100, StartAt: paidStartAt, EndAt: paidEndAt, PaidAt: &now},
+			{ID: "payment2", Amount: 100, StartAt: overdueStartAt, EndAt: overdueEndAt},
+			{ID: "payment3", Amount: 100, StartAt: dueStartAt, EndAt: dueEndAt},
+			{ID: "payment4", Amount: 100, StartAt: upcomingStartAt, EndAt: upcomingEndAt},
+		}
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(&entities.Loan{ID: "loan1", UserID: "user1"}, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(payments, nil)
+
+		service := services.NewLoanService(config.Config{}, nil, loanRepo, paymentRepo, nil, nil)
+		result, err := service.GetRepaymentSchedule(context.Background(), "user1", "loan1")
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Installments, 4)
+		assert.Equal(t, entities.INSTALLMENT_STATUS_PAID, result.Installments[0].Status)
+		assert.Equal(t, float64(100), result.Installments[0].AmountPaid)
+		assert.Equal(t, entities.INSTALLMENT_STATUS_OVERDUE, result.Installments[1].Status)
+		assert.Equal(t, entities.INSTALLMENT_STATUS_DUE, result.Installments[2].Status)
+		assert.Equal(t, entities.INSTALLMENT_STATUS_UPCOMING, result.Installments[3].Status)
+		assert.Equal(t, 4, result.Installments[3].Sequence)
+		assert.Equal(t, 1, result.PaidInstallments)
+		assert.Equal(t, 1, result.OverdueInstallments)
+		assert.Equal(t, "payment2", result.NextDue.Payment.ID)
+	})
+
+	t.Run("error when loan belongs to another user", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(&entities.Loan{ID: "loan1", UserID: "user2"}, nil)
+
+		service := services.NewLoanService(config.Config{}, nil, loanRepo, nil, nil, nil)
+		_, err := service.GetRepaymentSchedule(context.Background(), "user1", "loan1")
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.NotFoundError, errors.Unwrap(err))
+	})
+}
+
+func TestLoanService_QuoteLoan(t *testing.T) {
+	t.Run("success projects the standard schedule", func(t *testing.T) {
+		service := services.NewLoanService(config.Config{}, nil, nil, nil, nil, nil)
+		result, err := service.QuoteLoan(context.Background(), 0, 0)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Installments, entities.PAYMENT_WEEKS)
+		assert.Equal(t, float64(500000), result.TotalInterest)
+		assert.Equal(t, float64(5500000), result.TotalRepayable)
+		assert.Equal(t, float64(110000), result.InstallmentAmount)
+		assert.InDelta(t, 0.197793, result.APR, 1e-6)
+		assert.InDelta(t, 0.218253, result.EffectiveAnnualRate, 1e-6)
+	})
+
+	t.Run("success with custom amount and tenor", func(t *testing.T) {
+		service := services.NewLoanService(config.Config{}, nil, nil, nil, nil, nil)
+		result, err := service.QuoteLoan(context.Background(), 1000000, 10)
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Installments, 10)
+		assert.Equal(t, float64(110000), result.InstallmentAmount)
+		assert.Equal(t, entities.INSTALLMENT_STATUS_UPCOMING, result.Installments[0].Status)
+	})
+
+	t.Run("error on negative amount", func(t *testing.T) {
+		service := services.NewLoanService(config.Config{}, nil, nil, nil, nil, nil)
+		_, err := service.QuoteLoan(context.Background(), -1, 0)
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+	})
+}
+
+func TestLoanService_SweepDelinquentLoans(t *testing.T) {
+	t.Run("success marks new delinquencies and clears recovered loans", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		mockTx := &gorm.DB{}
+
+		now := time.Now()
+		overdueEndAt := now.AddDate(0, 0, -20)
+		nextEndAt := now.AddDate(0, 0, 7)
+		marked := now.AddDate(0, 0, -3)
+		pastDue := []*entities.Loan{
+			{ID: "loan1", UserID: "user1", Status: entities.LOAN_STATUS_ACTIVE},
+			{ID: "loan2", UserID: "user2", Status: entities.LOAN_STATUS_ACTIVE, DelinquentAt: &marked},
+		}
+		recovered := &entities.Loan{ID: "loan3", UserID: "user3", Status: entities.LOAN_STATUS_ACTIVE, DelinquentAt: &marked}
+
+		loanRepo.On("GetPastDueLoans", mock.Anything, mock.AnythingOfType("time.Time")).Return(pastDue, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return([]*entities.Payment{{ID: "payment1", EndAt: &overdueEndAt}}, nil)
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+		auditRepo := expectAuditEvents(uow, mockTx)
+		outboxRepo := expectOutboxEvents(uow, mockTx)
+		loanRepo.On("UpdateDelinquentAtLoanByID", mock.Anything, "loan1", mock.AnythingOfType("*time.Time")).Return(nil)
+		loanRepo.On("GetDelinquentLoans", mock.Anything).Return([]*entities.Loan{pastDue[1], recovered}, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan2").Return([]*entities.Payment{{ID: "payment2", EndAt: &overdueEndAt}}, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan3").Return([]*entities.Payment{{ID: "payment3", EndAt: &nextEndAt}}, nil)
+		loanRepo.On("UpdateDelinquentAtLoanByID", mock.Anything, "loan3", (*time.Time)(nil)).Return(nil)
+
+		service := services.NewLoanService(config.Config{}, uow, loanRepo, paymentRepo, nil, nil)
+		result, err := service.SweepDelinquentLoans(context.Background())
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, "loan1", result[0].ID)
+		assert.NotNil(t, result[0].DelinquentAt)
+		outboxRepo.AssertCalled(t, "CreateOutboxEvent", mock.Anything, mock.MatchedBy(func(event *entities.OutboxEvent) bool {
+			return event.Type == entities.EVENT_LOAN_BECAME_DELINQUENT && event.LoanID == "loan1" && event.Sequence == 1
+		}))
+		for loanID, action := range map[string]string{"loan1": entities.AUDIT_ACTION_LOAN_DELINQUENT, "loan3": entities.AUDIT_ACTION_DELINQUENCY_CLEARED} {
+			auditRepo.AssertCalled(t, "CreateAuditEvent", mock.Anything, mock.MatchedBy(func(event *entities.AuditEvent) bool {
+				return event.LoanID != nil && *event.LoanID == loanID && event.Action == action
+			}))
+		}
+		loanRepo.AssertNotCalled(t, "UpdateDelinquentAtLoanByID", mock.Anything, "loan2", mock.Anything)
+		loanRepo.AssertExpectations(t)
+	})
+
+	t.Run("error on a loan does not hold back the others", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		mockTx := &gorm.DB{}
+
+		overdueEndAt := time.Now().AddDate(0, 0, -20)
+		pastDue := []*entities.Loan{
+			{ID: "loan1", UserID: "user1", Status: entities.LOAN_STATUS_ACTIVE},
+			{ID: "loan2", UserID: "user2", Status: entities.LOAN_STATUS_ACTIVE},
+		}
+
+		loanRepo.On("GetPastDueLoans", mock.Anything, mock.AnythingOfType("time.Time")).Return(pastDue, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(nil, errors.New("connection reset"))
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan2").Return([]*entities.Payment{{ID: "payment2", EndAt: &overdueEndAt}}, nil)
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+		expectAuditEvents(uow, mockTx)
+		expectOutboxEvents(uow, mockTx)
+		loanRepo.On("UpdateDelinquentAtLoanByID", mock.Anything, "loan2", mock.AnythingOfType("*time.Time")).Return(nil)
+		loanRepo.On("GetDelinquentLoans", mock.Anything).Return([]*entities.Loan{}, nil)
+
+		service := services.NewLoanService(config.Config{}, uow, loanRepo, paymentRepo, nil, nil)
+		result, err := service.SweepDelinquentLoans(context.Background())
+
+		assert.ErrorIs(t, err, errorhandler.InternalServerError)
+		assert.Contains(t, err.Error(), "loan1")
+		assert.Len(t, result, 1)
+		assert.Equal(t, "loan2", result[0].ID)
+		loanRepo.AssertExpectations(t)
+	})
+}
+
+func TestLoanService_AccrueLateFees(t *testing.T) {
+	t.Run("success charges the fee once per overdue installment", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		mockTx := &gorm.DB{}
+
+		endAt := time.Now().AddDate(0, 0, -5)
+		overdue := []*entities.Payment{
+			{ID: "payment1", LoanID: "loan1", Amount: 110000, EndAt: &endAt},
+			{ID: "payment2", LoanID: "loan2", Amount: 110000, EndAt: &endAt},
+		}
+
+		paymentRepo.On("GetLateFeeDuePayments", mock.Anything, mock.AnythingOfType("time.Time")).Return(overdue, nil)
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		uow.On("Rollback", mockTx).Return(nil)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		auditRepo := expectAuditEvents(uow, mockTx)
+		outboxRepo := expectOutboxEvents(uow, mockTx)
+		paymentRepo.On("ChargeLateFeePayment", mock.Anything, "payment1", float64(25000), mock.AnythingOfType("time.Time")).Return(true, nil)
+		// paid meanwhile
+		paymentRepo.On("ChargeLateFeePayment", mock.Anything, "payment2", float64(25000), mock.AnythingOfType("time.Time")).Return(false, nil)
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(&entities.Loan{ID: "loan1", UserID: "user1", Fee: 50000}, nil)
+		loanRepo.On("GetLoanByID", mock.Anything, "loan2").Return(&entities.Loan{ID: "loan2", UserID: "user2"}, nil)
+		loanRepo.On("LockUserLoans", mock.Anything, mock.Anything).Return(nil)
+		loanRepo.On("AddFeeLoanByID", mock.Anything, "loan1", float64(25000)).Return(nil)
+
+		service := services.NewLoanService(config.Config{LateFeeAmount: 25000, LateFeeGraceDays: 3}, uow, loanRepo, paymentRepo, nil, nil)
+		result, err := service.AccrueLateFees(context.Background())
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, float64(135000), result[0].Amount)
+		assert.NotNil(t, result[0].LateFeeAt)
+		outboxRepo.AssertCalled(t, "CreateOutboxEvent", mock.Anything, mock.MatchedBy(func(event *entities.OutboxEvent) bool {
+			return event.Type == entities.EVENT_LATE_FEE_CHARGED && event.LoanID == "loan1"
+		}))
+		auditRepo.AssertCalled(t, "CreateAuditEvent", mock.Anything, mock.MatchedBy(func(event *entities.AuditEvent) bool {
+			return event.Action == entities.AUDIT_ACTION_LATE_FEE_CHARGED && event.EntityID == "payment1" &&
+				strings.Contains(*event.Before, `"amount":110000`) && strings.Contains(*event.After, `"amount":135000`)
+		}))
+		loanRepo.AssertCalled(t, "LockUserLoans", mock.Anything, "user1")
+		loanRepo.AssertNotCalled(t, "AddFeeLoanByID", mock.Anything, "loan2", mock.Anything)
+		loanRepo.AssertExpectations(t)
+		paymentRepo.AssertExpectations(t)
+	})
+
+	t.Run("error on an installment does not hold back the others", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		mockTx := &gorm.DB{}
+
+		endAt := time.Now().AddDate(0, 0, -5)
+		overdue := []*entities.Payment{
+			{ID: "payment1", LoanID: "loan1", Amount: 110000, EndAt: &endAt},
+			{ID: "payment2", LoanID: "loan2", Amount: 110000, EndAt: &endAt},
+		}
+
+		paymentRepo.On("GetLateFeeDuePayments", mock.Anything, mock.AnythingOfType("time.Time")).Return(overdue, nil)
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		uow.On("Rollback", mockTx).Return(nil)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		expectAuditEvents(uow, mockTx)
+		expectOutboxEvents(uow, mockTx)
+		paymentRepo.On("ChargeLateFeePayment", mock.Anything, "payment1", float64(25000), mock.AnythingOfType("time.Time")).Return(false, errors.New("connection reset"))
+		paymentRepo.On("ChargeLateFeePayment", mock.Anything, "payment2", float64(25000), mock.AnythingOfType("time.Time")).Return(true, nil)
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(&entities.Loan{ID: "loan1", UserID: "user1"}, nil)
+		loanRepo.On("GetLoanByID", mock.Anything, "loan2").Return(&entities.Loan{ID: "loan2", UserID: "user2"}, nil)
+		loanRepo.On("LockUserLoans", mock.Anything, mock.Anything).Return(nil)
+		loanRepo.On("AddFeeLoanByID", mock.Anything, "loan2", float64(25000)).Return(nil)
+
+		service := services.NewLoanService(config.Config{LateFeeAmount: 25000, LateFeeGraceDays: 3}, uow, loanRepo, paymentRepo, nil, nil)
+		result, err := service.AccrueLateFees(context.Background())
+
+		assert.ErrorIs(t, err, errorhandler.InternalServerError)
+		assert.Contains(t, err.Error(), "payment1")
+		assert.Len(t, result, 1)
+		assert.Equal(t, "payment2", result[0].ID)
+		uow.AssertCalled(t, "Rollback", mockTx)
+		loanRepo.AssertNotCalled(t, "AddFeeLoanByID", mock.Anything, "loan1", mock.Anything)
+	})
+
+	t.Run("no fee configured charges nothing", func(t *testing.T) {
+		paymentRepo := new(mocks.PaymentRepository)
+
+		service := services.NewLoanService(config.Config{}, nil, nil, paymentRepo, nil, nil)
+		result, err := service.AccrueLateFees(context.Background())
+
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+		paymentRepo.AssertNotCalled(t, "GetLateFeeDuePayments", mock.Anything, mock.Anything)
+	})
 }
@@ -7,63 +7,533 @@ import (
 	"github.com/google/uuid"
 	"github.com/verizhang/billing-engine/src/entities"
 	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"github.com/verizhang/billing-engine/src/utils/finmath"
+	"gorm.io/gorm"
+	"math"
 	"time"
 )
 
-func (s *loanService) generatePayments(loanID string, now time.Time) []*entities.Payment {
+func (s *loanService) newLoan(userID string, amount float64, now time.Time) (*entities.Loan, *entities.PaymentSchedule, error) {
+	loanID, err := uuid.NewUUID()
+	if err != nil {
+		return nil, nil, err
+	}
+	scheduleID, err := uuid.NewUUID()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	loan := &entities.Loan{
+		ID:        loanID.String(),
+		UserID:    userID,
+		Product:   entities.LOAN_PRODUCT_DEFAULT,
+		Amount:    amount,
+		Interest:  amount * entities.LOAN_INTEREST_RATE,
+		IsActive:  true,
+		Status:    entities.LOAN_STATUS_ACTIVE,
+		CreatedAt: &now,
+	}
+
+	schedule := &entities.PaymentSchedule{
+		ID:           scheduleID.String(),
+		LoanID:       loan.ID,
+		Version:      1,
+		Principal:    loan.Amount,
+		Interest:     loan.Interest,
+		InterestRate: entities.LOAN_INTEREST_RATE,
+		Tenor:        entities.PAYMENT_WEEKS,
+		CreatedAt:    &now,
+	}
+
+	return loan, schedule, nil
+}
+
+func (s *loanService) createLoan(ctx context.Context, tx *gorm.DB, loan *entities.Loan, schedule *entities.PaymentSchedule, now time.Time) error {
+	paymentReference, err := s.newPaymentReference(ctx, tx)
+	if err != nil {
+		return err
+	}
+	loan.PaymentReference = &paymentReference
+
+	err = s.uow.LoanRepository(tx).CreateLoan(ctx, loan)
+	if err != nil {
+		return err
+	}
+
+	err = s.uow.PaymentScheduleRepository(tx).CreatePaymentSchedule(ctx, schedule)
+	if err != nil {
+		return err
+	}
+
+	err = s.uow.PaymentRepository(tx).CreatePayments(ctx, s.generatePayments(schedule, now))
+	if err != nil {
+		return err
+	}
+
+	err = recordAuditEvent(ctx, s.uow, tx, &entities.AuditEvent{
+		LoanID:     &loan.ID,
+		Action:     entities.AUDIT_ACTION_LOAN_CREATED,
+		EntityType: entities.AUDIT_ENTITY_LOAN,
+		EntityID:   loan.ID,
+	}, nil, loan)
+	if err != nil {
+		return err
+	}
+
+	return recordOutboxEvent(ctx, s.uow, tx, loan.ID, entities.EVENT_LOAN_CREATED, &entities.LoanCreatedEvent{
+		LoanID:    loan.ID,
+		UserID:    loan.UserID,
+		Product:   loan.Product,
+		Amount:    loan.Amount,
+		Interest:  loan.Interest,
+		Fee:       loan.Fee,
+		CreatedAt: now,
+	})
+}
+
+// newPaymentReference draws references until one is free, the unique index still guards against a concurrent loan drawing the same one
+func (s *loanService) newPaymentReference(ctx context.Context, tx *gorm.DB) (string, error) {
+	format := paymentReferenceFormat(s.cfg)
+	for attempt := 0; attempt < paymentReferenceAttempts; attempt++ {
+		reference, err := format.Generate()
+		if err != nil {
+			return "", err
+		}
+
+		_, err = s.uow.LoanRepository(tx).GetLoanByPaymentReference(ctx, reference)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return reference, nil
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return "", fmt.Errorf("no free payment reference after %d attempts", paymentReferenceAttempts)
+}
+
+// lockExposure takes the lock of the loans of the user and sums the outstanding of their active loans but the excluded one,
+// read within the transaction holding the lock
+func (s *loanService) lockExposure(ctx context.Context, tx *gorm.DB, userID string, excludedLoanID string) (float64, error) {
+	loanRepo := s.uow.LoanRepository(tx)
+	err := loanRepo.LockUserLoans(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	loans, err := loanRepo.GetActiveLoansByUserID(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	paymentRepo := s.uow.PaymentRepository(tx)
+	exposure := float64(0)
+	for _, loan := range loans {
+		if loan.ID == excludedLoanID {
+			continue
+		}
+
+		payments, err := paymentRepo.GetPaymentByLoanID(ctx, loan.ID)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+		exposure = exposure + s.sumOutstanding(loan, payments)
+	}
+
+	return exposure, nil
+}
+
+func (s *loanService) generatePayments(schedule *entities.PaymentSchedule, now time.Time) []*entities.Payment {
 	var payments []*entities.Payment
-	amount := entities.LOAN_AMOUNT/entities.PAYMENT_WEEKS + (entities.LOAN_AMOUNT*entities.LOAN_INTEREST_RATE)/entities.PAYMENT_WEEKS
-	for i := 0; i < entities.PAYMENT_WEEKS; i++ {
+	principal := schedule.Principal / float64(schedule.Tenor)
+	interest := schedule.Interest / float64(schedule.Tenor)
+	for i := 0; i < schedule.Tenor; i++ {
 		startAt := now.AddDate(0, 0, 7*i)
 		endAt := startAt.AddDate(0, 0, 7).Add(-time.Nanosecond)
 		uuid, _ := uuid.NewUUID()
 		payments = append(payments, &entities.Payment{
-			ID:      uuid.String(),
-			LoanID:  loanID,
-			Amount:  amount,
-			StartAt: &startAt,
-			EndAt:   &endAt,
-			PaidAt:  nil,
+			ID:         uuid.String(),
+			LoanID:     schedule.LoanID,
+			ScheduleID: schedule.ID,
+			Amount:     principal + interest,
+			Principal:  principal,
+			Interest:   interest,
+			StartAt:    &startAt,
+			EndAt:      &endAt,
+			PaidAt:     nil,
 		})
 	}
 
 	return payments
 }
 
-func (s *loanService) getActiveLoan(ctx context.Context, userID string) (*entities.Loan, error) {
+// toInstallment derives the status of an installment: paid once settled, due within its window,
+// overdue after its window and upcoming before it. An installment is due at the end of its window.
+func (s *loanService) toInstallment(sequence int, payment *entities.Payment, now time.Time) *entities.Installment {
+	installment := &entities.Installment{
+		Sequence: sequence,
+		Payment:  payment,
+		DueAt:    payment.EndAt,
+		Status:   entities.INSTALLMENT_STATUS_UPCOMING,
+	}
+
+	switch {
+	case payment.PaidAt != nil:
+		installment.AmountPaid = payment.Amount
+		installment.Status = entities.INSTALLMENT_STATUS_PAID
+	case payment.EndAt.Before(now):
+		installment.Status = entities.INSTALLMENT_STATUS_OVERDUE
+	case !payment.StartAt.After(now):
+		installment.Status = entities.INSTALLMENT_STATUS_DUE
+	}
+
+	return installment
+}
+
+// calculateDisclosure computes APR and effective annual rate with each installment repaid at the end of its
+// window, measured in whole days from the disbursement. No upfront fee is charged on disbursement.
+func (s *loanService) calculateDisclosure(principal float64, payments []*entities.Payment, disbursedAt time.Time) (*finmath.Disclosure, error) {
+	var installments []finmath.CashFlow
+	for _, payment := range payments {
+		days := math.Round(payment.EndAt.Add(time.Nanosecond).Sub(disbursedAt).Hours() / 24)
+		installments = append(installments, finmath.CashFlow{
+			Period: days / 7,
+			Amount: payment.Amount,
+		})
+	}
+
+	return finmath.Disclose(principal, 0, installments, entities.WEEKS_PER_YEAR)
+}
+
+// getRestructuredPayments returns the unpaid installments replaced by a restructure, the principal carried
+// into the new schedule and the start of the new schedule. Overdue installments stay due unless their
+// arrears are capitalised, in which case their full amount becomes principal of the new schedule.
+func (s *loanService) getRestructuredPayments(payments []*entities.Payment, capitaliseArrears bool, now time.Time) ([]*entities.Payment, float64, time.Time) {
+	var restructured []*entities.Payment
+	principal := float64(0)
+	startAt := now
+	hasStartAt := false
+	for _, payment := range payments {
+		if payment.PaidAt != nil {
+			continue
+		}
+
+		isOverdue := payment.EndAt.Before(now)
+		if isOverdue && !capitaliseArrears {
+			continue
+		}
+
+		restructured = append(restructured, payment)
+		if isOverdue {
+			principal = principal + payment.Amount
+			continue
+		}
+
+		principal = principal + payment.Principal
+		if !hasStartAt {
+			startAt = *payment.StartAt
+			hasStartAt = true
+		}
+	}
+
+	return restructured, principal, startAt
+}
+
+func (s *loanService) getActiveLoans(ctx context.Context, userID string) ([]*entities.Loan, error) {
 	loans, err := s.loanRepo.GetActiveLoansByUserID(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
 	}
 
-	var loan *entities.Loan
-	if len(loans) > 0 {
-		loan = loans[0]
-	} else {
-		return nil, fmt.Errorf("%w: %s", errorhandler.BadRequestError, errors.New("Active loan not found"))
+	if len(loans) == 0 {
+		return nil, errorhandler.BadRequest(errorhandler.REASON_NO_ACTIVE_LOAN, "Active loan not found")
+	}
+
+	return loans, nil
+}
+
+func (s *loanService) getLoan(ctx context.Context, userID string, loanID string) (*entities.Loan, error) {
+	loan, err := s.loanRepo.GetLoanByID(ctx, loanID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errorhandler.NotFound(errorhandler.REASON_LOAN_NOT_FOUND, "loan not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	if loan.UserID != userID {
+		return nil, errorhandler.NotFound(errorhandler.REASON_LOAN_NOT_FOUND, "loan not found")
 	}
 
 	return loan, nil
 }
 
+func (s *loanService) calculateOutstanding(ctx context.Context, loan *entities.Loan) (*entities.Outstanding, error) {
+	payments, err := s.paymentRepo.GetPaymentByLoanID(ctx, loan.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return &entities.Outstanding{
+		LoanID:      loan.ID,
+		Outstanding: s.sumOutstanding(loan, payments),
+	}, nil
+}
+
+// sumOutstanding is what the borrower still owes on the loan, nothing once a top up paid it off
+func (s *loanService) sumOutstanding(loan *entities.Loan, payments []*entities.Payment) float64 {
+	if loan.Status == entities.LOAN_STATUS_REFINANCED {
+		return 0
+	}
+
+	outstanding := loan.Amount + loan.Interest + loan.Fee
+	for _, payment := range payments {
+		if payment.PaidAt != nil {
+			outstanding = outstanding - payment.Amount
+		}
+	}
+
+	return outstanding
+}
+
+func (s *loanService) calculateIsDelinquent(ctx context.Context, loan *entities.Loan) (*entities.IsDelinquent, error) {
+	payments, err := s.paymentRepo.GetPaymentByLoanID(ctx, loan.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	if len(payments) == 0 {
+		return &entities.IsDelinquent{LoanID: loan.ID}, nil
+	}
+
+	return &entities.IsDelinquent{
+		LoanID:       loan.ID,
+		IsDelinquent: s.compareDelinquent(payments),
+	}, nil
+}
+
+// compareDelinquent reports whether the earliest unpaid installment is more than two weeks past its end.
+// Deferred installments are moved past the payment holiday, so a deferred period never counts as missed.
 func (s *loanService) compareDelinquent(payments []*entities.Payment) bool {
 	now := time.Now()
 
-	lastPaidIdx := 0
-	for i, payment := range payments {
+	for _, payment := range payments {
 		if payment.PaidAt != nil {
-			lastPaidIdx = i
+			continue
+		}
+
+		dueDate := payment.EndAt.AddDate(0, 0, entities.LOAN_DELINQUENT_DAYS)
+		return now.After(dueDate)
+	}
+
+	return false
+}
+
+// markDelinquent sets delinquent_at and writes the audit event and LoanBecameDelinquent in one transaction
+func (s *loanService) markDelinquent(ctx context.Context, loan *entities.Loan, now time.Time) error {
+	payments, err := s.paymentRepo.GetPaymentByLoanID(ctx, loan.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	event := &entities.LoanBecameDelinquentEvent{LoanID: loan.ID, UserID: loan.UserID}
+	for _, payment := range payments {
+		if payment.PaidAt == nil {
+			event.OverdueSince = *payment.EndAt
+			event.DaysPastDue = int(now.Sub(*payment.EndAt).Hours() / 24)
 			break
 		}
 	}
 
-	lastUnpaidIdx := lastPaidIdx
-	if len(payments)-1 > lastPaidIdx+1 {
-		lastUnpaidIdx = lastPaidIdx + 1
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.uow.LoanRepository(tx).UpdateDelinquentAtLoanByID(ctx, loan.ID, &now)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	delinquent := *loan
+	delinquent.DelinquentAt = &now
+	err = recordAuditEvent(ctx, s.uow, tx, &entities.AuditEvent{
+		LoanID:     &loan.ID,
+		Action:     entities.AUDIT_ACTION_LOAN_DELINQUENT,
+		EntityType: entities.AUDIT_ENTITY_LOAN,
+		EntityID:   loan.ID,
+	}, loan, &delinquent)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = recordOutboxEvent(ctx, s.uow, tx, loan.ID, entities.EVENT_LOAN_BECAME_DELINQUENT, event)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.uow.Commit(tx)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	loan.DelinquentAt = &now
+	return nil
+}
+
+// clearDelinquent unsets delinquent_at of a loan back on schedule and writes the audit event in one transaction
+func (s *loanService) clearDelinquent(ctx context.Context, loan *entities.Loan) error {
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.uow.LoanRepository(tx).UpdateDelinquentAtLoanByID(ctx, loan.ID, nil)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	cleared := *loan
+	cleared.DelinquentAt = nil
+	err = recordAuditEvent(ctx, s.uow, tx, &entities.AuditEvent{
+		LoanID:     &loan.ID,
+		Action:     entities.AUDIT_ACTION_DELINQUENCY_CLEARED,
+		EntityType: entities.AUDIT_ENTITY_LOAN,
+		EntityID:   loan.ID,
+	}, loan, &cleared)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.uow.Commit(tx)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	loan.DelinquentAt = nil
+	return nil
+}
+
+// chargeLateFee adds the fee to the installment and its loan and writes the audit event and LateFeeCharged in one transaction,
+// false when the installment was paid or charged since it was read
+func (s *loanService) chargeLateFee(ctx context.Context, payment *entities.Payment, fee float64, now time.Time) (bool, error) {
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	loanRepo := s.uow.LoanRepository(tx)
+
+	loan, err := loanRepo.GetLoanByID(ctx, payment.LoanID)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return false, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	// charged under the lock of the borrower, an installment a restructure or top up cancelled meanwhile is left alone
+	err = loanRepo.LockUserLoans(ctx, loan.UserID)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return false, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	ok, err := s.uow.PaymentRepository(tx).ChargeLateFeePayment(ctx, payment.ID, fee, now)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return false, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+	if !ok {
+		s.uow.Rollback(tx)
+		return false, nil
+	}
+
+	err = loanRepo.AddFeeLoanByID(ctx, loan.ID, fee)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return false, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	before := *payment
+	payment.Amount = payment.Amount + fee
+	payment.Fee = payment.Fee + fee
+	payment.LateFee = fee
+	payment.LateFeeAt = &now
+	err = recordAuditEvent(ctx, s.uow, tx, &entities.AuditEvent{
+		LoanID:     &loan.ID,
+		Action:     entities.AUDIT_ACTION_LATE_FEE_CHARGED,
+		EntityType: entities.AUDIT_ENTITY_PAYMENT,
+		EntityID:   payment.ID,
+	}, &before, payment)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return false, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = recordOutboxEvent(ctx, s.uow, tx, loan.ID, entities.EVENT_LATE_FEE_CHARGED, &entities.LateFeeChargedEvent{
+		LoanID:    loan.ID,
+		UserID:    loan.UserID,
+		PaymentID: payment.ID,
+		Fee:       fee,
+		Amount:    payment.Amount,
+		DueAt:     *payment.EndAt,
+		ChargedAt: now,
+	})
+	if err != nil {
+		s.uow.Rollback(tx)
+		return false, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.uow.Commit(tx)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return true, nil
+}
+
+// getDeferredPayments returns the first upcoming unpaid installments to defer and the unpaid installments after them.
+func (s *loanService) getDeferredPayments(payments []*entities.Payment, installments int, now time.Time) ([]*entities.Payment, []*entities.Payment) {
+	var deferred []*entities.Payment
+	var later []*entities.Payment
+	for _, payment := range payments {
+		if payment.PaidAt != nil || payment.EndAt.Before(now) {
+			continue
+		}
+
+		if len(deferred) < installments {
+			deferred = append(deferred, payment)
+		} else {
+			later = append(later, payment)
+		}
+	}
+
+	return deferred, later
+}
+
+// deferPayments pushes the deferred installments and every later one back by the deferred periods, leaving the windows
+// of the deferred installments free as the payment holiday. The deferral fee and extra interest are charged on the first deferred installment.
+func (s *loanService) deferPayments(deferral *entities.PaymentDeferral, deferred []*entities.Payment, later []*entities.Payment) {
+	shift := func(payment *entities.Payment) {
+		startAt := payment.StartAt.AddDate(0, 0, 7*deferral.Installments)
+		endAt := payment.EndAt.AddDate(0, 0, 7*deferral.Installments)
+		payment.StartAt = &startAt
+		payment.EndAt = &endAt
 	}
 
-	lastPaid := payments[lastUnpaidIdx]
+	for _, payment := range deferred {
+		shift(payment)
+		payment.DeferralID = &deferral.ID
+	}
+	for _, payment := range later {
+		shift(payment)
+	}
 
-	dueDate := lastPaid.EndAt.AddDate(0, 0, 14)
-	return now.After(dueDate)
+	deferred[0].Fee = deferred[0].Fee + deferral.Fee
+	deferred[0].Interest = deferred[0].Interest + deferral.Interest
+	deferred[0].Amount = deferred[0].Amount + deferral.Fee + deferral.Interest
 }
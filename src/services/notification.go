@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/verizhang/billing-engine/config"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/notifications"
+	"github.com/verizhang/billing-engine/src/repositories"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"github.com/verizhang/billing-engine/src/utils/pagination"
+	"gorm.io/gorm"
+	"time"
+)
+
+type NotificationService interface {
+	SendPaymentReminders(ctx context.Context) ([]*entities.NotificationDelivery, error)
+	GetNotificationPreference(ctx context.Context, userID string) (*entities.NotificationPreference, error)
+	UpdateNotificationPreference(ctx context.Context, preference *entities.NotificationPreference) (*entities.NotificationPreference, error)
+	ListNotificationDeliveries(ctx context.Context, filter *entities.NotificationDeliveryFilter, pageSize int, pageToken string) (*entities.NotificationDeliveryPage, error)
+}
+
+type notificationService struct {
+	cfg            config.Config
+	uow            repositories.UnitOfWork
+	paymentService PaymentService
+	loanRepo       repositories.LoanRepository
+	paymentRepo    repositories.PaymentRepository
+	preferenceRepo repositories.NotificationPreferenceRepository
+	deliveryRepo   repositories.NotificationDeliveryRepository
+	channels       map[string]notifications.Channel
+	templates      *notifications.Templates
+}
+
+func NewNotificationService(cfg config.Config, uow repositories.UnitOfWork, paymentService PaymentService, loanRepo repositories.LoanRepository, paymentRepo repositories.PaymentRepository, preferenceRepo repositories.NotificationPreferenceRepository, deliveryRepo repositories.NotificationDeliveryRepository, channels map[string]notifications.Channel, templates *notifications.Templates) NotificationService {
+	return &notificationService{
+		cfg:            cfg,
+		uow:            uow,
+		paymentService: paymentService,
+		loanRepo:       loanRepo,
+		paymentRepo:    paymentRepo,
+		preferenceRepo: preferenceRepo,
+		deliveryRepo:   deliveryRepo,
+		channels:       channels,
+		templates:      templates,
+	}
+}
+
+// SendPaymentReminders runs the payment_reminders job: it publishes PaymentDue for the installments ending within PAYMENT_REMINDER_DAYS
+// and messages their borrowers, a failure of either does not hold back the other. It returns the deliveries logged
+func (s *notificationService) SendPaymentReminders(ctx context.Context) ([]*entities.NotificationDelivery, error) {
+	_, eventErr := s.paymentService.SendPaymentReminders(ctx)
+	deliveries, err := s.messageBorrowers(ctx)
+
+	return deliveries, errors.Join(eventErr, err)
+}
+
+// GetNotificationPreference returns the preference of the user, the default one when the user never set it
+func (s *notificationService) GetNotificationPreference(ctx context.Context, userID string) (*entities.NotificationPreference, error) {
+	preference, err := s.preferenceRepo.GetNotificationPreferenceByUserID(ctx, userID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &entities.NotificationPreference{UserID: userID, Locale: s.cfg.NotificationDefaultLocale, OptOuts: []string{}}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return preference, nil
+}
+
+// UpdateNotificationPreference replaces the preference of the user
+func (s *notificationService) UpdateNotificationPreference(ctx context.Context, preference *entities.NotificationPreference) (*entities.NotificationPreference, error) {
+	if preference.Locale == "" {
+		preference.Locale = s.cfg.NotificationDefaultLocale
+	}
+	if !s.templates.HasLocale(preference.Locale) {
+		return nil, errorhandler.InvalidField("locale", fmt.Sprintf("no templates for locale %s", preference.Locale))
+	}
+	for _, channel := range preference.OptOuts {
+		if !notifications.IsValidChannel(channel) {
+			return nil, errorhandler.InvalidField("optOuts", fmt.Sprintf("unknown channel %s", channel))
+		}
+	}
+	if preference.OptOuts == nil {
+		preference.OptOuts = []string{}
+	}
+
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	preferenceRepo := s.uow.NotificationPreferenceRepository(tx)
+	var before any
+	current, err := preferenceRepo.GetNotificationPreferenceByUserID(ctx, preference.UserID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+	if current != nil {
+		before = current
+	}
+
+	now := time.Now()
+	preference.CreatedAt = now
+	preference.UpdatedAt = now
+	err = preferenceRepo.SaveNotificationPreference(ctx, preference)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = recordAuditEvent(ctx, s.uow, tx, &entities.AuditEvent{
+		Action:     entities.AUDIT_ACTION_NOTIFICATION_PREFERENCE_UPDATED,
+		EntityType: entities.AUDIT_ENTITY_NOTIFICATION_PREFERENCE,
+		EntityID:   preference.UserID,
+	}, before, preference)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.uow.Commit(tx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return preference, nil
+}
+
+func (s *notificationService) ListNotificationDeliveries(ctx context.Context, filter *entities.NotificationDeliveryFilter, pageSize int, pageToken string) (*entities.NotificationDeliveryPage, error) {
+	cursor, err := pagination.DecodeCursor(pageToken)
+	if err != nil {
+		return nil, errorhandler.InvalidField("pageToken", err.Error())
+	}
+
+	pageSize = pagination.PageSize(pageSize)
+	deliveries, err := s.deliveryRepo.GetNotificationDeliveries(ctx, filter, cursor, pageSize+1)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	page := &entities.NotificationDeliveryPage{Deliveries: deliveries}
+	if len(deliveries) > pageSize {
+		page.Deliveries = deliveries[:pageSize]
+		last := page.Deliveries[pageSize-1]
+		page.NextPageToken = pagination.EncodeCursor(last.CreatedAt, last.ID)
+	}
+
+	return page, nil
+}
@@ -0,0 +1,313 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/verizhang/billing-engine/config"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/notifications"
+	mocks "github.com/verizhang/billing-engine/src/repositories/mocks"
+	"github.com/verizhang/billing-engine/src/services"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"gorm.io/gorm"
+)
+
+var notificationConfig = config.Config{
+	SchedulerTimezone:         "UTC",
+	PaymentReminderDays:       3,
+	NotificationDefaultLocale: "en",
+	NotificationOverdueDays:   1,
+	NotificationMaxAttempts:   3,
+}
+
+type fakeChannel struct {
+	name string
+	err  error
+	sent []*notifications.Message
+}
+
+func (c *fakeChannel) Name() string {
+	return c.name
+}
+
+func (c *fakeChannel) Send(ctx context.Context, msg *notifications.Message) error {
+	c.sent = append(c.sent, msg)
+	return c.err
+}
+
+// reminderPaymentService publishes the PaymentDue events of the reminders
+type reminderPaymentService struct {
+	services.PaymentService
+	err   error
+	calls int
+}
+
+func (s *reminderPaymentService) SendPaymentReminders(ctx context.Context) ([]*entities.Payment, error) {
+	s.calls++
+	return nil, s.err
+}
+
+func TestNotificationService_SendPaymentReminders(t *testing.T) {
+	templates, err := notifications.LoadTemplates("", "en")
+	assert.NoError(t, err)
+
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	upcomingAt := today.AddDate(0, 0, 2).Add(10 * time.Hour)
+	dueAt := today.Add(10 * time.Hour)
+	overdueAt := today.AddDate(0, 0, -2).Add(10 * time.Hour)
+	reference := "8800000012"
+
+	setup := func(events *reminderPaymentService, channels ...*fakeChannel) (services.NotificationService, *mocks.PaymentRepository, *mocks.NotificationDeliveryRepository) {
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		preferenceRepo := new(mocks.NotificationPreferenceRepository)
+		deliveryRepo := new(mocks.NotificationDeliveryRepository)
+
+		// every window gets the installment ending within it
+		paymentRepo.On("GetUnpaidPaymentsEndingBetween", mock.Anything, mock.Anything, mock.Anything).Return(func(ctx context.Context, from time.Time, to time.Time) ([]*entities.Payment, error) {
+			switch {
+			case !upcomingAt.Before(from) && upcomingAt.Before(to):
+				return []*entities.Payment{{ID: "payment-upcoming", LoanID: "loan-1", Amount: 1500000, EndAt: &upcomingAt}}, nil
+			case !dueAt.Before(from) && dueAt.Before(to):
+				return []*entities.Payment{{ID: "payment-due", LoanID: "loan-2", Amount: 1500000, EndAt: &dueAt}}, nil
+			case !overdueAt.Before(from) && overdueAt.Before(to):
+				return []*entities.Payment{{ID: "payment-overdue", LoanID: "loan-1", Amount: 1500000, EndAt: &overdueAt}}, nil
+			}
+			return nil, nil
+		})
+		loanRepo.On("GetLoanByID", mock.Anything, "loan-1").Return(&entities.Loan{ID: "loan-1", UserID: "user-1", PaymentReference: &reference}, nil)
+		loanRepo.On("GetLoanByID", mock.Anything, "loan-2").Return(&entities.Loan{ID: "loan-2", UserID: "user-2"}, nil)
+		preferenceRepo.On("GetNotificationPreferenceByUserID", mock.Anything, "user-1").Return(&entities.NotificationPreference{
+			UserID:  "user-1",
+			Locale:  "id",
+			Email:   "borrower@example.com",
+			OptOuts: []string{notifications.CHANNEL_SMS},
+		}, nil)
+		preferenceRepo.On("GetNotificationPreferenceByUserID", mock.Anything, "user-2").Return(nil, gorm.ErrRecordNotFound)
+
+		byName := map[string]notifications.Channel{}
+		for _, channel := range channels {
+			byName[channel.name] = channel
+		}
+		deliveryRepo.On("UpdateNotificationDeliveryStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+		service := services.NewNotificationService(notificationConfig, nil, events, loanRepo, paymentRepo, preferenceRepo, deliveryRepo, byName, templates)
+		return service, paymentRepo, deliveryRepo
+	}
+
+	t.Run("reminds each window through the reachable channels not opted out of", func(t *testing.T) {
+		smtp := &fakeChannel{name: notifications.CHANNEL_SMTP}
+		sms := &fakeChannel{name: notifications.CHANNEL_SMS}
+		log := &fakeChannel{name: notifications.CHANNEL_LOG}
+		service, paymentRepo, deliveryRepo := setup(&reminderPaymentService{}, smtp, sms, log)
+		deliveryRepo.On("GetNotificationDeliveriesByPaymentID", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+		deliveryRepo.On("CreateNotificationDelivery", mock.Anything, mock.Anything).Return(nil)
+
+		deliveries, err := service.SendPaymentReminders(context.Background())
+
+		assert.NoError(t, err)
+		sent := map[string][]string{}
+		for _, delivery := range deliveries {
+			assert.Equal(t, entities.NOTIFICATION_STATUS_SENT, delivery.Status)
+			sent[delivery.PaymentID+"/"+delivery.Kind] = append(sent[delivery.PaymentID+"/"+delivery.Kind], delivery.Channel)
+		}
+		assert.Equal(t, map[string][]string{
+			"payment-upcoming/" + entities.NOTIFICATION_KIND_PAYMENT_UPCOMING: {notifications.CHANNEL_LOG, notifications.CHANNEL_SMTP},
+			"payment-due/" + entities.NOTIFICATION_KIND_PAYMENT_DUE:           {notifications.CHANNEL_LOG},
+			"payment-overdue/" + entities.NOTIFICATION_KIND_PAYMENT_OVERDUE:   {notifications.CHANNEL_LOG, notifications.CHANNEL_SMTP},
+		}, sent)
+		assert.Empty(t, sms.sent)
+		assert.Len(t, smtp.sent, 2)
+		assert.Equal(t, "borrower@example.com", smtp.sent[0].Recipient)
+		assert.True(t, strings.Contains(smtp.sent[0].Body, reference))
+		assert.Equal(t, "user-2", log.sent[1].Recipient)
+		paymentRepo.AssertNumberOfCalls(t, "GetUnpaidPaymentsEndingBetween", 3)
+	})
+
+	t.Run("messages the borrowers even when the events cannot be published", func(t *testing.T) {
+		log := &fakeChannel{name: notifications.CHANNEL_LOG}
+		events := &reminderPaymentService{err: errorhandler.InternalServerError}
+		service, _, deliveryRepo := setup(events, log)
+		deliveryRepo.On("GetNotificationDeliveriesByPaymentID", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+		deliveryRepo.On("CreateNotificationDelivery", mock.Anything, mock.Anything).Return(nil)
+
+		deliveries, err := service.SendPaymentReminders(context.Background())
+
+		assert.ErrorIs(t, err, errorhandler.InternalServerError)
+		assert.Equal(t, 1, events.calls)
+		assert.Len(t, deliveries, 3)
+		assert.Len(t, log.sent, 3)
+	})
+
+	t.Run("logs a failed send and carries on", func(t *testing.T) {
+		smtp := &fakeChannel{name: notifications.CHANNEL_SMTP, err: errors.New("connection refused")}
+		service, _, deliveryRepo := setup(&reminderPaymentService{}, smtp)
+		deliveryRepo.On("GetNotificationDeliveriesByPaymentID", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+		deliveryRepo.On("CreateNotificationDelivery", mock.Anything, mock.MatchedBy(func(delivery *entities.NotificationDelivery) bool {
+			return delivery.Status == entities.NOTIFICATION_STATUS_SENDING
+		})).Return(nil)
+
+		deliveries, err := service.SendPaymentReminders(context.Background())
+
+		assert.NoError(t, err)
+		assert.Len(t, deliveries, 2)
+		deliveryRepo.AssertNumberOfCalls(t, "CreateNotificationDelivery", 2)
+		deliveryRepo.AssertCalled(t, "UpdateNotificationDeliveryStatus", mock.Anything, deliveries[0].ID, entities.NOTIFICATION_STATUS_FAILED, mock.MatchedBy(func(message *string) bool {
+			return message != nil && *message == "connection refused"
+		}))
+	})
+
+	t.Run("does not send a reminder it could not log", func(t *testing.T) {
+		log := &fakeChannel{name: notifications.CHANNEL_LOG}
+		service, _, deliveryRepo := setup(&reminderPaymentService{}, log)
+		deliveryRepo.On("GetNotificationDeliveriesByPaymentID", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+		deliveryRepo.On("CreateNotificationDelivery", mock.Anything, mock.Anything).Return(errors.New("connection reset"))
+
+		deliveries, err := service.SendPaymentReminders(context.Background())
+
+		assert.ErrorIs(t, err, errorhandler.InternalServerError)
+		assert.Empty(t, deliveries)
+		assert.Empty(t, log.sent)
+	})
+
+	t.Run("carries on with the other borrowers when one fails", func(t *testing.T) {
+		log := &fakeChannel{name: notifications.CHANNEL_LOG}
+		service, _, deliveryRepo := setup(&reminderPaymentService{}, log)
+		deliveryRepo.On("GetNotificationDeliveriesByPaymentID", mock.Anything, "payment-upcoming", mock.Anything).Return(nil, errors.New("connection reset"))
+		deliveryRepo.On("GetNotificationDeliveriesByPaymentID", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+		deliveryRepo.On("CreateNotificationDelivery", mock.Anything, mock.Anything).Return(nil)
+
+		deliveries, err := service.SendPaymentReminders(context.Background())
+
+		assert.ErrorIs(t, err, errorhandler.InternalServerError)
+		assert.ErrorContains(t, err, "payment-upcoming")
+		assert.Len(t, deliveries, 2)
+		assert.Len(t, log.sent, 2)
+	})
+
+	t.Run("skips the channels already sent or failed too often", func(t *testing.T) {
+		smtp := &fakeChannel{name: notifications.CHANNEL_SMTP}
+		log := &fakeChannel{name: notifications.CHANNEL_LOG}
+		service, _, deliveryRepo := setup(&reminderPaymentService{}, smtp, log)
+		failed := &entities.NotificationDelivery{Channel: notifications.CHANNEL_LOG, Status: entities.NOTIFICATION_STATUS_FAILED}
+		deliveryRepo.On("GetNotificationDeliveriesByPaymentID", mock.Anything, mock.Anything, mock.Anything).Return([]*entities.NotificationDelivery{
+			{Channel: notifications.CHANNEL_SMTP, Status: entities.NOTIFICATION_STATUS_SENT},
+			failed, failed, failed,
+		}, nil)
+
+		deliveries, err := service.SendPaymentReminders(context.Background())
+
+		assert.NoError(t, err)
+		assert.Empty(t, deliveries)
+		assert.Empty(t, smtp.sent)
+		assert.Empty(t, log.sent)
+		deliveryRepo.AssertNotCalled(t, "CreateNotificationDelivery", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ignores a delivery logged by another run", func(t *testing.T) {
+		log := &fakeChannel{name: notifications.CHANNEL_LOG}
+		service, _, deliveryRepo := setup(&reminderPaymentService{}, log)
+		deliveryRepo.On("GetNotificationDeliveriesByPaymentID", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+		deliveryRepo.On("CreateNotificationDelivery", mock.Anything, mock.Anything).Return(gorm.ErrDuplicatedKey)
+
+		deliveries, err := service.SendPaymentReminders(context.Background())
+
+		assert.NoError(t, err)
+		assert.Empty(t, deliveries)
+	})
+}
+
+func TestNotificationService_NotificationPreference(t *testing.T) {
+	templates, err := notifications.LoadTemplates("", "en")
+	assert.NoError(t, err)
+
+	setup := func() (services.NotificationService, *mocks.NotificationPreferenceRepository, *mocks.AuditEventRepository) {
+		uow := new(mocks.UnitOfWork)
+		preferenceRepo := new(mocks.NotificationPreferenceRepository)
+		mockTx := &gorm.DB{}
+
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		uow.On("NotificationPreferenceRepository", mockTx).Return(preferenceRepo)
+		auditRepo := expectAuditEvents(uow, mockTx)
+		return services.NewNotificationService(notificationConfig, uow, nil, nil, nil, preferenceRepo, nil, nil, templates), preferenceRepo, auditRepo
+	}
+
+	t.Run("defaults a user without a preference", func(t *testing.T) {
+		service, preferenceRepo, _ := setup()
+		preferenceRepo.On("GetNotificationPreferenceByUserID", mock.Anything, "user-1").Return(nil, gorm.ErrRecordNotFound)
+
+		preference, err := service.GetNotificationPreference(context.Background(), "user-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "en", preference.Locale)
+		assert.Empty(t, preference.OptOuts)
+	})
+
+	t.Run("saves the preference with the default locale", func(t *testing.T) {
+		service, preferenceRepo, auditRepo := setup()
+		preferenceRepo.On("GetNotificationPreferenceByUserID", mock.Anything, "user-1").Return(nil, gorm.ErrRecordNotFound)
+		preferenceRepo.On("SaveNotificationPreference", mock.Anything, mock.MatchedBy(func(preference *entities.NotificationPreference) bool {
+			return preference.UserID == "user-1" && preference.Locale == "en" && preference.Phone == "+6281234567890"
+		})).Return(nil)
+
+		preference, err := service.UpdateNotificationPreference(context.Background(), &entities.NotificationPreference{UserID: "user-1", Phone: "+6281234567890"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{}, preference.OptOuts)
+		preferenceRepo.AssertExpectations(t)
+		auditRepo.AssertCalled(t, "CreateAuditEvent", mock.Anything, mock.MatchedBy(func(event *entities.AuditEvent) bool {
+			return event.Action == entities.AUDIT_ACTION_NOTIFICATION_PREFERENCE_UPDATED && event.EntityID == "user-1" && event.Before == nil && event.After != nil
+		}))
+	})
+
+	t.Run("records the preference it replaces", func(t *testing.T) {
+		service, preferenceRepo, auditRepo := setup()
+		preferenceRepo.On("GetNotificationPreferenceByUserID", mock.Anything, "user-1").Return(&entities.NotificationPreference{UserID: "user-1", Locale: "en", OptOuts: []string{"sms"}}, nil)
+		preferenceRepo.On("SaveNotificationPreference", mock.Anything, mock.Anything).Return(nil)
+
+		_, err := service.UpdateNotificationPreference(context.Background(), &entities.NotificationPreference{UserID: "user-1", OptOuts: []string{"smtp"}})
+
+		assert.NoError(t, err)
+		auditRepo.AssertCalled(t, "CreateAuditEvent", mock.Anything, mock.MatchedBy(func(event *entities.AuditEvent) bool {
+			return strings.Contains(*event.Before, "sms") && strings.Contains(*event.After, "smtp")
+		}))
+	})
+
+	t.Run("rejects an unknown locale or channel", func(t *testing.T) {
+		service, preferenceRepo, _ := setup()
+
+		_, err := service.UpdateNotificationPreference(context.Background(), &entities.NotificationPreference{UserID: "user-1", Locale: "fr"})
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+
+		_, err = service.UpdateNotificationPreference(context.Background(), &entities.NotificationPreference{UserID: "user-1", OptOuts: []string{"push"}})
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+		preferenceRepo.AssertNotCalled(t, "SaveNotificationPreference", mock.Anything, mock.Anything)
+	})
+}
+
+func TestNotificationService_ListNotificationDeliveries(t *testing.T) {
+	deliveryRepo := new(mocks.NotificationDeliveryRepository)
+	service := services.NewNotificationService(notificationConfig, nil, nil, nil, nil, nil, deliveryRepo, nil, nil)
+	filter := &entities.NotificationDeliveryFilter{UserID: "user-1"}
+	createdAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	deliveryRepo.On("GetNotificationDeliveries", mock.Anything, filter, mock.Anything, 2).Return([]*entities.NotificationDelivery{
+		{ID: "delivery-2", CreatedAt: createdAt},
+		{ID: "delivery-1", CreatedAt: createdAt.Add(-time.Hour)},
+	}, nil)
+
+	page, err := service.ListNotificationDeliveries(context.Background(), filter, 1, "")
+
+	assert.NoError(t, err)
+	assert.Len(t, page.Deliveries, 1)
+	assert.NotEmpty(t, page.NextPageToken)
+
+	_, err = service.ListNotificationDeliveries(context.Background(), filter, 1, "not a token")
+	assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+}
@@ -0,0 +1,199 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/notifications"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"gorm.io/gorm"
+	"math"
+	"sort"
+	"time"
+)
+
+// reminderRun caches the loans and preferences read by one run of the reminders
+type reminderRun struct {
+	today       time.Time
+	loans       map[string]*entities.Loan
+	preferences map[string]*entities.NotificationPreference
+	deliveries  []*entities.NotificationDelivery
+}
+
+// messageBorrowers messages the borrowers of the unpaid installments ending within PAYMENT_REMINDER_DAYS, ending today
+// and overdue for NOTIFICATION_OVERDUE_DAYS, once per installment, kind and channel. Days are counted in SCHEDULER_TIMEZONE.
+// A failed message is logged and tried again by the next run up to NOTIFICATION_MAX_ATTEMPTS, it returns the deliveries logged
+func (s *notificationService) messageBorrowers(ctx context.Context) ([]*entities.NotificationDelivery, error) {
+	location, err := time.LoadLocation(s.cfg.SchedulerTimezone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	now := time.Now().In(location)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location)
+	windows := []struct {
+		kind string
+		from time.Time
+		to   time.Time
+	}{
+		{kind: entities.NOTIFICATION_KIND_PAYMENT_UPCOMING, from: today.AddDate(0, 0, 1), to: today.AddDate(0, 0, s.cfg.PaymentReminderDays+1)},
+		{kind: entities.NOTIFICATION_KIND_PAYMENT_DUE, from: today, to: today.AddDate(0, 0, 1)},
+		// overdue installments are looked for until the loan turns delinquent
+		{kind: entities.NOTIFICATION_KIND_PAYMENT_OVERDUE, from: today.AddDate(0, 0, -entities.LOAN_DELINQUENT_DAYS), to: today.AddDate(0, 0, 1-s.cfg.NotificationOverdueDays)},
+	}
+
+	run := &reminderRun{
+		today:       today,
+		loans:       map[string]*entities.Loan{},
+		preferences: map[string]*entities.NotificationPreference{},
+	}
+	// a borrower whose reminder cannot be prepared or logged does not hold back the others, the failures are returned together
+	var failures []error
+	for _, window := range windows {
+		payments, err := s.paymentRepo.GetUnpaidPaymentsEndingBetween(ctx, window.from, window.to)
+		if err != nil {
+			return run.deliveries, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+
+		for _, payment := range payments {
+			err = s.remindPayment(ctx, run, window.kind, payment)
+			if err != nil {
+				failures = append(failures, fmt.Errorf("payment %s: %w", payment.ID, err))
+			}
+		}
+	}
+
+	return run.deliveries, errors.Join(failures...)
+}
+
+// remindPayment sends the reminder of kind for the installment through every channel the borrower can be reached by
+// and has not opted out of, skipping the channels it was sent through or failed on too often
+func (s *notificationService) remindPayment(ctx context.Context, run *reminderRun, kind string, payment *entities.Payment) error {
+	loan, ok := run.loans[payment.LoanID]
+	if !ok {
+		var err error
+		loan, err = s.loanRepo.GetLoanByID(ctx, payment.LoanID)
+		if err != nil {
+			return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+		run.loans[loan.ID] = loan
+	}
+
+	preference, ok := run.preferences[loan.UserID]
+	if !ok {
+		var err error
+		preference, err = s.GetNotificationPreference(ctx, loan.UserID)
+		if err != nil {
+			return err
+		}
+		run.preferences[loan.UserID] = preference
+	}
+
+	previous, err := s.deliveryRepo.GetNotificationDeliveriesByPaymentID(ctx, payment.ID, kind)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	dueAt := payment.EndAt.In(run.today.Location())
+	dueDate := time.Date(dueAt.Year(), dueAt.Month(), dueAt.Day(), 0, 0, 0, 0, run.today.Location())
+	// rounded, a day across a daylight saving change is not 24 hours long
+	days := int(math.Abs(math.Round(dueDate.Sub(run.today).Hours() / 24)))
+	reminder := notifications.PaymentReminder{
+		LoanID: loan.ID,
+		Amount: payment.Amount,
+		DueAt:  dueAt,
+		Days:   days,
+	}
+	if loan.PaymentReference != nil {
+		reminder.PaymentReference = *loan.PaymentReference
+	}
+
+	rendered, err := s.templates.Render(preference.Locale, kind, reminder)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	names := make([]string, 0, len(s.channels))
+	for name := range s.channels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		recipient := notificationRecipient(name, loan.UserID, preference)
+		if recipient == "" || preference.OptedOut(name) || !s.shouldSend(previous, name) {
+			continue
+		}
+
+		delivery := &entities.NotificationDelivery{
+			ID:        uuid.NewString(),
+			UserID:    loan.UserID,
+			LoanID:    loan.ID,
+			PaymentID: payment.ID,
+			Kind:      kind,
+			Channel:   name,
+			Locale:    rendered.Locale,
+			Recipient: recipient,
+			Subject:   rendered.Subject,
+			Body:      rendered.Body,
+			Status:    entities.NOTIFICATION_STATUS_SENDING,
+			CreatedAt: time.Now(),
+		}
+		// logged before sending, another run holding the channel or a failure to log means no message
+		err = s.deliveryRepo.CreateNotificationDelivery(ctx, delivery)
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+		run.deliveries = append(run.deliveries, delivery)
+
+		delivery.Status = entities.NOTIFICATION_STATUS_SENT
+		err = s.channels[name].Send(ctx, &notifications.Message{Recipient: recipient, Subject: rendered.Subject, Body: rendered.Body})
+		if err != nil {
+			message := err.Error()
+			delivery.Status = entities.NOTIFICATION_STATUS_FAILED
+			delivery.Error = &message
+		}
+
+		// the delivery stays sending when its outcome cannot be logged, so it is not sent again
+		err = s.deliveryRepo.UpdateNotificationDeliveryStatus(ctx, delivery.ID, delivery.Status, delivery.Error)
+		if err != nil {
+			return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// shouldSend tells whether the channel still owes the reminder, it was neither sent, being sent nor failed NOTIFICATION_MAX_ATTEMPTS times
+func (s *notificationService) shouldSend(previous []*entities.NotificationDelivery, channel string) bool {
+	failures := 0
+	for _, delivery := range previous {
+		if delivery.Channel != channel {
+			continue
+		}
+		if delivery.Status == entities.NOTIFICATION_STATUS_SENT || delivery.Status == entities.NOTIFICATION_STATUS_SENDING {
+			return false
+		}
+		failures++
+	}
+
+	return failures < s.cfg.NotificationMaxAttempts
+}
+
+// notificationRecipient is the address of the borrower on the channel, empty when the borrower gave none
+func notificationRecipient(channel string, userID string, preference *entities.NotificationPreference) string {
+	switch channel {
+	case notifications.CHANNEL_SMTP:
+		return preference.Email
+	case notifications.CHANNEL_SMS:
+		return preference.Phone
+	case notifications.CHANNEL_LOG:
+		return userID
+	}
+	return ""
+}
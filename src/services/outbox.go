@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"github.com/verizhang/billing-engine/config"
+	"github.com/verizhang/billing-engine/src/publishers"
+	"github.com/verizhang/billing-engine/src/repositories"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"time"
+)
+
+type OutboxService interface {
+	RelayOutboxEvents(ctx context.Context) (int, error)
+	FanOutOutboxEvents(ctx context.Context) (int, error)
+}
+
+type outboxService struct {
+	cfg        config.Config
+	uow        repositories.UnitOfWork
+	outboxRepo repositories.OutboxEventRepository
+	publisher  publishers.EventPublisher
+	// subscribers creates the webhook deliveries of an event, apart from the configured publisher so neither holds back the other
+	subscribers publishers.EventPublisher
+}
+
+func NewOutboxService(cfg config.Config, uow repositories.UnitOfWork, outboxRepo repositories.OutboxEventRepository, publisher publishers.EventPublisher, subscribers publishers.EventPublisher) OutboxService {
+	return &outboxService{
+		cfg:         cfg,
+		uow:         uow,
+		outboxRepo:  outboxRepo,
+		publisher:   publisher,
+		subscribers: subscribers,
+	}
+}
+
+// RelayOutboxEvents publishes a leased batch of pending events in position order and returns how many were published.
+// No transaction is open while publishing, an event is marked published only after its delivery and a crash in between publishes it again.
+// A failed event is retried after a backoff and holds back the later events of its loan meanwhile, after OutboxMaxAttempts it is parked
+func (s *outboxService) RelayOutboxEvents(ctx context.Context) (int, error) {
+	events, err := s.claimOutboxEvents(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	blocked := map[string]bool{}
+	var held []string
+	for _, event := range events {
+		if blocked[event.LoanID] {
+			held = append(held, event.ID)
+			continue
+		}
+
+		err = s.publisher.Publish(ctx, event)
+		if err != nil {
+			now := time.Now()
+			var parkedAt *time.Time
+			if event.Attempts+1 >= s.cfg.OutboxMaxAttempts {
+				parkedAt = &now
+			} else {
+				blocked[event.LoanID] = true
+			}
+			retryAt := now.Add(retryBackoff(s.cfg.OutboxBackoffBase, s.cfg.OutboxBackoffMax, event.Attempts+1))
+			err = s.outboxRepo.UpdateFailedOutboxEventByID(ctx, event.ID, err.Error(), retryAt, parkedAt)
+			if err != nil {
+				return published, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+			}
+			continue
+		}
+
+		err = s.outboxRepo.UpdatePublishedOutboxEventByID(ctx, event.ID, time.Now())
+		if err != nil {
+			return published, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+		published++
+	}
+
+	if len(held) > 0 {
+		err = s.outboxRepo.ReleaseOutboxEvents(ctx, held)
+		if err != nil {
+			return published, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+	}
+
+	return published, nil
+}
+
+// FanOutOutboxEvents creates the webhook deliveries of a batch of events in position order and returns how many events were fanned out.
+// It does not wait for the configured publisher, creating the deliveries is idempotent so two replicas fanning out the same event is harmless
+func (s *outboxService) FanOutOutboxEvents(ctx context.Context) (int, error) {
+	events, err := s.outboxRepo.GetOutboxEventsToFanOut(ctx, s.cfg.OutboxBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	fannedOut := 0
+	for _, event := range events {
+		err = s.subscribers.Publish(ctx, event)
+		if err != nil {
+			return fannedOut, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+
+		err = s.outboxRepo.UpdateFannedOutOutboxEventByID(ctx, event.ID, time.Now())
+		if err != nil {
+			return fannedOut, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+		fannedOut++
+	}
+
+	return fannedOut, nil
+}
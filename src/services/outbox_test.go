@@ -0,0 +1,188 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/verizhang/billing-engine/config"
+	"github.com/verizhang/billing-engine/src/entities"
+	mocks "github.com/verizhang/billing-engine/src/repositories/mocks"
+	"github.com/verizhang/billing-engine/src/services"
+	"gorm.io/gorm"
+)
+
+// expectOutboxEvents mocks writing the domain events of a change, not every change publishes one
+func expectOutboxEvents(uow *mocks.UnitOfWork, tx *gorm.DB) *mocks.OutboxEventRepository {
+	outboxRepo := new(mocks.OutboxEventRepository)
+	uow.On("OutboxEventRepository", tx).Return(outboxRepo).Maybe()
+	outboxRepo.On("GetLastOutboxEventByLoanID", mock.Anything, mock.Anything).Return(nil, gorm.ErrRecordNotFound).Maybe()
+	outboxRepo.On("CreateOutboxEvent", mock.Anything, mock.AnythingOfType("*entities.OutboxEvent")).Return(nil).Maybe()
+	return outboxRepo
+}
+
+// publisherFunc publishes with a function
+type publisherFunc func(ctx context.Context, event *entities.OutboxEvent) error
+
+func (f publisherFunc) Publish(ctx context.Context, event *entities.OutboxEvent) error {
+	return f(ctx, event)
+}
+
+func TestOutboxService_RelayOutboxEvents(t *testing.T) {
+	setup := func(events []*entities.OutboxEvent) (*mocks.UnitOfWork, *mocks.OutboxEventRepository, *gorm.DB) {
+		uow := new(mocks.UnitOfWork)
+		outboxRepo := new(mocks.OutboxEventRepository)
+		mockTx := &gorm.DB{}
+
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		uow.On("OutboxEventRepository", mockTx).Return(outboxRepo)
+		outboxRepo.On("TryLockRelay", mock.Anything).Return(true, nil)
+		outboxRepo.On("ClaimOutboxEvents", mock.Anything, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time"), 10).Return(events, nil)
+		outboxRepo.On("UpdatePublishedOutboxEventByID", mock.Anything, mock.Anything, mock.AnythingOfType("time.Time")).Return(nil)
+		outboxRepo.On("UpdateFailedOutboxEventByID", mock.Anything, mock.Anything, mock.Anything, mock.AnythingOfType("time.Time"), mock.Anything).Return(nil)
+		outboxRepo.On("ReleaseOutboxEvents", mock.Anything, mock.Anything).Return(nil)
+		return uow, outboxRepo, mockTx
+	}
+	cfg := config.Config{OutboxBatchSize: 10, OutboxLease: time.Minute, OutboxMaxAttempts: 3, OutboxBackoffBase: time.Second, OutboxBackoffMax: time.Minute}
+
+	t.Run("success publishes the claimed events in order after committing the claim", func(t *testing.T) {
+		events := []*entities.OutboxEvent{
+			{ID: "event1", LoanID: "loan1", Sequence: 1, Type: entities.EVENT_LOAN_CREATED},
+			{ID: "event2", LoanID: "loan2", Sequence: 1, Type: entities.EVENT_LOAN_CREATED},
+			{ID: "event3", LoanID: "loan1", Sequence: 2, Type: entities.EVENT_PAYMENT_MADE},
+		}
+		uow, outboxRepo, mockTx := setup(events)
+
+		var published []string
+		publisher := publisherFunc(func(ctx context.Context, event *entities.OutboxEvent) error {
+			uow.AssertCalled(t, "Commit", mockTx)
+			published = append(published, event.ID)
+			return nil
+		})
+
+		service := services.NewOutboxService(cfg, uow, outboxRepo, publisher, nil)
+		result, err := service.RelayOutboxEvents(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, result)
+		assert.Equal(t, []string{"event1", "event2", "event3"}, published)
+		outboxRepo.AssertNumberOfCalls(t, "UpdatePublishedOutboxEventByID", 3)
+		outboxRepo.AssertNotCalled(t, "ReleaseOutboxEvents", mock.Anything, mock.Anything)
+	})
+
+	t.Run("failed event backs off and holds back the later events of its loan", func(t *testing.T) {
+		events := []*entities.OutboxEvent{
+			{ID: "event1", LoanID: "loan1", Sequence: 1},
+			{ID: "event2", LoanID: "loan2", Sequence: 1},
+			{ID: "event3", LoanID: "loan1", Sequence: 2},
+		}
+		uow, outboxRepo, _ := setup(events)
+
+		var published []string
+		publisher := publisherFunc(func(ctx context.Context, event *entities.OutboxEvent) error {
+			if event.ID == "event1" {
+				return errors.New("connection refused")
+			}
+			published = append(published, event.ID)
+			return nil
+		})
+
+		service := services.NewOutboxService(cfg, uow, outboxRepo, publisher, nil)
+		result, err := service.RelayOutboxEvents(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result)
+		assert.Equal(t, []string{"event2"}, published)
+		outboxRepo.AssertCalled(t, "UpdateFailedOutboxEventByID", mock.Anything, "event1", "connection refused", mock.AnythingOfType("time.Time"), (*time.Time)(nil))
+		outboxRepo.AssertNotCalled(t, "UpdatePublishedOutboxEventByID", mock.Anything, "event3", mock.Anything)
+		outboxRepo.AssertCalled(t, "ReleaseOutboxEvents", mock.Anything, []string{"event3"})
+	})
+
+	t.Run("event failing its last attempt is parked and no longer holds back its loan", func(t *testing.T) {
+		events := []*entities.OutboxEvent{
+			{ID: "event1", LoanID: "loan1", Sequence: 1, Attempts: 2},
+			{ID: "event2", LoanID: "loan1", Sequence: 2},
+		}
+		uow, outboxRepo, _ := setup(events)
+
+		publisher := publisherFunc(func(ctx context.Context, event *entities.OutboxEvent) error {
+			if event.ID == "event1" {
+				return errors.New("payload rejected")
+			}
+			return nil
+		})
+
+		service := services.NewOutboxService(cfg, uow, outboxRepo, publisher, nil)
+		result, err := service.RelayOutboxEvents(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result)
+		outboxRepo.AssertCalled(t, "UpdateFailedOutboxEventByID", mock.Anything, "event1", "payload rejected", mock.AnythingOfType("time.Time"), mock.AnythingOfType("*time.Time"))
+		outboxRepo.AssertCalled(t, "UpdatePublishedOutboxEventByID", mock.Anything, "event2", mock.Anything)
+	})
+
+	t.Run("skips the batch while another relay holds the lock", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		outboxRepo := new(mocks.OutboxEventRepository)
+		mockTx := &gorm.DB{}
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Rollback", mockTx).Return(nil)
+		uow.On("OutboxEventRepository", mockTx).Return(outboxRepo)
+		outboxRepo.On("TryLockRelay", mock.Anything).Return(false, nil)
+
+		service := services.NewOutboxService(cfg, uow, outboxRepo, nil, nil)
+		result, err := service.RelayOutboxEvents(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, result)
+		outboxRepo.AssertNotCalled(t, "ClaimOutboxEvents", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestOutboxService_FanOutOutboxEvents(t *testing.T) {
+	t.Run("success fans out the events whatever the configured publisher does", func(t *testing.T) {
+		outboxRepo := new(mocks.OutboxEventRepository)
+		outboxRepo.On("GetOutboxEventsToFanOut", mock.Anything, 10).Return([]*entities.OutboxEvent{
+			{ID: "event1", LoanID: "loan1", Sequence: 1},
+			{ID: "event2", LoanID: "loan1", Sequence: 2},
+		}, nil)
+		outboxRepo.On("UpdateFannedOutOutboxEventByID", mock.Anything, mock.Anything, mock.AnythingOfType("time.Time")).Return(nil)
+
+		var fannedOut []string
+		subscribers := publisherFunc(func(ctx context.Context, event *entities.OutboxEvent) error {
+			fannedOut = append(fannedOut, event.ID)
+			return nil
+		})
+		failing := publisherFunc(func(ctx context.Context, event *entities.OutboxEvent) error {
+			return errors.New("connection refused")
+		})
+
+		service := services.NewOutboxService(config.Config{OutboxBatchSize: 10}, nil, outboxRepo, failing, subscribers)
+		result, err := service.FanOutOutboxEvents(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result)
+		assert.Equal(t, []string{"event1", "event2"}, fannedOut)
+		outboxRepo.AssertNumberOfCalls(t, "UpdateFannedOutOutboxEventByID", 2)
+	})
+
+	t.Run("error keeps the event to fan out again", func(t *testing.T) {
+		outboxRepo := new(mocks.OutboxEventRepository)
+		outboxRepo.On("GetOutboxEventsToFanOut", mock.Anything, 10).Return([]*entities.OutboxEvent{{ID: "event1", LoanID: "loan1", Sequence: 1}}, nil)
+
+		subscribers := publisherFunc(func(ctx context.Context, event *entities.OutboxEvent) error {
+			return errors.New("connection reset")
+		})
+
+		service := services.NewOutboxService(config.Config{OutboxBatchSize: 10}, nil, outboxRepo, nil, subscribers)
+		result, err := service.FanOutOutboxEvents(context.Background())
+
+		assert.Error(t, err)
+		assert.Equal(t, 0, result)
+		outboxRepo.AssertNotCalled(t, "UpdateFannedOutOutboxEventByID", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/repositories"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"github.com/verizhang/billing-engine/src/utils/requestid"
+	"gorm.io/gorm"
+	"time"
+)
+
+// recordOutboxEvent writes the event in the transaction of the change it announces, it is published only once the change is committed
+func recordOutboxEvent(ctx context.Context, uow repositories.UnitOfWork, tx *gorm.DB, loanID string, eventType string, data any) error {
+	outboxRepo := uow.OutboxEventRepository(tx)
+
+	last, err := outboxRepo.GetLastOutboxEventByLoanID(ctx, loanID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	sequence := int64(1)
+	if last != nil {
+		sequence = last.Sequence + 1
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	return outboxRepo.CreateOutboxEvent(ctx, &entities.OutboxEvent{
+		ID:        uuid.NewString(),
+		LoanID:    loanID,
+		Sequence:  sequence,
+		Type:      eventType,
+		Payload:   string(payload),
+		RequestID: requestid.FromContext(ctx),
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+// claimOutboxEvents leases a batch under the relay lock and commits at once, nothing is claimed while another relay claims
+func (s *outboxService) claimOutboxEvents(ctx context.Context) ([]*entities.OutboxEvent, error) {
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	outboxRepo := s.uow.OutboxEventRepository(tx)
+
+	locked, err := outboxRepo.TryLockRelay(ctx)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+	if !locked {
+		s.uow.Rollback(tx)
+		return nil, nil
+	}
+
+	now := time.Now()
+	events, err := outboxRepo.ClaimOutboxEvents(ctx, now, now.Add(s.cfg.OutboxLease), s.cfg.OutboxBatchSize)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.uow.Commit(tx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return events, nil
+}
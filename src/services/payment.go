@@ -5,13 +5,22 @@ import (
 	"errors"
 	"fmt"
 	"github.com/verizhang/billing-engine/config"
+	"github.com/verizhang/billing-engine/src/entities"
 	"github.com/verizhang/billing-engine/src/repositories"
 	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"github.com/verizhang/billing-engine/src/utils/pagination"
+	"gorm.io/gorm"
+	"math"
 	"time"
 )
 
 type PaymentService interface {
 	MakePayment(ctx context.Context, userID string) error
+	MakePaymentByLoanID(ctx context.Context, userID string, loanID string) error
+	ListPayments(ctx context.Context, filter *entities.PaymentTransactionFilter, pageSize int, pageToken string) (*entities.PaymentTransactionPage, error)
+	PostProviderPayment(ctx context.Context, payment *entities.ProviderPayment) (*entities.ExternalPaymentResult, error)
+	PostStatementPayment(ctx context.Context, loanID string, key string, amount float64) (*entities.ExternalPaymentResult, error)
+	SendPaymentReminders(ctx context.Context) ([]*entities.Payment, error)
 }
 
 type paymentService struct {
@@ -19,14 +28,16 @@ type paymentService struct {
 	paymentRepo repositories.PaymentRepository
 	uow         repositories.UnitOfWork
 	loanRepo    repositories.LoanRepository
+	txRepo      repositories.PaymentTransactionRepository
 }
 
-func NewPaymentService(cfg config.Config, paymentRepo repositories.PaymentRepository, loanRepo repositories.LoanRepository, uow repositories.UnitOfWork) PaymentService {
+func NewPaymentService(cfg config.Config, paymentRepo repositories.PaymentRepository, loanRepo repositories.LoanRepository, uow repositories.UnitOfWork, txRepo repositories.PaymentTransactionRepository) PaymentService {
 	return &paymentService{
 		cfg:         cfg,
 		paymentRepo: paymentRepo,
 		loanRepo:    loanRepo,
 		uow:         uow,
+		txRepo:      txRepo,
 	}
 }
 
@@ -35,14 +46,115 @@ func (s *paymentService) MakePayment(ctx context.Context, userID string) error {
 	if err != nil {
 		return err
 	}
+
+	return s.payLoan(ctx, loan, paymentSource{channel: entities.PAYMENT_CHANNEL_API})
+}
+
+func (s *paymentService) MakePaymentByLoanID(ctx context.Context, userID string, loanID string) error {
+	loan, err := s.getLoan(ctx, userID, loanID)
+	if err != nil {
+		return err
+	}
+
+	return s.payLoan(ctx, loan, paymentSource{channel: entities.PAYMENT_CHANNEL_API})
+}
+
+// PostProviderPayment pays the due installment of the loan the provider payment is for, once per provider payment,
+// as paid when the provider received the money. The amount has to match the installment, anything else is left to an operator
+func (s *paymentService) PostProviderPayment(ctx context.Context, payment *entities.ProviderPayment) (*entities.ExternalPaymentResult, error) {
+	source := paymentSource{channel: entities.PAYMENT_CHANNEL_PROVIDER, amount: payment.Amount, paidAt: payment.PaidAt}
+	return s.postExternalPayment(ctx, source, payment.Provider+":"+payment.ExternalID, func() (*entities.Loan, error) {
+		return s.matchProviderLoan(ctx, payment)
+	})
+}
+
+// PostStatementPayment pays the due installment of the loan with a bank statement line, once per line key
+func (s *paymentService) PostStatementPayment(ctx context.Context, loanID string, key string, amount float64) (*entities.ExternalPaymentResult, error) {
+	source := paymentSource{channel: entities.PAYMENT_CHANNEL_BANK_TRANSFER, amount: amount}
+	return s.postExternalPayment(ctx, source, "statement:"+key, func() (*entities.Loan, error) {
+		return s.getPayableLoan(ctx, loanID)
+	})
+}
+
+// postExternalPayment posts money received outside the API once per reference of its channel, match finds the loan it pays
+func (s *paymentService) postExternalPayment(ctx context.Context, source paymentSource, reference string, match func() (*entities.Loan, error)) (*entities.ExternalPaymentResult, error) {
+	source.reference = &reference
+
+	posted, err := s.getExternalTransaction(ctx, source.channel, reference)
+	if err != nil {
+		return nil, err
+	}
+	if posted != nil {
+		return &entities.ExternalPaymentResult{LoanID: posted.LoanID, Duplicate: true}, nil
+	}
+
+	loan, err := match()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.payLoan(ctx, loan, source)
+	if err != nil {
+		// a payment posted concurrently wins the unique reference, this one is the duplicate
+		posted, lookupErr := s.getExternalTransaction(ctx, source.channel, reference)
+		if lookupErr == nil && posted != nil {
+			return &entities.ExternalPaymentResult{LoanID: posted.LoanID, Duplicate: true}, nil
+		}
+		return nil, err
+	}
+
+	return &entities.ExternalPaymentResult{LoanID: loan.ID}, nil
+}
+
+// SendPaymentReminders publishes PaymentDue once for every unpaid installment ending within PAYMENT_REMINDER_DAYS,
+// it returns the installments reminded
+func (s *paymentService) SendPaymentReminders(ctx context.Context) ([]*entities.Payment, error) {
+	now := time.Now()
+	payments, err := s.paymentRepo.GetReminderDuePayments(ctx, now, now.AddDate(0, 0, s.cfg.PaymentReminderDays))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	var reminded []*entities.Payment
+	for _, payment := range payments {
+		ok, err := s.remindPayment(ctx, payment, now)
+		if err != nil {
+			return reminded, err
+		}
+		if ok {
+			reminded = append(reminded, payment)
+		}
+	}
+
+	return reminded, nil
+}
+
+func (s *paymentService) payLoan(ctx context.Context, loan *entities.Loan, source paymentSource) error {
 	now := time.Now()
 
 	payments, err := s.paymentRepo.GetPaymentByLoanID(ctx, loan.ID)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	// a written off loan only accepts recoveries, which settle the remaining installments regardless of their window
+	isRecovery := loan.Status == entities.LOAN_STATUS_WRITTEN_OFF
 	unpaidPayment := s.getEligiblePayment(payments, &now)
+	if isRecovery {
+		unpaidPayment = s.getUnpaidPayment(payments)
+	}
+	if unpaidPayment == nil && s.getUnpaidPayment(payments) == nil {
+		return errorhandler.BadRequest(errorhandler.REASON_ALL_INSTALLMENTS_PAID, "all loans have been paid off")
+	}
 	if unpaidPayment == nil {
-		return fmt.Errorf("%w: %s", errorhandler.BadRequestError, errors.New("all loans have been paid off").Error())
+		return errorhandler.BadRequest(errorhandler.REASON_NO_INSTALLMENT_DUE, "no installment is due yet")
+	}
+	if source.amount != 0 && math.Abs(source.amount-unpaidPayment.Amount) >= 0.01 {
+		return errorhandler.BadRequest(errorhandler.REASON_PAYMENT_AMOUNT_MISMATCH, fmt.Sprintf("amount %.2f does not match the installment of %.2f", source.amount, unpaidPayment.Amount))
 	}
 	isLastPayment := s.isLastPayment(payments, unpaidPayment.ID)
+	// the installment due now is settled as paid when the money arrived, a provider may notify late
+	paidAt := s.getPaidAt(source, now)
 
 	tx, err := s.uow.Begin(ctx)
 	if err != nil {
@@ -52,14 +164,87 @@ func (s *paymentService) MakePayment(ctx context.Context, userID string) error {
 	loanRepo := s.uow.LoanRepository(tx)
 	paymentRepo := s.uow.PaymentRepository(tx)
 
-	err = paymentRepo.UpdatePaidAtPayment(ctx, unpaidPayment.ID, &now)
+	// the installment may have been paid, refinanced or restructured since it was read
+	ok, err := paymentRepo.UpdatePaidAtPayment(ctx, unpaidPayment.ID, &paidAt)
 	if err != nil {
 		s.uow.Rollback(tx)
 		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
 	}
+	if !ok {
+		s.uow.Rollback(tx)
+		return errorhandler.BadRequest(errorhandler.REASON_LOAN_CHANGED, "the installment was paid or replaced meanwhile, please retry")
+	}
 
-	if isLastPayment {
-		err = loanRepo.UpdateIsActiveLoanByID(ctx, loan.ID, false)
+	err = s.recordTransaction(ctx, tx, loan, unpaidPayment, source, &paidAt, &now)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	if isRecovery {
+		err = s.recordRecovery(ctx, tx, loan, unpaidPayment, &paidAt, &now)
+		if err != nil {
+			s.uow.Rollback(tx)
+			return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+	}
+
+	paid := *unpaidPayment
+	paid.PaidAt = &paidAt
+	err = recordAuditEvent(ctx, s.uow, tx, &entities.AuditEvent{
+		LoanID:     &loan.ID,
+		Action:     entities.AUDIT_ACTION_PAYMENT_MADE,
+		EntityType: entities.AUDIT_ENTITY_PAYMENT,
+		EntityID:   unpaidPayment.ID,
+	}, unpaidPayment, &paid)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = recordOutboxEvent(ctx, s.uow, tx, loan.ID, entities.EVENT_PAYMENT_MADE, &entities.PaymentMadeEvent{
+		LoanID:    loan.ID,
+		UserID:    loan.UserID,
+		PaymentID: unpaidPayment.ID,
+		Amount:    unpaidPayment.Amount,
+		Principal: unpaidPayment.Principal,
+		Interest:  unpaidPayment.Interest,
+		Fee:       unpaidPayment.Fee,
+		Channel:   source.channel,
+		Recovery:  isRecovery,
+		PaidAt:    paidAt,
+	})
+	if err != nil {
+		s.uow.Rollback(tx)
+		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	if isLastPayment && !isRecovery {
+		err = loanRepo.UpdateStatusLoanByID(ctx, loan.ID, entities.LOAN_STATUS_PAID_OFF)
+		if err != nil {
+			s.uow.Rollback(tx)
+			return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+
+		paidOff := *loan
+		paidOff.Status = entities.LOAN_STATUS_PAID_OFF
+		paidOff.IsActive = false
+		err = recordAuditEvent(ctx, s.uow, tx, &entities.AuditEvent{
+			LoanID:     &loan.ID,
+			Action:     entities.AUDIT_ACTION_LOAN_PAID_OFF,
+			EntityType: entities.AUDIT_ENTITY_LOAN,
+			EntityID:   loan.ID,
+		}, loan, &paidOff)
+		if err != nil {
+			s.uow.Rollback(tx)
+			return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+
+		err = recordOutboxEvent(ctx, s.uow, tx, loan.ID, entities.EVENT_LOAN_PAID_OFF, &entities.LoanPaidOffEvent{
+			LoanID:    loan.ID,
+			UserID:    loan.UserID,
+			PaidOffAt: paidAt,
+		})
 		if err != nil {
 			s.uow.Rollback(tx)
 			return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
@@ -73,3 +258,45 @@ func (s *paymentService) MakePayment(ctx context.Context, userID string) error {
 
 	return nil
 }
+
+func (s *paymentService) ListPayments(ctx context.Context, filter *entities.PaymentTransactionFilter, pageSize int, pageToken string) (*entities.PaymentTransactionPage, error) {
+	if filter.LoanID == "" && filter.UserID == "" {
+		return nil, errorhandler.InvalidField("loanId", "loan id or user id is required")
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, errorhandler.InvalidField("from", "must be before to")
+	}
+
+	if filter.LoanID != "" {
+		loan, err := s.loanRepo.GetLoanByID(ctx, filter.LoanID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorhandler.NotFound(errorhandler.REASON_LOAN_NOT_FOUND, "loan not found")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+		if filter.UserID != "" && loan.UserID != filter.UserID {
+			return nil, errorhandler.NotFound(errorhandler.REASON_LOAN_NOT_FOUND, "loan not found")
+		}
+	}
+
+	cursor, err := pagination.DecodeCursor(pageToken)
+	if err != nil {
+		return nil, errorhandler.InvalidField("pageToken", err.Error())
+	}
+
+	pageSize = pagination.PageSize(pageSize)
+	transactions, err := s.txRepo.GetPaymentTransactions(ctx, filter, cursor, pageSize+1)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	page := &entities.PaymentTransactionPage{Transactions: transactions}
+	if len(transactions) > pageSize {
+		page.Transactions = transactions[:pageSize]
+		last := page.Transactions[pageSize-1]
+		page.NextPageToken = pagination.EncodeCursor(*last.PaidAt, last.ID)
+	}
+
+	return page, nil
+}
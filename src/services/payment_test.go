@@ -3,6 +3,8 @@ package services_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,9 +15,19 @@ import (
 	mocks "github.com/verizhang/billing-engine/src/repositories/mocks"
 	"github.com/verizhang/billing-engine/src/services"
 	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"github.com/verizhang/billing-engine/src/utils/pagination"
+	"github.com/verizhang/billing-engine/src/utils/paymentref"
 	"gorm.io/gorm"
 )
 
+// expectPaymentTransaction mocks recording the transaction of the paid installment
+func expectPaymentTransaction(uow *mocks.UnitOfWork, tx *gorm.DB) *mocks.PaymentTransactionRepository {
+	txRepo := new(mocks.PaymentTransactionRepository)
+	uow.On("PaymentTransactionRepository", tx).Return(txRepo)
+	txRepo.On("CreatePaymentTransaction", mock.Anything, mock.AnythingOfType("*entities.PaymentTransaction")).Return(nil)
+	return txRepo
+}
+
 func TestPaymentService_MakePayment(t *testing.T) {
 	// Helper function to create service with mocks
 	createService := func(
@@ -24,7 +36,7 @@ func TestPaymentService_MakePayment(t *testing.T) {
 		paymentRepo *mocks.PaymentRepository,
 		loanRepo *mocks.LoanRepository,
 	) services.PaymentService {
-		return services.NewPaymentService(cfg, paymentRepo, loanRepo, uow)
+		return services.NewPaymentService(cfg, paymentRepo, loanRepo, uow, nil)
 	}
 
 	t.Run("success make payment - not last payment", func(t *testing.T) {
@@ -60,10 +72,13 @@ func TestPaymentService_MakePayment(t *testing.T) {
 		// Mock expectations
 		loanRepo.On("GetActiveLoansByUserID", mock.Anything, "user1").Return([]*entities.Loan{loan}, nil)
 		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(payments, nil)
-		paymentRepo.On("UpdatePaidAtPayment", mock.Anything, "payment1", mock.Anything).Return(nil)
+		paymentRepo.On("UpdatePaidAtPayment", mock.Anything, "payment1", mock.Anything).Return(true, nil)
 		uow.On("Begin", mock.Anything).Return(mockTx, nil)
 		uow.On("Commit", mockTx).Return(nil)
+		expectAuditEvents(uow, mockTx)
+		expectOutboxEvents(uow, mockTx)
 		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		txRepo := expectPaymentTransaction(uow, mockTx)
 		uow.On("LoanRepository", mockTx).Return(loanRepo)
 
 		// Execute
@@ -75,6 +90,11 @@ func TestPaymentService_MakePayment(t *testing.T) {
 		uow.AssertExpectations(t)
 		loanRepo.AssertExpectations(t)
 		paymentRepo.AssertExpectations(t)
+
+		transaction := txRepo.Calls[0].Arguments.Get(1).(*entities.PaymentTransaction)
+		assert.Equal(t, entities.PAYMENT_CHANNEL_API, transaction.Channel)
+		assert.Len(t, transaction.Allocations, 1)
+		assert.Equal(t, "payment1", transaction.Allocations[0].PaymentID)
 	})
 
 	t.Run("success make payment - last payment", func(t *testing.T) {
@@ -109,10 +129,13 @@ func TestPaymentService_MakePayment(t *testing.T) {
 		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(payments, nil)
 		uow.On("Begin", mock.Anything).Return(mockTx, nil)
 		uow.On("Commit", mockTx).Return(nil)
+		expectAuditEvents(uow, mockTx)
+		outboxRepo := expectOutboxEvents(uow, mockTx)
 		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		expectPaymentTransaction(uow, mockTx)
 		uow.On("LoanRepository", mockTx).Return(loanRepo)
-		paymentRepo.On("UpdatePaidAtPayment", mock.Anything, "payment2", mock.Anything).Return(nil)
-		loanRepo.On("UpdateIsActiveLoanByID", mock.Anything, "loan1", false).Return(nil)
+		paymentRepo.On("UpdatePaidAtPayment", mock.Anything, "payment2", mock.Anything).Return(true, nil)
+		loanRepo.On("UpdateStatusLoanByID", mock.Anything, "loan1", entities.LOAN_STATUS_PAID_OFF).Return(nil)
 
 		// Execute
 		service := createService(config.Config{}, uow, paymentRepo, loanRepo)
@@ -123,6 +146,11 @@ func TestPaymentService_MakePayment(t *testing.T) {
 		uow.AssertExpectations(t)
 		loanRepo.AssertExpectations(t)
 		paymentRepo.AssertExpectations(t)
+		for _, eventType := range []string{entities.EVENT_PAYMENT_MADE, entities.EVENT_LOAN_PAID_OFF} {
+			outboxRepo.AssertCalled(t, "CreateOutboxEvent", mock.Anything, mock.MatchedBy(func(event *entities.OutboxEvent) bool {
+				return event.Type == eventType && event.LoanID == "loan1"
+			}))
+		}
 	})
 
 	t.Run("error - no active loan found", func(t *testing.T) {
@@ -160,6 +188,29 @@ func TestPaymentService_MakePayment(t *testing.T) {
 
 		assert.Error(t, err)
 		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+		var e *errorhandler.Error
+		assert.ErrorAs(t, err, &e)
+		assert.Equal(t, errorhandler.REASON_ALL_INSTALLMENTS_PAID, e.Reason)
+	})
+
+	t.Run("error - no installment due yet", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+
+		startAt := time.Now().AddDate(0, 0, 1)
+		endAt := startAt.AddDate(0, 0, 7)
+		loan := &entities.Loan{ID: "loan1", UserID: "user1", IsActive: true}
+		payments := []*entities.Payment{{ID: "payment1", LoanID: "loan1", StartAt: &startAt, EndAt: &endAt}}
+
+		loanRepo.On("GetActiveLoansByUserID", mock.Anything, "user1").Return([]*entities.Loan{loan}, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(payments, nil)
+
+		service := createService(config.Config{}, nil, paymentRepo, loanRepo)
+		err := service.MakePayment(context.Background(), "user1")
+
+		var e *errorhandler.Error
+		assert.ErrorAs(t, err, &e)
+		assert.Equal(t, errorhandler.REASON_NO_INSTALLMENT_DUE, e.Reason)
 	})
 
 	t.Run("error - begin transaction fails", func(t *testing.T) {
@@ -210,7 +261,7 @@ func TestPaymentService_MakePayment(t *testing.T) {
 
 		loanRepo.On("GetActiveLoansByUserID", mock.Anything, "user1").Return([]*entities.Loan{loan}, nil)
 		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(payments, nil)
-		paymentRepo.On("UpdatePaidAtPayment", mock.Anything, "payment1", mock.Anything).Return(errors.New("update error"))
+		paymentRepo.On("UpdatePaidAtPayment", mock.Anything, "payment1", mock.Anything).Return(false, errors.New("update error"))
 		uow.On("Begin", mock.Anything).Return(mockTx, nil)
 		uow.On("Rollback", mockTx).Return(nil)
 		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
@@ -224,6 +275,32 @@ func TestPaymentService_MakePayment(t *testing.T) {
 		uow.AssertCalled(t, "Rollback", mockTx)
 	})
 
+	t.Run("error - installment paid or replaced meanwhile", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		uow := new(mocks.UnitOfWork)
+		mockTx := &gorm.DB{}
+
+		now := time.Now()
+		loan := &entities.Loan{ID: "loan1", UserID: "user1", IsActive: true}
+		payments := []*entities.Payment{{ID: "payment1", LoanID: "loan1", StartAt: &now, EndAt: &now}}
+
+		loanRepo.On("GetActiveLoansByUserID", mock.Anything, "user1").Return([]*entities.Loan{loan}, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(payments, nil)
+		paymentRepo.On("UpdatePaidAtPayment", mock.Anything, "payment1", mock.Anything).Return(false, nil)
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Rollback", mockTx).Return(nil)
+		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+
+		service := createService(config.Config{}, uow, paymentRepo, loanRepo)
+		err := service.MakePayment(context.Background(), "user1")
+
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+		uow.AssertCalled(t, "Rollback", mockTx)
+		uow.AssertNotCalled(t, "Commit", mockTx)
+	})
+
 	t.Run("error - update loan status fails (last payment)", func(t *testing.T) {
 		loanRepo := new(mocks.LoanRepository)
 		paymentRepo := new(mocks.PaymentRepository)
@@ -247,9 +324,12 @@ func TestPaymentService_MakePayment(t *testing.T) {
 		uow.On("Begin", mock.Anything).Return(mockTx, nil)
 		uow.On("Rollback", mockTx).Return(nil)
 		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		expectPaymentTransaction(uow, mockTx)
+		expectAuditEvents(uow, mockTx)
+		expectOutboxEvents(uow, mockTx)
 		uow.On("LoanRepository", mockTx).Return(loanRepo)
-		paymentRepo.On("UpdatePaidAtPayment", mock.Anything, "payment1", mock.Anything).Return(nil)
-		loanRepo.On("UpdateIsActiveLoanByID", mock.Anything, "loan1", false).Return(errors.New("update error"))
+		paymentRepo.On("UpdatePaidAtPayment", mock.Anything, "payment1", mock.Anything).Return(true, nil)
+		loanRepo.On("UpdateStatusLoanByID", mock.Anything, "loan1", entities.LOAN_STATUS_PAID_OFF).Return(errors.New("update error"))
 
 		service := createService(config.Config{}, uow, paymentRepo, loanRepo)
 		err := service.MakePayment(context.Background(), "user1")
@@ -278,12 +358,15 @@ func TestPaymentService_MakePayment(t *testing.T) {
 		}
 
 		loanRepo.On("GetActiveLoansByUserID", mock.Anything, "user1").Return([]*entities.Loan{loan}, nil)
-		loanRepo.On("UpdateIsActiveLoanByID", mock.Anything, "loan1", false).Return(nil)
+		loanRepo.On("UpdateStatusLoanByID", mock.Anything, "loan1", entities.LOAN_STATUS_PAID_OFF).Return(nil)
 		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(payments, nil)
-		paymentRepo.On("UpdatePaidAtPayment", mock.Anything, "payment1", mock.Anything).Return(nil)
+		paymentRepo.On("UpdatePaidAtPayment", mock.Anything, "payment1", mock.Anything).Return(true, nil)
 		uow.On("Begin", mock.Anything).Return(mockTx, nil)
 		uow.On("Commit", mockTx).Return(errors.New("commit error"))
+		expectAuditEvents(uow, mockTx)
+		expectOutboxEvents(uow, mockTx)
 		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		expectPaymentTransaction(uow, mockTx)
 		uow.On("LoanRepository", mockTx).Return(loanRepo)
 
 		service := createService(config.Config{}, uow, paymentRepo, loanRepo)
@@ -292,4 +375,409 @@ func TestPaymentService_MakePayment(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, errorhandler.InternalServerError, errors.Unwrap(err))
 	})
+
+	t.Run("error - multiple active loans", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		loans := []*entities.Loan{
+			{ID: "loan1", UserID: "user1", IsActive: true},
+			{ID: "loan2", UserID: "user1", IsActive: true},
+		}
+		loanRepo.On("GetActiveLoansByUserID", mock.Anything, "user1").Return(loans, nil)
+
+		service := createService(config.Config{}, nil, nil, loanRepo)
+		err := service.MakePayment(context.Background(), "user1")
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+	})
+}
+
+func TestPaymentService_MakePaymentByLoanID(t *testing.T) {
+	createService := func(
+		uow *mocks.UnitOfWork,
+		paymentRepo *mocks.PaymentRepository,
+		loanRepo *mocks.LoanRepository,
+	) services.PaymentService {
+		return services.NewPaymentService(config.Config{}, paymentRepo, loanRepo, uow, nil)
+	}
+
+	t.Run("success make payment", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		paymentRepo := new(mocks.PaymentRepository)
+		loanRepo := new(mocks.LoanRepository)
+		mockTx := &gorm.DB{}
+
+		now := time.Now()
+		lastDay := now.AddDate(0, 0, -1)
+		nextEndAt := now.AddDate(0, 0, 7)
+		loan := &entities.Loan{ID: "loan2", UserID: "user1", IsActive: true}
+		payments := []*entities.Payment{
+			{ID: "payment1", LoanID: "loan2", StartAt: &lastDay, EndAt: &nextEndAt},
+			{ID: "payment2", LoanID: "loan2", StartAt: &nextEndAt, EndAt: &nextEndAt},
+		}
+
+		loanRepo.On("GetLoanByID", mock.Anything, "loan2").Return(loan, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan2").Return(payments, nil)
+		paymentRepo.On("UpdatePaidAtPayment", mock.Anything, "payment1", mock.Anything).Return(true, nil)
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		expectAuditEvents(uow, mockTx)
+		expectOutboxEvents(uow, mockTx)
+		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		expectPaymentTransaction(uow, mockTx)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+
+		service := createService(uow, paymentRepo, loanRepo)
+		err := service.MakePaymentByLoanID(context.Background(), "user1", "loan2")
+
+		assert.NoError(t, err)
+		uow.AssertExpectations(t)
+		paymentRepo.AssertExpectations(t)
+	})
+
+	t.Run("success record recovery on written off loan", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		paymentRepo := new(mocks.PaymentRepository)
+		loanRepo := new(mocks.LoanRepository)
+		writeOffRepo := new(mocks.LoanWriteOffRepository)
+		recoveryRepo := new(mocks.LoanRecoveryRepository)
+		mockTx := &gorm.DB{}
+
+		now := time.Now()
+		futureStartAt := now.AddDate(0, 0, 7)
+		loan := &entities.Loan{ID: "loan2", UserID: "user1", IsActive: false, Status: entities.LOAN_STATUS_WRITTEN_OFF}
+		payments := []*entities.Payment{
+			{ID: "payment1", LoanID: "loan2", Amount: 110, StartAt: &futureStartAt, EndAt: &futureStartAt},
+			{ID: "payment2", LoanID: "loan2", Amount: 110, StartAt: &futureStartAt, EndAt: &futureStartAt},
+		}
+
+		loanRepo.On("GetLoanByID", mock.Anything, "loan2").Return(loan, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan2").Return(payments, nil)
+		paymentRepo.On("UpdatePaidAtPayment", mock.Anything, "payment1", mock.Anything).Return(true, nil)
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		expectAuditEvents(uow, mockTx)
+		expectOutboxEvents(uow, mockTx)
+		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		expectPaymentTransaction(uow, mockTx)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+		uow.On("LoanWriteOffRepository", mockTx).Return(writeOffRepo)
+		uow.On("LoanRecoveryRepository", mockTx).Return(recoveryRepo)
+		writeOffRepo.On("GetLoanWriteOffByLoanID", mock.Anything, "loan2").Return(&entities.LoanWriteOff{ID: "writeOff1"}, nil)
+		recoveryRepo.On("CreateLoanRecovery", mock.Anything, mock.MatchedBy(func(recovery *entities.LoanRecovery) bool {
+			return recovery.WriteOffID == "writeOff1" && recovery.PaymentID == "payment1" && recovery.Amount == 110
+		})).Return(nil)
+
+		service := createService(uow, paymentRepo, loanRepo)
+		err := service.MakePaymentByLoanID(context.Background(), "user1", "loan2")
+
+		assert.NoError(t, err)
+		recoveryRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - loan is not active", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		loan := &entities.Loan{ID: "loan2", UserID: "user1", IsActive: false}
+		loanRepo.On("GetLoanByID", mock.Anything, "loan2").Return(loan, nil)
+
+		service := createService(nil, nil, loanRepo)
+		err := service.MakePaymentByLoanID(context.Background(), "user1", "loan2")
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+	})
+}
+
+func TestPaymentService_ListPayments(t *testing.T) {
+	newTransactions := func(count int) []*entities.PaymentTransaction {
+		var transactions []*entities.PaymentTransaction
+		for i := 0; i < count; i++ {
+			paidAt := time.Now().AddDate(0, 0, -i)
+			transactions = append(transactions, &entities.PaymentTransaction{ID: fmt.Sprintf("transaction%d", i+1), LoanID: "loan1", PaidAt: &paidAt})
+		}
+		return transactions
+	}
+
+	t.Run("success by loan returns next page token", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		txRepo := new(mocks.PaymentTransactionRepository)
+		filter := &entities.PaymentTransactionFilter{LoanID: "loan1", UserID: "user1"}
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(&entities.Loan{ID: "loan1", UserID: "user1"}, nil)
+		txRepo.On("GetPaymentTransactions", mock.Anything, filter, (*pagination.Cursor)(nil), 3).Return(newTransactions(3), nil)
+
+		service := services.NewPaymentService(config.Config{}, nil, loanRepo, nil, txRepo)
+		result, err := service.ListPayments(context.Background(), filter, 2, "")
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Transactions, 2)
+		cursor, err := pagination.DecodeCursor(result.NextPageToken)
+		assert.NoError(t, err)
+		assert.Equal(t, "transaction2", cursor.ID)
+	})
+
+	t.Run("success by user on last page", func(t *testing.T) {
+		txRepo := new(mocks.PaymentTransactionRepository)
+		filter := &entities.PaymentTransactionFilter{UserID: "user1"}
+		txRepo.On("GetPaymentTransactions", mock.Anything, filter, (*pagination.Cursor)(nil), 21).Return(newTransactions(1), nil)
+
+		service := services.NewPaymentService(config.Config{}, nil, nil, nil, txRepo)
+		result, err := service.ListPayments(context.Background(), filter, 0, "")
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Transactions, 1)
+		assert.Empty(t, result.NextPageToken)
+	})
+
+	t.Run("error when loan belongs to another user", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(&entities.Loan{ID: "loan1", UserID: "user2"}, nil)
+
+		service := services.NewPaymentService(config.Config{}, nil, loanRepo, nil, nil)
+		_, err := service.ListPayments(context.Background(), &entities.PaymentTransactionFilter{LoanID: "loan1", UserID: "user1"}, 0, "")
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.NotFoundError, errors.Unwrap(err))
+	})
+
+	t.Run("error without loan or user", func(t *testing.T) {
+		service := services.NewPaymentService(config.Config{}, nil, nil, nil, nil)
+		_, err := service.ListPayments(context.Background(), &entities.PaymentTransactionFilter{}, 0, "")
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+	})
+}
+
+func TestPaymentService_PostProviderPayment(t *testing.T) {
+	newPayment := func(amount float64) *entities.ProviderPayment {
+		return &entities.ProviderPayment{Provider: "generic", ExternalID: "pay1", Reference: "loan1", Amount: amount, PaidAt: time.Now()}
+	}
+
+	t.Run("success post matched payment", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		paymentRepo := new(mocks.PaymentRepository)
+		loanRepo := new(mocks.LoanRepository)
+		lookupRepo := new(mocks.PaymentTransactionRepository)
+		mockTx := &gorm.DB{}
+
+		now := time.Now()
+		lastDay := now.AddDate(0, 0, -1)
+		nextEndAt := now.AddDate(0, 0, 7)
+		loan := &entities.Loan{ID: "loan1", UserID: "user1", IsActive: true}
+		payments := []*entities.Payment{
+			{ID: "payment1", LoanID: "loan1", Amount: 110, StartAt: &lastDay, EndAt: &nextEndAt},
+			{ID: "payment2", LoanID: "loan1", Amount: 110, StartAt: &nextEndAt, EndAt: &nextEndAt},
+		}
+
+		lookupRepo.On("GetPaymentTransactionByReference", mock.Anything, entities.PAYMENT_CHANNEL_PROVIDER, "generic:pay1").Return(nil, gorm.ErrRecordNotFound)
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(loan, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(payments, nil)
+		paymentRepo.On("UpdatePaidAtPayment", mock.Anything, "payment1", mock.Anything).Return(true, nil)
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		expectAuditEvents(uow, mockTx)
+		expectOutboxEvents(uow, mockTx)
+		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		txRepo := expectPaymentTransaction(uow, mockTx)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+
+		// notified late, the installment is paid when the provider received the money
+		payment := newPayment(110)
+		payment.PaidAt = now.Add(-2 * time.Hour)
+		service := services.NewPaymentService(config.Config{}, paymentRepo, loanRepo, uow, lookupRepo)
+		result, err := service.PostProviderPayment(context.Background(), payment)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "loan1", result.LoanID)
+		assert.False(t, result.Duplicate)
+		transaction := txRepo.Calls[0].Arguments.Get(1).(*entities.PaymentTransaction)
+		assert.Equal(t, entities.PAYMENT_CHANNEL_PROVIDER, transaction.Channel)
+		assert.Equal(t, "generic:pay1", *transaction.Reference)
+		assert.True(t, payment.PaidAt.Equal(*transaction.PaidAt))
+		assert.True(t, transaction.CreatedAt.After(payment.PaidAt))
+		paymentRepo.AssertCalled(t, "UpdatePaidAtPayment", mock.Anything, "payment1", mock.MatchedBy(func(paidAt *time.Time) bool {
+			return paidAt.Equal(payment.PaidAt)
+		}))
+	})
+
+	t.Run("success acknowledge duplicate payment", func(t *testing.T) {
+		lookupRepo := new(mocks.PaymentTransactionRepository)
+		lookupRepo.On("GetPaymentTransactionByReference", mock.Anything, entities.PAYMENT_CHANNEL_PROVIDER, "generic:pay1").
+			Return(&entities.PaymentTransaction{ID: "transaction1", LoanID: "loan1"}, nil)
+
+		service := services.NewPaymentService(config.Config{}, nil, nil, nil, lookupRepo)
+		result, err := service.PostProviderPayment(context.Background(), newPayment(110))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "loan1", result.LoanID)
+		assert.True(t, result.Duplicate)
+	})
+
+	t.Run("success match loan by virtual account", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		lookupRepo := new(mocks.PaymentTransactionRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+
+		loan := &entities.Loan{ID: "loan1", UserID: "user1", IsActive: true}
+		lookupRepo.On("GetPaymentTransactionByReference", mock.Anything, mock.Anything, mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+		loanRepo.On("GetLoanByPaymentReference", mock.Anything, "8808000000000001").Return(loan, nil)
+		// stops after matching, the schedule is covered by the posting tests above
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(nil, errors.New("stop"))
+
+		payment := newPayment(110)
+		payment.VirtualAccount = "8808000000000001"
+		payment.Reference = ""
+		service := services.NewPaymentService(config.Config{}, paymentRepo, loanRepo, nil, lookupRepo)
+		_, err := service.PostProviderPayment(context.Background(), payment)
+
+		assert.Equal(t, errorhandler.InternalServerError, errors.Unwrap(err))
+		loanRepo.AssertExpectations(t)
+		paymentRepo.AssertExpectations(t)
+	})
+
+	t.Run("success match loan by payment reference", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		lookupRepo := new(mocks.PaymentTransactionRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+
+		format := paymentref.Format{Prefix: "8808", Length: 16, CheckDigit: paymentref.CHECK_DIGIT_LUHN}
+		reference, _ := format.Generate()
+		loan := &entities.Loan{ID: "loan1", UserID: "user1", IsActive: true}
+		lookupRepo.On("GetPaymentTransactionByReference", mock.Anything, mock.Anything, mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+		loanRepo.On("GetLoanByPaymentReference", mock.Anything, reference).Return(loan, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(nil, errors.New("stop"))
+
+		payment := newPayment(110)
+		payment.Reference = reference
+		cfg := config.Config{PaymentReferencePrefix: format.Prefix, PaymentReferenceLength: format.Length, PaymentReferenceCheckDigit: format.CheckDigit}
+		service := services.NewPaymentService(cfg, paymentRepo, loanRepo, nil, lookupRepo)
+		service.PostProviderPayment(context.Background(), payment)
+
+		loanRepo.AssertExpectations(t)
+		loanRepo.AssertNotCalled(t, "GetLoanByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error when no loan matches", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		lookupRepo := new(mocks.PaymentTransactionRepository)
+		lookupRepo.On("GetPaymentTransactionByReference", mock.Anything, mock.Anything, mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(nil, gorm.ErrRecordNotFound)
+
+		service := services.NewPaymentService(config.Config{}, nil, loanRepo, nil, lookupRepo)
+		_, err := service.PostProviderPayment(context.Background(), newPayment(110))
+
+		var e *errorhandler.Error
+		assert.ErrorAs(t, err, &e)
+		assert.Equal(t, errorhandler.NotFoundError, errors.Unwrap(err))
+		assert.Equal(t, errorhandler.REASON_PAYMENT_UNMATCHED, e.Reason)
+	})
+
+	t.Run("error when amount does not match the installment", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		lookupRepo := new(mocks.PaymentTransactionRepository)
+
+		lastDay := time.Now().AddDate(0, 0, -1)
+		nextEndAt := time.Now().AddDate(0, 0, 7)
+		loan := &entities.Loan{ID: "loan1", UserID: "user1", IsActive: true}
+		payments := []*entities.Payment{{ID: "payment1", LoanID: "loan1", Amount: 110, StartAt: &lastDay, EndAt: &nextEndAt}}
+
+		lookupRepo.On("GetPaymentTransactionByReference", mock.Anything, mock.Anything, mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(loan, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(payments, nil)
+
+		service := services.NewPaymentService(config.Config{}, paymentRepo, loanRepo, nil, lookupRepo)
+		_, err := service.PostProviderPayment(context.Background(), newPayment(100))
+
+		var e *errorhandler.Error
+		assert.ErrorAs(t, err, &e)
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+		assert.Equal(t, errorhandler.REASON_PAYMENT_AMOUNT_MISMATCH, e.Reason)
+		paymentRepo.AssertNotCalled(t, "UpdatePaidAtPayment", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestPaymentService_PostStatementPayment(t *testing.T) {
+	t.Run("success acknowledge line posted before", func(t *testing.T) {
+		lookupRepo := new(mocks.PaymentTransactionRepository)
+		lookupRepo.On("GetPaymentTransactionByReference", mock.Anything, entities.PAYMENT_CHANNEL_BANK_TRANSFER, "statement:TRX001").
+			Return(&entities.PaymentTransaction{ID: "transaction1", LoanID: "loan1"}, nil)
+
+		service := services.NewPaymentService(config.Config{}, nil, nil, nil, lookupRepo)
+		result, err := service.PostStatementPayment(context.Background(), "loan2", "TRX001", 110)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "loan1", result.LoanID)
+		assert.True(t, result.Duplicate)
+	})
+
+	t.Run("error when loan is not found", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		lookupRepo := new(mocks.PaymentTransactionRepository)
+		lookupRepo.On("GetPaymentTransactionByReference", mock.Anything, mock.Anything, mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(nil, gorm.ErrRecordNotFound)
+
+		service := services.NewPaymentService(config.Config{}, nil, loanRepo, nil, lookupRepo)
+		_, err := service.PostStatementPayment(context.Background(), "loan1", "TRX001", 110)
+
+		var e *errorhandler.Error
+		assert.ErrorAs(t, err, &e)
+		assert.Equal(t, errorhandler.REASON_LOAN_NOT_FOUND, e.Reason)
+	})
+
+	t.Run("error when loan is paid off", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		lookupRepo := new(mocks.PaymentTransactionRepository)
+		lookupRepo.On("GetPaymentTransactionByReference", mock.Anything, mock.Anything, mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(&entities.Loan{ID: "loan1", Status: entities.LOAN_STATUS_PAID_OFF}, nil)
+
+		service := services.NewPaymentService(config.Config{}, nil, loanRepo, nil, lookupRepo)
+		_, err := service.PostStatementPayment(context.Background(), "loan1", "TRX001", 110)
+
+		var e *errorhandler.Error
+		assert.ErrorAs(t, err, &e)
+		assert.Equal(t, errorhandler.REASON_LOAN_NOT_ACTIVE, e.Reason)
+	})
+}
+
+func TestPaymentService_SendPaymentReminders(t *testing.T) {
+	t.Run("success publishes PaymentDue once per installment", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		mockTx := &gorm.DB{}
+
+		endAt := time.Now().AddDate(0, 0, 2)
+		reference := "8808123456789012"
+		due := []*entities.Payment{{ID: "payment1", LoanID: "loan1", Amount: 110000, EndAt: &endAt}}
+
+		paymentRepo.On("GetReminderDuePayments", mock.Anything, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(due, nil)
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(&entities.Loan{ID: "loan1", UserID: "user1", PaymentReference: &reference}, nil)
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		uow.On("PaymentRepository", mockTx).Return(paymentRepo)
+		outboxRepo := expectOutboxEvents(uow, mockTx)
+		paymentRepo.On("UpdateRemindedAtPayment", mock.Anything, "payment1", mock.AnythingOfType("time.Time")).Return(true, nil)
+
+		service := services.NewPaymentService(config.Config{PaymentReminderDays: 3}, paymentRepo, loanRepo, uow, nil)
+		result, err := service.SendPaymentReminders(context.Background())
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.NotNil(t, result[0].RemindedAt)
+		outboxRepo.AssertCalled(t, "CreateOutboxEvent", mock.Anything, mock.MatchedBy(func(event *entities.OutboxEvent) bool {
+			return event.Type == entities.EVENT_PAYMENT_DUE && event.LoanID == "loan1" && strings.Contains(event.Payload, reference)
+		}))
+	})
+
+	t.Run("error when the installments cannot be read", func(t *testing.T) {
+		paymentRepo := new(mocks.PaymentRepository)
+		paymentRepo.On("GetReminderDuePayments", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+
+		service := services.NewPaymentService(config.Config{PaymentReminderDays: 3}, paymentRepo, nil, nil, nil)
+		_, err := service.SendPaymentReminders(context.Background())
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.InternalServerError, errors.Unwrap(err))
+	})
 }
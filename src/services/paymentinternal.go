@@ -4,27 +4,143 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/google/uuid"
+	"github.com/verizhang/billing-engine/config"
 	"github.com/verizhang/billing-engine/src/entities"
 	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"github.com/verizhang/billing-engine/src/utils/paymentref"
+	"gorm.io/gorm"
 	"time"
 )
 
+// paymentReferenceAttempts bounds the draws of a free payment reference, a collision is already unlikely
+const paymentReferenceAttempts = 5
+
+func paymentReferenceFormat(cfg config.Config) paymentref.Format {
+	return paymentref.Format{
+		Prefix:     cfg.PaymentReferencePrefix,
+		Length:     cfg.PaymentReferenceLength,
+		CheckDigit: cfg.PaymentReferenceCheckDigit,
+	}
+}
+
+// paymentSource is where the money of a payment comes from, a zero amount takes the amount of the installment
+// and a zero paidAt the time the payment is posted
+type paymentSource struct {
+	channel   string
+	reference *string
+	amount    float64
+	paidAt    time.Time
+}
+
 func (s *paymentService) getActiveLoan(ctx context.Context, userID string) (*entities.Loan, error) {
 	loans, err := s.loanRepo.GetActiveLoansByUserID(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
 	}
 
-	var loan *entities.Loan
-	if len(loans) > 0 {
-		loan = loans[0]
-	} else {
-		return nil, fmt.Errorf("%w: %s", errorhandler.BadRequestError, errors.New("Active loan not found"))
+	if len(loans) == 0 {
+		return nil, errorhandler.BadRequest(errorhandler.REASON_NO_ACTIVE_LOAN, "Active loan not found")
+	}
+
+	if len(loans) > 1 {
+		return nil, errorhandler.BadRequest(errorhandler.REASON_MULTIPLE_ACTIVE_LOANS, "you have multiple active loans, please specify the loan")
+	}
+
+	return loans[0], nil
+}
+
+func (s *paymentService) getLoan(ctx context.Context, userID string, loanID string) (*entities.Loan, error) {
+	loan, err := s.loanRepo.GetLoanByID(ctx, loanID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errorhandler.NotFound(errorhandler.REASON_LOAN_NOT_FOUND, "loan not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	if loan.UserID != userID {
+		return nil, errorhandler.NotFound(errorhandler.REASON_LOAN_NOT_FOUND, "loan not found")
+	}
+
+	if !loan.IsActive && loan.Status != entities.LOAN_STATUS_WRITTEN_OFF {
+		return nil, errorhandler.BadRequest(errorhandler.REASON_LOAN_NOT_ACTIVE, "loan is not active")
 	}
 
 	return loan, nil
 }
 
+// matchProviderLoan finds the loan a provider payment is for, by its virtual account first,
+// then by the reference carrying either the payment reference or the id of the loan
+func (s *paymentService) matchProviderLoan(ctx context.Context, payment *entities.ProviderPayment) (*entities.Loan, error) {
+	var lookups []func() (*entities.Loan, error)
+	if payment.VirtualAccount != "" {
+		lookups = append(lookups, func() (*entities.Loan, error) {
+			return s.loanRepo.GetLoanByPaymentReference(ctx, payment.VirtualAccount)
+		})
+	}
+	if paymentReferenceFormat(s.cfg).Valid(payment.Reference) {
+		lookups = append(lookups, func() (*entities.Loan, error) {
+			return s.loanRepo.GetLoanByPaymentReference(ctx, payment.Reference)
+		})
+	} else if payment.Reference != "" {
+		lookups = append(lookups, func() (*entities.Loan, error) {
+			return s.loanRepo.GetLoanByID(ctx, payment.Reference)
+		})
+	}
+	if len(lookups) == 0 {
+		return nil, errorhandler.NotFound(errorhandler.REASON_PAYMENT_UNMATCHED, "payment carries no virtual account or reference")
+	}
+
+	for _, lookup := range lookups {
+		loan, err := lookup()
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+
+		return loan, checkPayable(loan)
+	}
+
+	return nil, errorhandler.NotFound(errorhandler.REASON_PAYMENT_UNMATCHED, "no loan matches the payment")
+}
+
+// getPayableLoan returns the loan external money was assigned to, by an operator or by reconciliation
+func (s *paymentService) getPayableLoan(ctx context.Context, loanID string) (*entities.Loan, error) {
+	loan, err := s.loanRepo.GetLoanByID(ctx, loanID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errorhandler.NotFound(errorhandler.REASON_LOAN_NOT_FOUND, "loan not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return loan, checkPayable(loan)
+}
+
+// checkPayable lets external money pay active loans and recover written off ones
+func checkPayable(loan *entities.Loan) error {
+	if !loan.IsActive && loan.Status != entities.LOAN_STATUS_WRITTEN_OFF {
+		return errorhandler.BadRequest(errorhandler.REASON_LOAN_NOT_ACTIVE, "loan is not active")
+	}
+	return nil
+}
+
+// getExternalTransaction returns the transaction posted for the reference of an external payment, nil when it was not posted yet
+func (s *paymentService) getExternalTransaction(ctx context.Context, channel string, reference string) (*entities.PaymentTransaction, error) {
+	transaction, err := s.txRepo.GetPaymentTransactionByReference(ctx, channel, reference)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return transaction, nil
+}
+
 func (s *paymentService) getEligiblePayment(payments []*entities.Payment, now *time.Time) *entities.Payment {
 	for _, payment := range payments {
 
@@ -36,6 +152,73 @@ func (s *paymentService) getEligiblePayment(payments []*entities.Payment, now *t
 	return nil
 }
 
+func (s *paymentService) getUnpaidPayment(payments []*entities.Payment) *entities.Payment {
+	for _, payment := range payments {
+		if payment.PaidAt == nil {
+			return payment
+		}
+	}
+
+	return nil
+}
+
+// recordTransaction records the money received for an installment, allocated in full to that installment
+func (s *paymentService) recordTransaction(ctx context.Context, tx *gorm.DB, loan *entities.Loan, payment *entities.Payment, source paymentSource, paidAt *time.Time, now *time.Time) error {
+	transactionID, err := uuid.NewUUID()
+	if err != nil {
+		return err
+	}
+	allocationID, err := uuid.NewUUID()
+	if err != nil {
+		return err
+	}
+
+	return s.uow.PaymentTransactionRepository(tx).CreatePaymentTransaction(ctx, &entities.PaymentTransaction{
+		ID:        transactionID.String(),
+		LoanID:    loan.ID,
+		UserID:    loan.UserID,
+		Amount:    payment.Amount,
+		Channel:   source.channel,
+		Reference: source.reference,
+		PaidAt:    paidAt,
+		Allocations: []*entities.PaymentAllocation{
+			{
+				ID:            allocationID.String(),
+				TransactionID: transactionID.String(),
+				PaymentID:     payment.ID,
+				Amount:        payment.Amount,
+				Principal:     payment.Principal,
+				Interest:      payment.Interest,
+				Fee:           payment.Fee,
+				CreatedAt:     now,
+			},
+		},
+		CreatedAt: now,
+	})
+}
+
+func (s *paymentService) recordRecovery(ctx context.Context, tx *gorm.DB, loan *entities.Loan, payment *entities.Payment, recoveredAt *time.Time, now *time.Time) error {
+	writeOff, err := s.uow.LoanWriteOffRepository(tx).GetLoanWriteOffByLoanID(ctx, loan.ID)
+	if err != nil {
+		return err
+	}
+
+	recoveryID, err := uuid.NewUUID()
+	if err != nil {
+		return err
+	}
+
+	return s.uow.LoanRecoveryRepository(tx).CreateLoanRecovery(ctx, &entities.LoanRecovery{
+		ID:          recoveryID.String(),
+		LoanID:      loan.ID,
+		WriteOffID:  writeOff.ID,
+		PaymentID:   payment.ID,
+		Amount:      payment.Amount,
+		RecoveredAt: recoveredAt,
+		CreatedAt:   now,
+	})
+}
+
 func (s *paymentService) isLastPayment(payments []*entities.Payment, ID string) bool {
 	lastPayment := payments[len(payments)-1]
 	if lastPayment.ID == ID {
@@ -43,3 +226,60 @@ func (s *paymentService) isLastPayment(payments []*entities.Payment, ID string)
 	}
 	return false
 }
+
+// remindPayment marks the installment reminded and writes PaymentDue in one transaction,
+// false when it was paid or reminded since it was read
+func (s *paymentService) remindPayment(ctx context.Context, payment *entities.Payment, now time.Time) (bool, error) {
+	loan, err := s.loanRepo.GetLoanByID(ctx, payment.LoanID)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	ok, err := s.uow.PaymentRepository(tx).UpdateRemindedAtPayment(ctx, payment.ID, now)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return false, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+	if !ok {
+		s.uow.Rollback(tx)
+		return false, nil
+	}
+
+	event := &entities.PaymentDueEvent{
+		LoanID:    loan.ID,
+		UserID:    loan.UserID,
+		PaymentID: payment.ID,
+		Amount:    payment.Amount,
+		DueAt:     *payment.EndAt,
+	}
+	if loan.PaymentReference != nil {
+		event.PaymentReference = *loan.PaymentReference
+	}
+	err = recordOutboxEvent(ctx, s.uow, tx, loan.ID, entities.EVENT_PAYMENT_DUE, event)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return false, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.uow.Commit(tx)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	payment.RemindedAt = &now
+	return true, nil
+}
+
+// getPaidAt is when the money of the source was paid, a time ahead of the clock of the service is taken as now
+func (s *paymentService) getPaidAt(source paymentSource, now time.Time) time.Time {
+	if source.paidAt.IsZero() || source.paidAt.After(now) {
+		return now
+	}
+
+	return source.paidAt
+}
@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/repositories"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"github.com/verizhang/billing-engine/src/utils/requestid"
+	"time"
+)
+
+type ProviderCallbackService interface {
+	HandleProviderPayment(ctx context.Context, payment *entities.ProviderPayment, payload []byte) (*entities.ProviderCallback, error)
+}
+
+type providerCallbackService struct {
+	paymentService PaymentService
+	callbackRepo   repositories.ProviderCallbackRepository
+}
+
+func NewProviderCallbackService(paymentService PaymentService, callbackRepo repositories.ProviderCallbackRepository) ProviderCallbackService {
+	return &providerCallbackService{
+		paymentService: paymentService,
+		callbackRepo:   callbackRepo,
+	}
+}
+
+// HandleProviderPayment posts a verified provider payment and records the notification with its outcome.
+// A payment that cannot be matched or posted is recorded for an operator and acknowledged,
+// only an internal failure is returned so the provider notifies again
+func (s *providerCallbackService) HandleProviderPayment(ctx context.Context, payment *entities.ProviderPayment, payload []byte) (*entities.ProviderCallback, error) {
+	callback := &entities.ProviderCallback{
+		ID:             uuid.NewString(),
+		Provider:       payment.Provider,
+		ExternalID:     payment.ExternalID,
+		VirtualAccount: payment.VirtualAccount,
+		Reference:      payment.Reference,
+		Amount:         payment.Amount,
+		Payload:        string(payload),
+		RequestID:      requestid.FromContext(ctx),
+		ReceivedAt:     time.Now(),
+	}
+
+	result, err := s.paymentService.PostProviderPayment(ctx, payment)
+	var e *errorhandler.Error
+	switch {
+	case err == nil && result.Duplicate:
+		callback.Status = entities.PROVIDER_CALLBACK_STATUS_DUPLICATE
+		callback.LoanID = &result.LoanID
+	case err == nil:
+		callback.Status = entities.PROVIDER_CALLBACK_STATUS_POSTED
+		callback.LoanID = &result.LoanID
+	case errors.Is(err, errorhandler.NotFoundError) && errors.As(err, &e):
+		callback.Status = entities.PROVIDER_CALLBACK_STATUS_UNMATCHED
+		callback.Reason = e.Reason
+	case errors.Is(err, errorhandler.BadRequestError) && errors.As(err, &e):
+		callback.Status = entities.PROVIDER_CALLBACK_STATUS_REJECTED
+		callback.Reason = e.Reason
+	default:
+		return nil, err
+	}
+
+	if err := s.callbackRepo.CreateProviderCallback(ctx, callback); err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return callback, nil
+}
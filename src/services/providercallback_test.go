@@ -0,0 +1,82 @@
+package services_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/verizhang/billing-engine/src/entities"
+	mocks "github.com/verizhang/billing-engine/src/repositories/mocks"
+	"github.com/verizhang/billing-engine/src/services"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"github.com/verizhang/billing-engine/src/utils/requestid"
+)
+
+// providerPaymentService posts every provider payment with the same outcome
+type providerPaymentService struct {
+	services.PaymentService
+	result *entities.ExternalPaymentResult
+	err    error
+}
+
+func (s *providerPaymentService) PostProviderPayment(ctx context.Context, payment *entities.ProviderPayment) (*entities.ExternalPaymentResult, error) {
+	return s.result, s.err
+}
+
+func TestProviderCallbackService_HandleProviderPayment(t *testing.T) {
+	payment := &entities.ProviderPayment{Provider: "generic", ExternalID: "pay1", Reference: "loan1", Amount: 110}
+	payload := []byte(`{"id":"pay1"}`)
+
+	tests := []struct {
+		name   string
+		result *entities.ExternalPaymentResult
+		err    error
+		status string
+		reason string
+	}{
+		{name: "posted", result: &entities.ExternalPaymentResult{LoanID: "loan1"}, status: entities.PROVIDER_CALLBACK_STATUS_POSTED},
+		{name: "duplicate", result: &entities.ExternalPaymentResult{LoanID: "loan1", Duplicate: true}, status: entities.PROVIDER_CALLBACK_STATUS_DUPLICATE},
+		{
+			name:   "unmatched",
+			err:    errorhandler.NotFound(errorhandler.REASON_PAYMENT_UNMATCHED, "no loan matches the payment reference"),
+			status: entities.PROVIDER_CALLBACK_STATUS_UNMATCHED,
+			reason: errorhandler.REASON_PAYMENT_UNMATCHED,
+		},
+		{
+			name:   "rejected",
+			err:    errorhandler.BadRequest(errorhandler.REASON_PAYMENT_AMOUNT_MISMATCH, "amount does not match"),
+			status: entities.PROVIDER_CALLBACK_STATUS_REJECTED,
+			reason: errorhandler.REASON_PAYMENT_AMOUNT_MISMATCH,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run("success record "+tt.name+" callback", func(t *testing.T) {
+			callbackRepo := new(mocks.ProviderCallbackRepository)
+			callbackRepo.On("CreateProviderCallback", mock.Anything, mock.AnythingOfType("*entities.ProviderCallback")).Return(nil)
+
+			service := services.NewProviderCallbackService(&providerPaymentService{result: tt.result, err: tt.err}, callbackRepo)
+			ctx := requestid.WithRequestID(context.Background(), "request1")
+			callback, err := service.HandleProviderPayment(ctx, payment, payload)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.status, callback.Status)
+			assert.Equal(t, tt.reason, callback.Reason)
+			assert.Equal(t, "request1", callback.RequestID)
+			assert.Equal(t, string(payload), callback.Payload)
+			assert.Equal(t, tt.result == nil, callback.LoanID == nil)
+			callbackRepo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("error when posting fails - should not record", func(t *testing.T) {
+		callbackRepo := new(mocks.ProviderCallbackRepository)
+		service := services.NewProviderCallbackService(&providerPaymentService{err: fmt.Errorf("%w: connection reset", errorhandler.InternalServerError)}, callbackRepo)
+		_, err := service.HandleProviderPayment(context.Background(), payment, payload)
+
+		assert.ErrorIs(t, err, errorhandler.InternalServerError)
+		callbackRepo.AssertNotCalled(t, "CreateProviderCallback", mock.Anything, mock.Anything)
+	})
+}
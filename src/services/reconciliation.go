@@ -0,0 +1,272 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/verizhang/billing-engine/config"
+	"github.com/verizhang/billing-engine/src/bankstatements"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/repositories"
+	"github.com/verizhang/billing-engine/src/utils/auth"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"github.com/verizhang/billing-engine/src/utils/pagination"
+	"gorm.io/gorm"
+	"time"
+)
+
+type ReconciliationService interface {
+	ImportBankStatement(ctx context.Context, format string, fileName string, content []byte) (*entities.BankStatementResult, error)
+	ListBankStatementLines(ctx context.Context, filter *entities.BankStatementLineFilter, pageSize int, pageToken string) (*entities.BankStatementLinePage, error)
+	ResolveBankStatementLine(ctx context.Context, lineID string, loanID string, note string) (*entities.BankStatementLine, error)
+	DismissBankStatementLine(ctx context.Context, lineID string, note string) (*entities.BankStatementLine, error)
+	GetReconciliationReport(ctx context.Context, from time.Time, to time.Time) (*entities.ReconciliationReport, error)
+}
+
+type reconciliationService struct {
+	cfg            config.Config
+	uow            repositories.UnitOfWork
+	loanRepo       repositories.LoanRepository
+	paymentRepo    repositories.PaymentRepository
+	importRepo     repositories.BankStatementImportRepository
+	lineRepo       repositories.BankStatementLineRepository
+	paymentService PaymentService
+}
+
+func NewReconciliationService(cfg config.Config, uow repositories.UnitOfWork, loanRepo repositories.LoanRepository, paymentRepo repositories.PaymentRepository, importRepo repositories.BankStatementImportRepository, lineRepo repositories.BankStatementLineRepository, paymentService PaymentService) ReconciliationService {
+	return &reconciliationService{
+		cfg:            cfg,
+		uow:            uow,
+		loanRepo:       loanRepo,
+		paymentRepo:    paymentRepo,
+		importRepo:     importRepo,
+		lineRepo:       lineRepo,
+		paymentService: paymentService,
+	}
+}
+
+// ImportBankStatement records the lines of a statement file and reconciles them: matched credits are posted to their loan,
+// unmatched, ambiguous and unpayable ones go to the exceptions queue. A file is imported once,
+// uploading it again reconciles the lines an interrupted import left pending and returns the import
+func (s *reconciliationService) ImportBankStatement(ctx context.Context, format string, fileName string, content []byte) (*entities.BankStatementResult, error) {
+	if !entities.IsValidBankStatementFormat(format) {
+		return nil, errorhandler.InvalidField("format", "must be csv, mt940 or camt053")
+	}
+	if len(content) == 0 {
+		return nil, errorhandler.InvalidField("content", "must not be empty")
+	}
+
+	sum := sha256.Sum256(content)
+	fileHash := hex.EncodeToString(sum[:])
+
+	statementImport, err := s.importRepo.GetBankStatementImportByFileHash(ctx, fileHash)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		statementImport, err = s.createImport(ctx, format, fileName, fileHash, content)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	lines, err := s.lineRepo.GetBankStatementLinesByImportID(ctx, statementImport.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	indistinct := indistinctLineKeys(lines)
+	for _, line := range lines {
+		if line.Status != entities.BANK_STATEMENT_LINE_STATUS_PENDING {
+			continue
+		}
+		// the lines after a failure stay pending for the next upload of the file
+		err = s.reconcileLine(ctx, line, indistinct[statementLineKey(line)])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &entities.BankStatementResult{Import: statementImport, Lines: lines}, nil
+}
+
+func (s *reconciliationService) ListBankStatementLines(ctx context.Context, filter *entities.BankStatementLineFilter, pageSize int, pageToken string) (*entities.BankStatementLinePage, error) {
+	cursor, err := pagination.DecodeCursor(pageToken)
+	if err != nil {
+		return nil, errorhandler.InvalidField("pageToken", err.Error())
+	}
+
+	pageSize = pagination.PageSize(pageSize)
+	lines, err := s.lineRepo.GetBankStatementLines(ctx, filter, cursor, pageSize+1)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	page := &entities.BankStatementLinePage{Lines: lines}
+	if len(lines) > pageSize {
+		page.Lines = lines[:pageSize]
+		last := page.Lines[pageSize-1]
+		page.NextPageToken = pagination.EncodeCursor(last.CreatedAt, last.ID)
+	}
+
+	return page, nil
+}
+
+// ResolveBankStatementLine posts an exception to the loan an operator assigned it to,
+// the reason it became an exception is kept for the report. The line pays the due installment in full,
+// a partial payment or an overpayment cannot be resolved and is dismissed instead. An indistinct line is posted once by its own id,
+// the operator having told it apart from the identical lines of its statement
+func (s *reconciliationService) ResolveBankStatementLine(ctx context.Context, lineID string, loanID string, note string) (*entities.BankStatementLine, error) {
+	line, err := s.getException(ctx, lineID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.validateLineCurrency(line)
+	if err != nil {
+		return nil, err
+	}
+
+	key := statementLineKey(line)
+	if line.Reason == errorhandler.REASON_STATEMENT_LINE_INDISTINCT {
+		key = line.ID
+	}
+
+	result, err := s.paymentService.PostStatementPayment(ctx, loanID, key, line.Amount)
+	var e *errorhandler.Error
+	if errors.As(err, &e) && e.Reason == errorhandler.REASON_PAYMENT_AMOUNT_MISMATCH {
+		return nil, errorhandler.BadRequest(e.Reason, e.Message+", a line only resolves to an installment of its amount, dismiss a partial payment or an overpayment")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	line.Status = entities.BANK_STATEMENT_LINE_STATUS_RESOLVED
+	if result.Duplicate {
+		line.Status = entities.BANK_STATEMENT_LINE_STATUS_DUPLICATE
+	}
+	line.LoanID = &result.LoanID
+	line.Note = note
+	return s.closeException(ctx, s.lineRepo, line)
+}
+
+// DismissBankStatementLine takes an exception out of the queue without posting it, for money handled outside the engine
+func (s *reconciliationService) DismissBankStatementLine(ctx context.Context, lineID string, note string) (*entities.BankStatementLine, error) {
+	if note == "" {
+		return nil, errorhandler.InvalidField("note", "must explain the dismissal")
+	}
+
+	line, err := s.getException(ctx, lineID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *line
+	line.Status = entities.BANK_STATEMENT_LINE_STATUS_DISMISSED
+	line.Note = note
+
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	line, err = s.closeException(ctx, s.uow.BankStatementLineRepository(tx), line)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, err
+	}
+
+	err = recordAuditEvent(ctx, s.uow, tx, &entities.AuditEvent{
+		Action:     entities.AUDIT_ACTION_BANK_STATEMENT_LINE_DISMISSED,
+		EntityType: entities.AUDIT_ENTITY_BANK_STATEMENT_LINE,
+		EntityID:   line.ID,
+	}, &before, line)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.uow.Commit(tx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return line, nil
+}
+
+func (s *reconciliationService) GetReconciliationReport(ctx context.Context, from time.Time, to time.Time) (*entities.ReconciliationReport, error) {
+	if !from.Before(to) {
+		return nil, errorhandler.InvalidField("from", "must be before to")
+	}
+
+	imports, err := s.importRepo.CountBankStatementImportsByDate(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	totals, err := s.lineRepo.GetReconciliationTotalsByDate(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return &entities.ReconciliationReport{
+		From:    from,
+		To:      to,
+		Imports: int(imports),
+		Totals:  totals,
+	}, nil
+}
+
+// createImport parses the file and stores the import with its lines pending, so an interrupted import can be resumed
+func (s *reconciliationService) createImport(ctx context.Context, format string, fileName string, fileHash string, content []byte) (*entities.BankStatementImport, error) {
+	lines, err := bankstatements.Parse(format, content)
+	if err != nil {
+		return nil, errorhandler.InvalidField("content", err.Error())
+	}
+
+	now := time.Now()
+	statementImport := &entities.BankStatementImport{
+		ID:         uuid.NewString(),
+		Format:     format,
+		FileName:   fileName,
+		FileHash:   fileHash,
+		Lines:      len(lines),
+		ImportedBy: auth.Actor(ctx),
+		ImportedAt: now,
+	}
+	for _, line := range lines {
+		line.ID = uuid.NewString()
+		line.ImportID = statementImport.ID
+		line.Status = entities.BANK_STATEMENT_LINE_STATUS_PENDING
+		line.CreatedAt = now
+		line.UpdatedAt = now
+	}
+
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.uow.BankStatementImportRepository(tx).CreateBankStatementImport(ctx, statementImport)
+	if err == nil {
+		err = s.uow.BankStatementLineRepository(tx).CreateBankStatementLines(ctx, lines)
+	}
+	if err == nil {
+		err = recordAuditEvent(ctx, s.uow, tx, &entities.AuditEvent{
+			Action:     entities.AUDIT_ACTION_BANK_STATEMENT_IMPORTED,
+			EntityType: entities.AUDIT_ENTITY_BANK_STATEMENT_IMPORT,
+			EntityID:   statementImport.ID,
+		}, nil, statementImport)
+	}
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.uow.Commit(tx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return statementImport, nil
+}
@@ -0,0 +1,416 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/verizhang/billing-engine/config"
+	"github.com/verizhang/billing-engine/src/entities"
+	mocks "github.com/verizhang/billing-engine/src/repositories/mocks"
+	"github.com/verizhang/billing-engine/src/services"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"github.com/verizhang/billing-engine/src/utils/paymentref"
+	"gorm.io/gorm"
+)
+
+// statementPaymentService posts statement payments through post and records them
+type statementPaymentService struct {
+	services.PaymentService
+	post  func(loanID string, amount float64) (*entities.ExternalPaymentResult, error)
+	posts []string
+}
+
+func (s *statementPaymentService) PostStatementPayment(ctx context.Context, loanID string, key string, amount float64) (*entities.ExternalPaymentResult, error) {
+	s.posts = append(s.posts, loanID+"|"+key)
+	return s.post(loanID, amount)
+}
+
+func TestReconciliationService_ImportBankStatement(t *testing.T) {
+	cfg := config.Config{LoanCurrency: "IDR", PaymentReferencePrefix: "8808", PaymentReferenceLength: 16, PaymentReferenceCheckDigit: paymentref.CHECK_DIGIT_LUHN}
+	format := paymentref.Format{Prefix: "8808", Length: 16, CheckDigit: paymentref.CHECK_DIGIT_LUHN}
+	reference1, _ := format.Generate()
+	reference2, _ := format.Generate()
+	posted := func(loanID string, amount float64) (*entities.ExternalPaymentResult, error) {
+		return &entities.ExternalPaymentResult{LoanID: loanID}, nil
+	}
+	// lineStatuses collects the status each line was updated to, by line number
+	lineStatuses := func(lineRepo *mocks.BankStatementLineRepository) map[int]*entities.BankStatementLine {
+		updated := map[int]*entities.BankStatementLine{}
+		lineRepo.On("UpdateBankStatementLine", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			line := args.Get(1).(*entities.BankStatementLine)
+			updated[line.LineNo] = line
+		}).Return(nil)
+		return updated
+	}
+
+	t.Run("success post matched credits and queue the rest", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		loanRepo := new(mocks.LoanRepository)
+		importRepo := new(mocks.BankStatementImportRepository)
+		lineRepo := new(mocks.BankStatementLineRepository)
+		mockTx := &gorm.DB{}
+		content := []byte("date,amount,reference,description,bank_reference\n" +
+			"2026-03-02,110," + reference1 + ",installment,TRX001\n" +
+			"2026-03-02,110,,no reference here,TRX002\n" +
+			"2026-03-02,-5,,bank charges,TRX003\n")
+
+		var created []*entities.BankStatementLine
+		importRepo.On("GetBankStatementImportByFileHash", mock.Anything, mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		uow.On("BankStatementImportRepository", mockTx).Return(importRepo)
+		uow.On("BankStatementLineRepository", mockTx).Return(lineRepo)
+		auditRepo := expectAuditEvents(uow, mockTx)
+		importRepo.On("CreateBankStatementImport", mock.Anything, mock.AnythingOfType("*entities.BankStatementImport")).Return(nil)
+		lineRepo.On("CreateBankStatementLines", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			created = args.Get(1).([]*entities.BankStatementLine)
+		}).Return(nil)
+		lineRepo.On("GetBankStatementLinesByImportID", mock.Anything, mock.Anything).Return(func(ctx context.Context, importID string) []*entities.BankStatementLine {
+			return created
+		}, nil)
+		updated := lineStatuses(lineRepo)
+		loanRepo.On("GetLoanByPaymentReference", mock.Anything, reference1).Return(&entities.Loan{ID: "loan1"}, nil)
+
+		paymentService := &statementPaymentService{post: posted}
+		service := services.NewReconciliationService(cfg, uow, loanRepo, nil, importRepo, lineRepo, paymentService)
+		result, err := service.ImportBankStatement(context.Background(), entities.BANK_STATEMENT_FORMAT_CSV, "statement.csv", content)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, result.Import.Lines)
+		assert.Len(t, result.Lines, 3)
+		assert.Equal(t, []string{"loan1|TRX001"}, paymentService.posts)
+		assert.Equal(t, entities.BANK_STATEMENT_LINE_STATUS_POSTED, updated[1].Status)
+		assert.Equal(t, "loan1", *updated[1].LoanID)
+		assert.Equal(t, entities.BANK_STATEMENT_LINE_STATUS_EXCEPTION, updated[2].Status)
+		assert.Equal(t, errorhandler.REASON_PAYMENT_UNMATCHED, updated[2].Reason)
+		assert.Equal(t, entities.BANK_STATEMENT_LINE_STATUS_IGNORED, updated[3].Status)
+		auditRepo.AssertCalled(t, "CreateAuditEvent", mock.Anything, mock.MatchedBy(func(event *entities.AuditEvent) bool {
+			return event.Action == entities.AUDIT_ACTION_BANK_STATEMENT_IMPORTED && event.EntityID == result.Import.ID && event.LoanID == nil
+		}))
+		uow.AssertExpectations(t)
+	})
+
+	t.Run("success resume the pending lines of a file uploaded again", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		importRepo := new(mocks.BankStatementImportRepository)
+		lineRepo := new(mocks.BankStatementLineRepository)
+
+		statementImport := &entities.BankStatementImport{ID: "import1", Lines: 2}
+		lines := []*entities.BankStatementLine{
+			{ID: "line1", LineNo: 1, Amount: 110, Reference: reference1, Status: entities.BANK_STATEMENT_LINE_STATUS_POSTED},
+			{ID: "line2", LineNo: 2, Amount: 110, Reference: reference2, BankReference: "TRX002", Status: entities.BANK_STATEMENT_LINE_STATUS_PENDING},
+		}
+		importRepo.On("GetBankStatementImportByFileHash", mock.Anything, mock.Anything).Return(statementImport, nil)
+		lineRepo.On("GetBankStatementLinesByImportID", mock.Anything, "import1").Return(lines, nil)
+		updated := lineStatuses(lineRepo)
+		loanRepo.On("GetLoanByPaymentReference", mock.Anything, reference2).Return(&entities.Loan{ID: "loan2"}, nil)
+
+		paymentService := &statementPaymentService{post: posted}
+		service := services.NewReconciliationService(cfg, nil, loanRepo, nil, importRepo, lineRepo, paymentService)
+		result, err := service.ImportBankStatement(context.Background(), entities.BANK_STATEMENT_FORMAT_CSV, "statement.csv", []byte("same file"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "import1", result.Import.ID)
+		assert.Equal(t, []string{"loan2|TRX002"}, paymentService.posts)
+		assert.Len(t, updated, 1)
+		assert.Equal(t, entities.BANK_STATEMENT_LINE_STATUS_POSTED, updated[2].Status)
+	})
+
+	t.Run("success match the loan whose installment is the amount", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		importRepo := new(mocks.BankStatementImportRepository)
+		lineRepo := new(mocks.BankStatementLineRepository)
+
+		paidAt := time.Now()
+		lines := []*entities.BankStatementLine{
+			{ID: "line1", LineNo: 1, Amount: 110, Description: reference1 + " " + reference2, Status: entities.BANK_STATEMENT_LINE_STATUS_PENDING},
+		}
+		importRepo.On("GetBankStatementImportByFileHash", mock.Anything, mock.Anything).Return(&entities.BankStatementImport{ID: "import1"}, nil)
+		lineRepo.On("GetBankStatementLinesByImportID", mock.Anything, "import1").Return(lines, nil)
+		updated := lineStatuses(lineRepo)
+		loanRepo.On("GetLoanByPaymentReference", mock.Anything, reference1).Return(&entities.Loan{ID: "loan1"}, nil)
+		loanRepo.On("GetLoanByPaymentReference", mock.Anything, reference2).Return(&entities.Loan{ID: "loan2"}, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return([]*entities.Payment{{ID: "payment1", Amount: 220}}, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan2").Return([]*entities.Payment{
+			{ID: "payment2", Amount: 220, PaidAt: &paidAt},
+			{ID: "payment3", Amount: 110},
+		}, nil)
+
+		paymentService := &statementPaymentService{post: posted}
+		service := services.NewReconciliationService(cfg, nil, loanRepo, paymentRepo, importRepo, lineRepo, paymentService)
+		_, err := service.ImportBankStatement(context.Background(), entities.BANK_STATEMENT_FORMAT_CSV, "statement.csv", []byte("file"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.BANK_STATEMENT_LINE_STATUS_POSTED, updated[1].Status)
+		assert.Equal(t, "loan2", *updated[1].LoanID)
+	})
+
+	t.Run("success queue ambiguous and unpayable lines", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		importRepo := new(mocks.BankStatementImportRepository)
+		lineRepo := new(mocks.BankStatementLineRepository)
+
+		lines := []*entities.BankStatementLine{
+			{ID: "line1", LineNo: 1, Amount: 110, Reference: reference1, Description: "loan 0b9a3e52-2f7c-11f0-9cd2-0242ac120002", Status: entities.BANK_STATEMENT_LINE_STATUS_PENDING},
+			{ID: "line2", LineNo: 2, Amount: 99, Reference: reference1, Status: entities.BANK_STATEMENT_LINE_STATUS_PENDING},
+			{ID: "line3", LineNo: 3, Amount: 110, Currency: "USD", Reference: reference2, Status: entities.BANK_STATEMENT_LINE_STATUS_PENDING},
+		}
+		importRepo.On("GetBankStatementImportByFileHash", mock.Anything, mock.Anything).Return(&entities.BankStatementImport{ID: "import1"}, nil)
+		lineRepo.On("GetBankStatementLinesByImportID", mock.Anything, "import1").Return(lines, nil)
+		updated := lineStatuses(lineRepo)
+		loanRepo.On("GetLoanByPaymentReference", mock.Anything, reference1).Return(&entities.Loan{ID: "loan1"}, nil)
+		loanRepo.On("GetLoanByPaymentReference", mock.Anything, reference2).Return(&entities.Loan{ID: "loan2"}, nil)
+		loanRepo.On("GetLoanByID", mock.Anything, "0b9a3e52-2f7c-11f0-9cd2-0242ac120002").Return(&entities.Loan{ID: "0b9a3e52-2f7c-11f0-9cd2-0242ac120002"}, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, mock.Anything).Return([]*entities.Payment{{ID: "payment1", Amount: 110}}, nil)
+
+		paymentService := &statementPaymentService{post: func(loanID string, amount float64) (*entities.ExternalPaymentResult, error) {
+			return nil, errorhandler.BadRequest(errorhandler.REASON_PAYMENT_AMOUNT_MISMATCH, "amount does not match")
+		}}
+		service := services.NewReconciliationService(cfg, nil, loanRepo, paymentRepo, importRepo, lineRepo, paymentService)
+		_, err := service.ImportBankStatement(context.Background(), entities.BANK_STATEMENT_FORMAT_CSV, "statement.csv", []byte("file"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.BANK_STATEMENT_LINE_STATUS_EXCEPTION, updated[1].Status)
+		assert.Equal(t, errorhandler.REASON_PAYMENT_AMBIGUOUS, updated[1].Reason)
+		assert.Equal(t, entities.BANK_STATEMENT_LINE_STATUS_EXCEPTION, updated[2].Status)
+		assert.Equal(t, errorhandler.REASON_PAYMENT_AMOUNT_MISMATCH, updated[2].Reason)
+		assert.Equal(t, "loan1", *updated[2].LoanID)
+		assert.Equal(t, entities.BANK_STATEMENT_LINE_STATUS_EXCEPTION, updated[3].Status)
+		assert.Equal(t, errorhandler.REASON_CURRENCY_MISMATCH, updated[3].Reason)
+	})
+
+	t.Run("success queue identical lines of the statement instead of taking them for duplicates", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		importRepo := new(mocks.BankStatementImportRepository)
+		lineRepo := new(mocks.BankStatementLineRepository)
+
+		bookedAt := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+		lines := []*entities.BankStatementLine{
+			{ID: "line1", LineNo: 1, BookedAt: bookedAt, Amount: 110, Reference: reference1, Status: entities.BANK_STATEMENT_LINE_STATUS_PENDING},
+			{ID: "line2", LineNo: 2, BookedAt: bookedAt, Amount: 110, Reference: reference1, Status: entities.BANK_STATEMENT_LINE_STATUS_PENDING},
+			{ID: "line3", LineNo: 3, BookedAt: bookedAt, Amount: 110, Reference: reference2, Status: entities.BANK_STATEMENT_LINE_STATUS_PENDING},
+		}
+		importRepo.On("GetBankStatementImportByFileHash", mock.Anything, mock.Anything).Return(&entities.BankStatementImport{ID: "import1"}, nil)
+		lineRepo.On("GetBankStatementLinesByImportID", mock.Anything, "import1").Return(lines, nil)
+		updated := lineStatuses(lineRepo)
+		loanRepo.On("GetLoanByPaymentReference", mock.Anything, reference2).Return(&entities.Loan{ID: "loan2"}, nil)
+
+		paymentService := &statementPaymentService{post: posted}
+		service := services.NewReconciliationService(cfg, nil, loanRepo, nil, importRepo, lineRepo, paymentService)
+		_, err := service.ImportBankStatement(context.Background(), entities.BANK_STATEMENT_FORMAT_CSV, "statement.csv", []byte("file"))
+
+		assert.NoError(t, err)
+		assert.Len(t, paymentService.posts, 1)
+		for _, lineNo := range []int{1, 2} {
+			assert.Equal(t, entities.BANK_STATEMENT_LINE_STATUS_EXCEPTION, updated[lineNo].Status)
+			assert.Equal(t, errorhandler.REASON_STATEMENT_LINE_INDISTINCT, updated[lineNo].Reason)
+		}
+		assert.Equal(t, entities.BANK_STATEMENT_LINE_STATUS_POSTED, updated[3].Status)
+	})
+
+	t.Run("error when posting fails - should leave line pending", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		importRepo := new(mocks.BankStatementImportRepository)
+		lineRepo := new(mocks.BankStatementLineRepository)
+
+		lines := []*entities.BankStatementLine{{ID: "line1", LineNo: 1, Amount: 110, Reference: reference1, Status: entities.BANK_STATEMENT_LINE_STATUS_PENDING}}
+		importRepo.On("GetBankStatementImportByFileHash", mock.Anything, mock.Anything).Return(&entities.BankStatementImport{ID: "import1"}, nil)
+		lineRepo.On("GetBankStatementLinesByImportID", mock.Anything, "import1").Return(lines, nil)
+		loanRepo.On("GetLoanByPaymentReference", mock.Anything, reference1).Return(&entities.Loan{ID: "loan1"}, nil)
+
+		paymentService := &statementPaymentService{post: func(loanID string, amount float64) (*entities.ExternalPaymentResult, error) {
+			return nil, fmt.Errorf("%w: connection reset", errorhandler.InternalServerError)
+		}}
+		service := services.NewReconciliationService(cfg, nil, loanRepo, nil, importRepo, lineRepo, paymentService)
+		_, err := service.ImportBankStatement(context.Background(), entities.BANK_STATEMENT_FORMAT_CSV, "statement.csv", []byte("file"))
+
+		assert.ErrorIs(t, err, errorhandler.InternalServerError)
+		lineRepo.AssertNotCalled(t, "UpdateBankStatementLine", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error when file is invalid", func(t *testing.T) {
+		importRepo := new(mocks.BankStatementImportRepository)
+		importRepo.On("GetBankStatementImportByFileHash", mock.Anything, mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+		service := services.NewReconciliationService(cfg, nil, nil, nil, importRepo, nil, nil)
+
+		_, err := service.ImportBankStatement(context.Background(), "bai2", "statement.bai", []byte("file"))
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+
+		_, err = service.ImportBankStatement(context.Background(), entities.BANK_STATEMENT_FORMAT_CSV, "statement.csv", []byte("date\n2026-03-02\n"))
+		var e *errorhandler.Error
+		assert.ErrorAs(t, err, &e)
+		assert.Equal(t, "content", e.Violations[0].Field)
+	})
+}
+
+func TestReconciliationService_ResolveBankStatementLine(t *testing.T) {
+	exception := func() *entities.BankStatementLine {
+		return &entities.BankStatementLine{ID: "line1", Amount: 110, BankReference: "TRX001", Status: entities.BANK_STATEMENT_LINE_STATUS_EXCEPTION, Reason: errorhandler.REASON_PAYMENT_UNMATCHED}
+	}
+
+	t.Run("success post exception to the assigned loan", func(t *testing.T) {
+		lineRepo := new(mocks.BankStatementLineRepository)
+		lineRepo.On("GetBankStatementLineByID", mock.Anything, "line1").Return(exception(), nil)
+		lineRepo.On("UpdateBankStatementLine", mock.Anything, mock.Anything).Return(nil)
+
+		paymentService := &statementPaymentService{post: func(loanID string, amount float64) (*entities.ExternalPaymentResult, error) {
+			return &entities.ExternalPaymentResult{LoanID: loanID}, nil
+		}}
+		service := services.NewReconciliationService(config.Config{}, nil, nil, nil, nil, lineRepo, paymentService)
+		line, err := service.ResolveBankStatementLine(context.Background(), "line1", "loan1", "borrower called")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"loan1|TRX001"}, paymentService.posts)
+		assert.Equal(t, entities.BANK_STATEMENT_LINE_STATUS_RESOLVED, line.Status)
+		assert.Equal(t, errorhandler.REASON_PAYMENT_UNMATCHED, line.Reason)
+		assert.Equal(t, "loan1", *line.LoanID)
+		assert.Equal(t, "system", line.ResolvedBy)
+		assert.NotNil(t, line.ResolvedAt)
+	})
+
+	t.Run("success post an indistinct line by its own id", func(t *testing.T) {
+		line := exception()
+		line.BankReference = ""
+		line.Reason = errorhandler.REASON_STATEMENT_LINE_INDISTINCT
+		lineRepo := new(mocks.BankStatementLineRepository)
+		lineRepo.On("GetBankStatementLineByID", mock.Anything, "line1").Return(line, nil)
+		lineRepo.On("UpdateBankStatementLine", mock.Anything, mock.Anything).Return(nil)
+
+		paymentService := &statementPaymentService{post: func(loanID string, amount float64) (*entities.ExternalPaymentResult, error) {
+			return &entities.ExternalPaymentResult{LoanID: loanID}, nil
+		}}
+		service := services.NewReconciliationService(config.Config{}, nil, nil, nil, nil, lineRepo, paymentService)
+		_, err := service.ResolveBankStatementLine(context.Background(), "line1", "loan1", "second transfer of the day")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"loan1|line1"}, paymentService.posts)
+	})
+
+	t.Run("error when posting is rejected - should keep exception", func(t *testing.T) {
+		lineRepo := new(mocks.BankStatementLineRepository)
+		lineRepo.On("GetBankStatementLineByID", mock.Anything, "line1").Return(exception(), nil)
+
+		paymentService := &statementPaymentService{post: func(loanID string, amount float64) (*entities.ExternalPaymentResult, error) {
+			return nil, errorhandler.BadRequest(errorhandler.REASON_LOAN_NOT_ACTIVE, "loan is not active")
+		}}
+		service := services.NewReconciliationService(config.Config{}, nil, nil, nil, nil, lineRepo, paymentService)
+		_, err := service.ResolveBankStatementLine(context.Background(), "line1", "loan1", "")
+
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+		lineRepo.AssertNotCalled(t, "UpdateBankStatementLine", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error when amount does not pay the installment - should explain the dismissal", func(t *testing.T) {
+		lineRepo := new(mocks.BankStatementLineRepository)
+		lineRepo.On("GetBankStatementLineByID", mock.Anything, "line1").Return(exception(), nil)
+
+		paymentService := &statementPaymentService{post: func(loanID string, amount float64) (*entities.ExternalPaymentResult, error) {
+			return nil, errorhandler.BadRequest(errorhandler.REASON_PAYMENT_AMOUNT_MISMATCH, "amount 110.00 does not match the installment of 220.00")
+		}}
+		service := services.NewReconciliationService(config.Config{}, nil, nil, nil, nil, lineRepo, paymentService)
+		_, err := service.ResolveBankStatementLine(context.Background(), "line1", "loan1", "")
+
+		var e *errorhandler.Error
+		assert.ErrorAs(t, err, &e)
+		assert.Equal(t, errorhandler.REASON_PAYMENT_AMOUNT_MISMATCH, e.Reason)
+		assert.Contains(t, e.Message, "dismiss")
+		lineRepo.AssertNotCalled(t, "UpdateBankStatementLine", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error when line is not an exception", func(t *testing.T) {
+		line := exception()
+		line.Status = entities.BANK_STATEMENT_LINE_STATUS_POSTED
+		lineRepo := new(mocks.BankStatementLineRepository)
+		lineRepo.On("GetBankStatementLineByID", mock.Anything, "line1").Return(line, nil)
+
+		service := services.NewReconciliationService(config.Config{}, nil, nil, nil, nil, lineRepo, nil)
+		_, err := service.ResolveBankStatementLine(context.Background(), "line1", "loan1", "")
+
+		var e *errorhandler.Error
+		assert.ErrorAs(t, err, &e)
+		assert.Equal(t, errorhandler.REASON_STATEMENT_LINE_NOT_EXCEPTION, e.Reason)
+	})
+
+	t.Run("error when line is not found", func(t *testing.T) {
+		lineRepo := new(mocks.BankStatementLineRepository)
+		lineRepo.On("GetBankStatementLineByID", mock.Anything, "line1").Return(nil, gorm.ErrRecordNotFound)
+
+		service := services.NewReconciliationService(config.Config{}, nil, nil, nil, nil, lineRepo, nil)
+		_, err := service.ResolveBankStatementLine(context.Background(), "line1", "loan1", "")
+
+		assert.Equal(t, errorhandler.NotFoundError, errors.Unwrap(err))
+	})
+}
+
+func TestReconciliationService_DismissBankStatementLine(t *testing.T) {
+	t.Run("success dismiss exception", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		lineRepo := new(mocks.BankStatementLineRepository)
+		mockTx := &gorm.DB{}
+
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		uow.On("BankStatementLineRepository", mockTx).Return(lineRepo)
+		auditRepo := expectAuditEvents(uow, mockTx)
+		lineRepo.On("GetBankStatementLineByID", mock.Anything, "line1").Return(&entities.BankStatementLine{ID: "line1", Status: entities.BANK_STATEMENT_LINE_STATUS_EXCEPTION}, nil)
+		lineRepo.On("UpdateBankStatementLine", mock.Anything, mock.Anything).Return(nil)
+
+		service := services.NewReconciliationService(config.Config{}, uow, nil, nil, nil, lineRepo, nil)
+		line, err := service.DismissBankStatementLine(context.Background(), "line1", "refunded to sender")
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.BANK_STATEMENT_LINE_STATUS_DISMISSED, line.Status)
+		assert.Equal(t, "refunded to sender", line.Note)
+		auditRepo.AssertCalled(t, "GetLastAuditEventByEntity", mock.Anything, entities.AUDIT_ENTITY_BANK_STATEMENT_LINE, "line1")
+		auditRepo.AssertCalled(t, "CreateAuditEvent", mock.Anything, mock.MatchedBy(func(event *entities.AuditEvent) bool {
+			return event.Action == entities.AUDIT_ACTION_BANK_STATEMENT_LINE_DISMISSED &&
+				strings.Contains(*event.Before, entities.BANK_STATEMENT_LINE_STATUS_EXCEPTION) && strings.Contains(*event.After, entities.BANK_STATEMENT_LINE_STATUS_DISMISSED)
+		}))
+	})
+
+	t.Run("error when note is missing", func(t *testing.T) {
+		service := services.NewReconciliationService(config.Config{}, nil, nil, nil, nil, nil, nil)
+		_, err := service.DismissBankStatementLine(context.Background(), "line1", "")
+
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+	})
+}
+
+func TestReconciliationService_GetReconciliationReport(t *testing.T) {
+	t.Run("success report totals by status", func(t *testing.T) {
+		importRepo := new(mocks.BankStatementImportRepository)
+		lineRepo := new(mocks.BankStatementLineRepository)
+
+		to := time.Now()
+		from := to.AddDate(0, 0, -7)
+		totals := []*entities.ReconciliationTotal{
+			{Status: entities.BANK_STATEMENT_LINE_STATUS_EXCEPTION, Lines: 1, Amount: 110},
+			{Status: entities.BANK_STATEMENT_LINE_STATUS_POSTED, Lines: 3, Amount: 330},
+		}
+		importRepo.On("CountBankStatementImportsByDate", mock.Anything, from, to).Return(int64(2), nil)
+		lineRepo.On("GetReconciliationTotalsByDate", mock.Anything, from, to).Return(totals, nil)
+
+		service := services.NewReconciliationService(config.Config{}, nil, nil, nil, importRepo, lineRepo, nil)
+		report, err := service.GetReconciliationReport(context.Background(), from, to)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, report.Imports)
+		assert.Equal(t, totals, report.Totals)
+	})
+
+	t.Run("error when range is invalid", func(t *testing.T) {
+		now := time.Now()
+		service := services.NewReconciliationService(config.Config{}, nil, nil, nil, nil, nil, nil)
+		_, err := service.GetReconciliationReport(context.Background(), now, now)
+
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+	})
+}
@@ -0,0 +1,206 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/repositories"
+	"github.com/verizhang/billing-engine/src/utils/auth"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"gorm.io/gorm"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// statementNumber finds the numbers of a statement line, those with a valid check digit are payment references
+	statementNumber = regexp.MustCompile(`\d+`)
+	statementLoanID = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+)
+
+// reconcileLine posts a credit to the loan it matches or queues it as an exception, debits are ignored.
+// An indistinct credit shares its key with another line of the statement and is queued, it cannot be told from a duplicate.
+// Only an internal failure is returned, the line then stays pending
+func (s *reconciliationService) reconcileLine(ctx context.Context, line *entities.BankStatementLine, indistinct bool) error {
+	if line.Amount <= 0 {
+		line.Status = entities.BANK_STATEMENT_LINE_STATUS_IGNORED
+	} else if indistinct {
+		line.Status = entities.BANK_STATEMENT_LINE_STATUS_EXCEPTION
+		line.Reason = errorhandler.REASON_STATEMENT_LINE_INDISTINCT
+	} else {
+		var result *entities.ExternalPaymentResult
+		loan, err := s.matchLine(ctx, line)
+		if err == nil {
+			line.LoanID = &loan.ID
+			err = s.validateLineCurrency(line)
+		}
+		if err == nil {
+			result, err = s.paymentService.PostStatementPayment(ctx, loan.ID, statementLineKey(line), line.Amount)
+		}
+
+		var e *errorhandler.Error
+		switch {
+		case err == nil && result.Duplicate:
+			line.Status = entities.BANK_STATEMENT_LINE_STATUS_DUPLICATE
+			line.LoanID = &result.LoanID
+		case err == nil:
+			line.Status = entities.BANK_STATEMENT_LINE_STATUS_POSTED
+		case errors.As(err, &e):
+			line.Status = entities.BANK_STATEMENT_LINE_STATUS_EXCEPTION
+			line.Reason = e.Reason
+		default:
+			return err
+		}
+	}
+
+	line.UpdatedAt = time.Now()
+	err := s.lineRepo.UpdateBankStatementLine(ctx, line)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+// matchLine finds the loan of a line by the payment references and loan ids in its reference and description.
+// A line naming several loans goes to the one whose due installment is the amount of the line, if only one is
+func (s *reconciliationService) matchLine(ctx context.Context, line *entities.BankStatementLine) (*entities.Loan, error) {
+	text := line.Reference + " " + line.Description
+	format := paymentReferenceFormat(s.cfg)
+
+	var loans []*entities.Loan
+	add := func(loan *entities.Loan, err error) error {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+		for _, found := range loans {
+			if found.ID == loan.ID {
+				return nil
+			}
+		}
+		loans = append(loans, loan)
+		return nil
+	}
+
+	for _, number := range statementNumber.FindAllString(text, -1) {
+		if !format.Valid(number) {
+			continue
+		}
+		if err := add(s.loanRepo.GetLoanByPaymentReference(ctx, number)); err != nil {
+			return nil, err
+		}
+	}
+	for _, loanID := range statementLoanID.FindAllString(text, -1) {
+		if err := add(s.loanRepo.GetLoanByID(ctx, loanID)); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(loans) == 0 {
+		return nil, errorhandler.NotFound(errorhandler.REASON_PAYMENT_UNMATCHED, "no loan matches the line")
+	}
+	if len(loans) == 1 {
+		return loans[0], nil
+	}
+
+	var matched []*entities.Loan
+	for _, loan := range loans {
+		payments, err := s.paymentRepo.GetPaymentByLoanID(ctx, loan.ID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+		for _, payment := range payments {
+			if payment.PaidAt == nil {
+				if math.Abs(payment.Amount-line.Amount) < 0.01 {
+					matched = append(matched, loan)
+				}
+				break
+			}
+		}
+	}
+	if len(matched) != 1 {
+		return nil, errorhandler.BadRequest(errorhandler.REASON_PAYMENT_AMBIGUOUS, fmt.Sprintf("line names %d loans and matches the installment of %d", len(loans), len(matched)))
+	}
+
+	return matched[0], nil
+}
+
+// validateLineCurrency rejects a line in another currency than the loans, a line without currency is in the currency of the account
+func (s *reconciliationService) validateLineCurrency(line *entities.BankStatementLine) error {
+	if line.Currency == "" || strings.EqualFold(line.Currency, s.cfg.LoanCurrency) {
+		return nil
+	}
+
+	return errorhandler.BadRequest(errorhandler.REASON_CURRENCY_MISMATCH, fmt.Sprintf("line is in %s, loans are in %s", line.Currency, s.cfg.LoanCurrency))
+}
+
+// statementLineKey identifies the money of a line across statements, so overlapping statements post it once.
+// Lines without a bank reference are told apart by their date, amount and texts
+func statementLineKey(line *entities.BankStatementLine) string {
+	if line.BankReference != "" && len(line.BankReference) <= 64 {
+		return line.BankReference
+	}
+
+	sum := sha256.Sum256([]byte(line.BookedAt.Format("2006-01-02") + "|" +
+		strconv.FormatFloat(line.Amount, 'f', 2, 64) + "|" +
+		line.Currency + "|" + line.Reference + "|" + line.Description + "|" + line.BankReference))
+	return hex.EncodeToString(sum[:])
+}
+
+// indistinctLineKeys returns the keys shared by several lines of a statement, such as identical transfers of a day
+// without a bank reference, posting them by key would take all but the first for duplicates
+func indistinctLineKeys(lines []*entities.BankStatementLine) map[string]bool {
+	counts := map[string]int{}
+	for _, line := range lines {
+		counts[statementLineKey(line)]++
+	}
+
+	indistinct := map[string]bool{}
+	for key, count := range counts {
+		if count > 1 {
+			indistinct[key] = true
+		}
+	}
+
+	return indistinct
+}
+
+func (s *reconciliationService) getException(ctx context.Context, lineID string) (*entities.BankStatementLine, error) {
+	line, err := s.lineRepo.GetBankStatementLineByID(ctx, lineID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errorhandler.NotFound(errorhandler.REASON_STATEMENT_LINE_NOT_FOUND, "bank statement line not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	if line.Status != entities.BANK_STATEMENT_LINE_STATUS_EXCEPTION {
+		return nil, errorhandler.BadRequest(errorhandler.REASON_STATEMENT_LINE_NOT_EXCEPTION, "bank statement line is not in the exceptions queue")
+	}
+
+	return line, nil
+}
+
+// closeException records who took the line out of the exceptions queue
+func (s *reconciliationService) closeException(ctx context.Context, lineRepo repositories.BankStatementLineRepository, line *entities.BankStatementLine) (*entities.BankStatementLine, error) {
+	now := time.Now()
+	line.ResolvedBy = auth.Actor(ctx)
+	line.ResolvedAt = &now
+	line.UpdatedAt = now
+
+	err := lineRepo.UpdateBankStatementLine(ctx, line)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return line, nil
+}
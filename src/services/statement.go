@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/repositories"
+	"github.com/verizhang/billing-engine/src/utils/auth"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"gorm.io/gorm"
+	"time"
+)
+
+type StatementService interface {
+	GenerateStatement(ctx context.Context, loanID string, from time.Time, to time.Time) (*entities.Statement, error)
+	RenderStatement(ctx context.Context, loanID string, from time.Time, to time.Time, format string) ([]byte, string, error)
+}
+
+type statementService struct {
+	loanRepo     repositories.LoanRepository
+	paymentRepo  repositories.PaymentRepository
+	scheduleRepo repositories.PaymentScheduleRepository
+	deferralRepo repositories.PaymentDeferralRepository
+}
+
+func NewStatementService(loanRepo repositories.LoanRepository, paymentRepo repositories.PaymentRepository, scheduleRepo repositories.PaymentScheduleRepository, deferralRepo repositories.PaymentDeferralRepository) StatementService {
+	return &statementService{
+		loanRepo:     loanRepo,
+		paymentRepo:  paymentRepo,
+		scheduleRepo: scheduleRepo,
+		deferralRepo: deferralRepo,
+	}
+}
+
+func (s *statementService) GenerateStatement(ctx context.Context, loanID string, from time.Time, to time.Time) (*entities.Statement, error) {
+	if !from.Before(to) {
+		return nil, errorhandler.InvalidField("from", "must be before to")
+	}
+
+	loan, err := s.loanRepo.GetLoanByID(ctx, loanID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errorhandler.NotFound(errorhandler.REASON_LOAN_NOT_FOUND, "loan not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+	// statements are requested by loan id only, a borrower must not learn about the loans of others
+	if !auth.CanAccessUser(ctx, loan.UserID) {
+		return nil, errorhandler.NotFound(errorhandler.REASON_LOAN_NOT_FOUND, "loan not found")
+	}
+
+	schedules, err := s.scheduleRepo.GetPaymentSchedulesByLoanID(ctx, loan.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	payments, err := s.paymentRepo.GetPaymentHistoryByLoanID(ctx, loan.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	deferrals, err := s.deferralRepo.GetPaymentDeferralsByLoanID(ctx, loan.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	statement := &entities.Statement{
+		Loan: loan,
+		From: from,
+		To:   to,
+	}
+	balance := float64(0)
+	for _, entry := range s.getStatementEntries(loan, schedules, payments, deferrals) {
+		if !entry.Date.Before(to) {
+			break
+		}
+
+		balance = balance + entry.Amount
+		entry.Balance = balance
+		if entry.Date.Before(from) {
+			statement.OpeningBalance = balance
+			continue
+		}
+		statement.Entries = append(statement.Entries, entry)
+	}
+	statement.ClosingBalance = balance
+
+	return statement, nil
+}
+
+func (s *statementService) RenderStatement(ctx context.Context, loanID string, from time.Time, to time.Time, format string) ([]byte, string, error) {
+	if format != entities.STATEMENT_FORMAT_CSV && format != entities.STATEMENT_FORMAT_PDF {
+		return nil, "", errorhandler.InvalidField("format", fmt.Sprintf("must be %s or %s", entities.STATEMENT_FORMAT_CSV, entities.STATEMENT_FORMAT_PDF))
+	}
+
+	statement, err := s.GenerateStatement(ctx, loanID, from, to)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if format == entities.STATEMENT_FORMAT_CSV {
+		data, err := s.renderCSV(statement)
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+		return data, "text/csv", nil
+	}
+
+	data, err := s.renderPDF(statement)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+	return data, "application/pdf", nil
+}
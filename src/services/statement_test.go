@@ -0,0 +1,225 @@
+package services_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/verizhang/billing-engine/src/entities"
+	mocks "github.com/verizhang/billing-engine/src/repositories/mocks"
+	"github.com/verizhang/billing-engine/src/services"
+	"github.com/verizhang/billing-engine/src/utils/auth"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"gorm.io/gorm"
+)
+
+func TestStatementService_GenerateStatement(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	firstPaidAt := createdAt.AddDate(0, 0, 3)
+	deferredAt := createdAt.AddDate(0, 0, 10)
+	restructuredAt := createdAt.AddDate(0, 0, 20)
+	secondPaidAt := createdAt.AddDate(0, 0, 25)
+	replacedBy := "schedule2"
+
+	setup := func() (*mocks.LoanRepository, *mocks.PaymentRepository, *mocks.PaymentScheduleRepository, *mocks.PaymentDeferralRepository) {
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		scheduleRepo := new(mocks.PaymentScheduleRepository)
+		deferralRepo := new(mocks.PaymentDeferralRepository)
+
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(&entities.Loan{ID: "loan1", UserID: "user1", CreatedAt: &createdAt}, nil)
+		scheduleRepo.On("GetPaymentSchedulesByLoanID", mock.Anything, "loan1").Return([]*entities.PaymentSchedule{
+			{ID: "schedule1", Version: 1, Principal: 1000, Interest: 100, CreatedAt: &createdAt},
+			{ID: "schedule2", Version: 2, Principal: 500, Interest: 100, CreatedAt: &restructuredAt},
+		}, nil)
+		paymentRepo.On("GetPaymentHistoryByLoanID", mock.Anything, "loan1").Return([]*entities.Payment{
+			{ID: "payment1", Amount: 550, PaidAt: &firstPaidAt},
+			{ID: "payment2", Amount: 560, DeletedAt: &restructuredAt, ReplacedByScheduleID: &replacedBy},
+			{ID: "payment3", Amount: 600, PaidAt: &secondPaidAt},
+		}, nil)
+		deferralRepo.On("GetPaymentDeferralsByLoanID", mock.Anything, "loan1").Return([]*entities.PaymentDeferral{
+			{ID: "deferral1", Installments: 1, Fee: 10, CreatedAt: &deferredAt},
+		}, nil)
+
+		return loanRepo, paymentRepo, scheduleRepo, deferralRepo
+	}
+
+	t.Run("success rebuilds balances across the period", func(t *testing.T) {
+		loanRepo, paymentRepo, scheduleRepo, deferralRepo := setup()
+
+		service := services.NewStatementService(loanRepo, paymentRepo, scheduleRepo, deferralRepo)
+		result, err := service.GenerateStatement(context.Background(), "loan1", createdAt.AddDate(0, 0, 5), createdAt.AddDate(0, 0, 30))
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(550), result.OpeningBalance)
+		assert.Len(t, result.Entries, 3)
+		assert.Equal(t, entities.STATEMENT_ENTRY_FEE, result.Entries[0].Type)
+		assert.Equal(t, float64(560), result.Entries[0].Balance)
+		assert.Equal(t, entities.STATEMENT_ENTRY_CHARGE, result.Entries[1].Type)
+		assert.Equal(t, float64(40), result.Entries[1].Amount)
+		assert.Equal(t, entities.STATEMENT_ENTRY_PAYMENT, result.Entries[2].Type)
+		assert.Equal(t, float64(0), result.ClosingBalance)
+	})
+
+	t.Run("success reverses installments settled by a refinance", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		scheduleRepo := new(mocks.PaymentScheduleRepository)
+		deferralRepo := new(mocks.PaymentDeferralRepository)
+
+		refinancedAt := createdAt.AddDate(0, 0, 8)
+		refinancedByLoanID := "loan2"
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(&entities.Loan{ID: "loan1", RefinancedByLoanID: &refinancedByLoanID}, nil)
+		scheduleRepo.On("GetPaymentSchedulesByLoanID", mock.Anything, "loan1").Return([]*entities.PaymentSchedule{
+			{ID: "schedule1", Version: 1, Principal: 1000, Interest: 100, CreatedAt: &createdAt},
+		}, nil)
+		paymentRepo.On("GetPaymentHistoryByLoanID", mock.Anything, "loan1").Return([]*entities.Payment{
+			{ID: "payment1", Amount: 550, PaidAt: &firstPaidAt},
+			{ID: "payment2", Amount: 550, DeletedAt: &refinancedAt},
+		}, nil)
+		deferralRepo.On("GetPaymentDeferralsByLoanID", mock.Anything, "loan1").Return([]*entities.PaymentDeferral{}, nil)
+
+		service := services.NewStatementService(loanRepo, paymentRepo, scheduleRepo, deferralRepo)
+		result, err := service.GenerateStatement(context.Background(), "loan1", createdAt, createdAt.AddDate(0, 0, 30))
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Entries, 3)
+		assert.Equal(t, entities.STATEMENT_ENTRY_REVERSAL, result.Entries[2].Type)
+		assert.Equal(t, "loan2", result.Entries[2].Reference)
+		assert.Equal(t, float64(0), result.ClosingBalance)
+	})
+
+	t.Run("success nets the installments of the schedule version replacing them", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		scheduleRepo := new(mocks.PaymentScheduleRepository)
+		deferralRepo := new(mocks.PaymentDeferralRepository)
+
+		// the removal time of an installment says nothing of what removed it
+		replacedAt := restructuredAt.Add(time.Millisecond)
+		refinancedByLoanID := "loan2"
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(&entities.Loan{ID: "loan1", RefinancedByLoanID: &refinancedByLoanID}, nil)
+		scheduleRepo.On("GetPaymentSchedulesByLoanID", mock.Anything, "loan1").Return([]*entities.PaymentSchedule{
+			{ID: "schedule1", Version: 1, Principal: 1000, Interest: 100, CreatedAt: &createdAt},
+			{ID: "schedule2", Version: 2, Principal: 500, Interest: 100, CreatedAt: &restructuredAt},
+		}, nil)
+		paymentRepo.On("GetPaymentHistoryByLoanID", mock.Anything, "loan1").Return([]*entities.Payment{
+			{ID: "payment1", Amount: 550, PaidAt: &firstPaidAt},
+			{ID: "payment2", Amount: 560, DeletedAt: &replacedAt, ReplacedByScheduleID: &replacedBy},
+			{ID: "payment3", Amount: 590, DeletedAt: &restructuredAt},
+		}, nil)
+		deferralRepo.On("GetPaymentDeferralsByLoanID", mock.Anything, "loan1").Return([]*entities.PaymentDeferral{}, nil)
+
+		service := services.NewStatementService(loanRepo, paymentRepo, scheduleRepo, deferralRepo)
+		result, err := service.GenerateStatement(context.Background(), "loan1", createdAt, createdAt.AddDate(0, 0, 30))
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Entries, 4)
+		assert.Equal(t, float64(40), result.Entries[2].Amount)
+		assert.Equal(t, entities.STATEMENT_ENTRY_REVERSAL, result.Entries[3].Type)
+		assert.Equal(t, float64(-590), result.Entries[3].Amount)
+		assert.Equal(t, float64(0), result.ClosingBalance)
+	})
+
+	t.Run("success charges late fees to the balance", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		scheduleRepo := new(mocks.PaymentScheduleRepository)
+		deferralRepo := new(mocks.PaymentDeferralRepository)
+
+		lateFeeAt := createdAt.AddDate(0, 0, 18)
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(&entities.Loan{ID: "loan1", Fee: 25}, nil)
+		scheduleRepo.On("GetPaymentSchedulesByLoanID", mock.Anything, "loan1").Return([]*entities.PaymentSchedule{
+			{ID: "schedule1", Version: 1, Principal: 1000, Interest: 100, CreatedAt: &createdAt},
+		}, nil)
+		paymentRepo.On("GetPaymentHistoryByLoanID", mock.Anything, "loan1").Return([]*entities.Payment{
+			{ID: "payment1", Amount: 550, PaidAt: &firstPaidAt},
+			{ID: "payment2", Amount: 575, Fee: 25, LateFee: 25, LateFeeAt: &lateFeeAt, PaidAt: &secondPaidAt},
+		}, nil)
+		deferralRepo.On("GetPaymentDeferralsByLoanID", mock.Anything, "loan1").Return([]*entities.PaymentDeferral{}, nil)
+
+		service := services.NewStatementService(loanRepo, paymentRepo, scheduleRepo, deferralRepo)
+		result, err := service.GenerateStatement(context.Background(), "loan1", createdAt.AddDate(0, 0, 5), createdAt.AddDate(0, 0, 30))
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Entries, 2)
+		assert.Equal(t, entities.STATEMENT_ENTRY_FEE, result.Entries[0].Type)
+		assert.Equal(t, "payment2", result.Entries[0].Reference)
+		assert.Equal(t, float64(25), result.Entries[0].Amount)
+		assert.Equal(t, float64(575), result.Entries[0].Balance)
+		assert.Equal(t, float64(0), result.ClosingBalance)
+	})
+
+	t.Run("error when loan not found", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(nil, gorm.ErrRecordNotFound)
+
+		service := services.NewStatementService(loanRepo, nil, nil, nil)
+		_, err := service.GenerateStatement(context.Background(), "loan1", createdAt, createdAt.AddDate(0, 0, 30))
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.NotFoundError, errors.Unwrap(err))
+	})
+
+	t.Run("error when loan belongs to another borrower", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(&entities.Loan{ID: "loan1", UserID: "user1"}, nil)
+		ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "user2", Role: auth.ROLE_BORROWER})
+
+		service := services.NewStatementService(loanRepo, nil, nil, nil)
+		_, err := service.GenerateStatement(ctx, "loan1", createdAt, createdAt.AddDate(0, 0, 30))
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.NotFoundError, errors.Unwrap(err))
+	})
+
+	t.Run("success for operator on any loan", func(t *testing.T) {
+		loanRepo, paymentRepo, scheduleRepo, deferralRepo := setup()
+		ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "ops1", Role: auth.ROLE_OPERATOR})
+
+		service := services.NewStatementService(loanRepo, paymentRepo, scheduleRepo, deferralRepo)
+		result, err := service.GenerateStatement(ctx, "loan1", createdAt, createdAt.AddDate(0, 0, 30))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "loan1", result.Loan.ID)
+	})
+
+	t.Run("error when from is not before to", func(t *testing.T) {
+		service := services.NewStatementService(nil, nil, nil, nil)
+		_, err := service.GenerateStatement(context.Background(), "loan1", createdAt, createdAt)
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+	})
+
+	t.Run("success render csv and pdf", func(t *testing.T) {
+		loanRepo, paymentRepo, scheduleRepo, deferralRepo := setup()
+		service := services.NewStatementService(loanRepo, paymentRepo, scheduleRepo, deferralRepo)
+		from, to := createdAt, createdAt.AddDate(0, 0, 30)
+
+		data, contentType, err := service.RenderStatement(context.Background(), "loan1", from, to, entities.STATEMENT_FORMAT_CSV)
+		assert.NoError(t, err)
+		assert.Equal(t, "text/csv", contentType)
+		lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+		assert.Len(t, lines, 8)
+		assert.True(t, strings.HasSuffix(lines[7], "Closing balance,,,0.00"))
+
+		data, contentType, err = service.RenderStatement(context.Background(), "loan1", from, to, entities.STATEMENT_FORMAT_PDF)
+		assert.NoError(t, err)
+		assert.Equal(t, "application/pdf", contentType)
+		assert.True(t, bytes.HasPrefix(data, []byte("%PDF")))
+	})
+
+	t.Run("error on unsupported format", func(t *testing.T) {
+		service := services.NewStatementService(nil, nil, nil, nil)
+		_, _, err := service.RenderStatement(context.Background(), "loan1", createdAt, createdAt.AddDate(0, 0, 30), "xlsx")
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+	})
+}
@@ -0,0 +1,182 @@
+package services
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"github.com/go-pdf/fpdf"
+	"github.com/verizhang/billing-engine/src/entities"
+	"sort"
+	"time"
+)
+
+// getStatementEntries rebuilds the ledger of a loan ordered by date. Every schedule version charges its
+// principal and interest, net of the installments it replaced. Installments cancelled outside a restructure,
+// such as on a refinance, are reversed. Deferral fee and interest are charged when the deferral is granted,
+// late fees when they are charged to their installment.
+func (s *statementService) getStatementEntries(loan *entities.Loan, schedules []*entities.PaymentSchedule, payments []*entities.Payment, deferrals []*entities.PaymentDeferral) []*entities.StatementEntry {
+	var entries []*entities.StatementEntry
+	replaced := map[string]bool{}
+
+	for _, schedule := range schedules {
+		entry := &entities.StatementEntry{
+			Date:        schedule.CreatedAt,
+			Type:        entities.STATEMENT_ENTRY_CHARGE,
+			Description: "Loan principal and interest",
+			Reference:   schedule.ID,
+			Amount:      schedule.Principal + schedule.Interest,
+		}
+		if schedule.Version > 1 {
+			entry.Description = fmt.Sprintf("Restructure to schedule version %d", schedule.Version)
+			for _, payment := range payments {
+				if payment.ReplacedByScheduleID != nil && *payment.ReplacedByScheduleID == schedule.ID {
+					entry.Amount = entry.Amount - payment.Amount
+					replaced[payment.ID] = true
+				}
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	cancelled := map[int64]*entities.StatementEntry{}
+	for _, payment := range payments {
+		if payment.LateFeeAt != nil {
+			entries = append(entries, &entities.StatementEntry{
+				Date:        payment.LateFeeAt,
+				Type:        entities.STATEMENT_ENTRY_FEE,
+				Description: "Late fee",
+				Reference:   payment.ID,
+				Amount:      payment.LateFee,
+			})
+		}
+		if payment.PaidAt != nil {
+			entries = append(entries, &entities.StatementEntry{
+				Date:        payment.PaidAt,
+				Type:        entities.STATEMENT_ENTRY_PAYMENT,
+				Description: "Installment payment",
+				Reference:   payment.ID,
+				Amount:      -payment.Amount,
+			})
+			continue
+		}
+		if payment.DeletedAt == nil || replaced[payment.ID] {
+			continue
+		}
+
+		entry, ok := cancelled[payment.DeletedAt.UnixNano()]
+		if !ok {
+			entry = &entities.StatementEntry{
+				Date:        payment.DeletedAt,
+				Type:        entities.STATEMENT_ENTRY_REVERSAL,
+				Description: "Installments cancelled",
+			}
+			if loan.RefinancedByLoanID != nil {
+				entry.Description = "Settled by refinance"
+				entry.Reference = *loan.RefinancedByLoanID
+			}
+			cancelled[payment.DeletedAt.UnixNano()] = entry
+			entries = append(entries, entry)
+		}
+		entry.Amount = entry.Amount - payment.Amount
+	}
+
+	for _, deferral := range deferrals {
+		entries = append(entries, &entities.StatementEntry{
+			Date:        deferral.CreatedAt,
+			Type:        entities.STATEMENT_ENTRY_FEE,
+			Description: fmt.Sprintf("Deferral of %d installments", deferral.Installments),
+			Reference:   deferral.ID,
+			Amount:      deferral.Fee,
+		})
+		if deferral.Interest > 0 {
+			entries = append(entries, &entities.StatementEntry{
+				Date:        deferral.CreatedAt,
+				Type:        entities.STATEMENT_ENTRY_CHARGE,
+				Description: "Deferral interest",
+				Reference:   deferral.ID,
+				Amount:      deferral.Interest,
+			})
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Date.Before(*entries[j].Date)
+	})
+
+	return entries
+}
+
+func (s *statementService) renderCSV(statement *entities.Statement) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	records := [][]string{
+		{"date", "type", "description", "reference", "amount", "balance"},
+		{statement.From.Format(time.RFC3339), "", "Opening balance", "", "", formatAmount(statement.OpeningBalance)},
+	}
+	for _, entry := range statement.Entries {
+		records = append(records, []string{
+			entry.Date.Format(time.RFC3339),
+			entry.Type,
+			entry.Description,
+			entry.Reference,
+			formatAmount(entry.Amount),
+			formatAmount(entry.Balance),
+		})
+	}
+	records = append(records, []string{statement.To.Format(time.RFC3339), "", "Closing balance", "", "", formatAmount(statement.ClosingBalance)})
+
+	err := writer.WriteAll(records)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (s *statementService) renderPDF(statement *entities.Statement) ([]byte, error) {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetTitle(fmt.Sprintf("Statement %s", statement.Loan.ID), false)
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 14)
+	pdf.CellFormat(0, 10, "Loan statement", "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(0, 6, fmt.Sprintf("Loan: %s", statement.Loan.ID), "", 1, "L", false, 0, "")
+	pdf.CellFormat(0, 6, fmt.Sprintf("Period: %s to %s", statement.From.Format(time.DateOnly), statement.To.Format(time.DateOnly)), "", 1, "L", false, 0, "")
+	pdf.CellFormat(0, 6, fmt.Sprintf("Opening balance: %s", formatAmount(statement.OpeningBalance)), "", 1, "L", false, 0, "")
+	pdf.Ln(4)
+
+	widths := []float64{25, 20, 75, 30, 30}
+	pdf.SetFont("Helvetica", "B", 9)
+	for i, header := range []string{"Date", "Type", "Description", "Amount", "Balance"} {
+		pdf.CellFormat(widths[i], 7, header, "1", 0, "L", false, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont("Helvetica", "", 9)
+	for _, entry := range statement.Entries {
+		pdf.CellFormat(widths[0], 6, entry.Date.Format(time.DateOnly), "1", 0, "L", false, 0, "")
+		pdf.CellFormat(widths[1], 6, entry.Type, "1", 0, "L", false, 0, "")
+		pdf.CellFormat(widths[2], 6, entry.Description, "1", 0, "L", false, 0, "")
+		pdf.CellFormat(widths[3], 6, formatAmount(entry.Amount), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(widths[4], 6, formatAmount(entry.Balance), "1", 0, "R", false, 0, "")
+		pdf.Ln(-1)
+	}
+
+	pdf.Ln(4)
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(0, 6, fmt.Sprintf("Closing balance: %s", formatAmount(statement.ClosingBalance)), "", 1, "L", false, 0, "")
+
+	var buf bytes.Buffer
+	err := pdf.Output(&buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func formatAmount(amount float64) string {
+	return fmt.Sprintf("%.2f", amount)
+}
@@ -0,0 +1,289 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/verizhang/billing-engine/config"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/repositories"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"github.com/verizhang/billing-engine/src/utils/pagination"
+	"gorm.io/gorm"
+	"net/http"
+	"time"
+)
+
+type WebhookService interface {
+	CreateWebhookSubscription(ctx context.Context, url string, eventTypes []string, secret string) (*entities.WebhookSubscription, error)
+	ListWebhookSubscriptions(ctx context.Context) ([]*entities.WebhookSubscription, error)
+	UpdateWebhookSubscription(ctx context.Context, ID string, update *entities.WebhookSubscriptionUpdate) (*entities.WebhookSubscription, error)
+	DeleteWebhookSubscription(ctx context.Context, ID string) error
+	ListWebhookDeliveries(ctx context.Context, filter *entities.WebhookDeliveryFilter, pageSize int, pageToken string) (*entities.WebhookDeliveryPage, error)
+	RedeliverWebhook(ctx context.Context, deliveryID string) (*entities.WebhookDelivery, error)
+	DeliverWebhooks(ctx context.Context) (int, error)
+	// Publish fans an outbox event out to a delivery per subscription, it is the publisher of the relay for the subscriptions
+	Publish(ctx context.Context, event *entities.OutboxEvent) error
+}
+
+type webhookService struct {
+	cfg              config.Config
+	uow              repositories.UnitOfWork
+	subscriptionRepo repositories.WebhookSubscriptionRepository
+	deliveryRepo     repositories.WebhookDeliveryRepository
+	outboxRepo       repositories.OutboxEventRepository
+	client           *http.Client
+}
+
+func NewWebhookService(cfg config.Config, uow repositories.UnitOfWork, subscriptionRepo repositories.WebhookSubscriptionRepository, deliveryRepo repositories.WebhookDeliveryRepository, outboxRepo repositories.OutboxEventRepository, client *http.Client) WebhookService {
+	return &webhookService{
+		cfg:              cfg,
+		uow:              uow,
+		subscriptionRepo: subscriptionRepo,
+		deliveryRepo:     deliveryRepo,
+		outboxRepo:       outboxRepo,
+		client:           client,
+	}
+}
+
+func (s *webhookService) CreateWebhookSubscription(ctx context.Context, url string, eventTypes []string, secret string) (*entities.WebhookSubscription, error) {
+	err := s.validateSubscription(&url, eventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	if secret == "" {
+		secret, err = generateWebhookSecret()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+	}
+
+	now := time.Now()
+	subscription := &entities.WebhookSubscription{
+		ID:         uuid.NewString(),
+		URL:        url,
+		EventTypes: eventTypes,
+		Secret:     secret,
+		IsActive:   true,
+		CreatedAt:  &now,
+		UpdatedAt:  &now,
+	}
+
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.uow.WebhookSubscriptionRepository(tx).CreateWebhookSubscription(ctx, subscription)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.recordSubscriptionEvent(ctx, tx, entities.AUDIT_ACTION_WEBHOOK_SUBSCRIPTION_CREATED, subscription.ID, nil, subscription)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.uow.Commit(tx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return subscription, nil
+}
+
+func (s *webhookService) ListWebhookSubscriptions(ctx context.Context) ([]*entities.WebhookSubscription, error) {
+	subscriptions, err := s.subscriptionRepo.GetWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return subscriptions, nil
+}
+
+func (s *webhookService) UpdateWebhookSubscription(ctx context.Context, ID string, update *entities.WebhookSubscriptionUpdate) (*entities.WebhookSubscription, error) {
+	before, err := s.getSubscription(ctx, ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.validateSubscription(update.URL, update.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	subscriptionRepo := s.uow.WebhookSubscriptionRepository(tx)
+	err = subscriptionRepo.UpdateWebhookSubscriptionByID(ctx, ID, update)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	subscription, err := subscriptionRepo.GetWebhookSubscriptionByID(ctx, ID)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.recordSubscriptionEvent(ctx, tx, entities.AUDIT_ACTION_WEBHOOK_SUBSCRIPTION_UPDATED, ID, before, subscription)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.uow.Commit(tx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return subscription, nil
+}
+
+func (s *webhookService) DeleteWebhookSubscription(ctx context.Context, ID string) error {
+	before, err := s.getSubscription(ctx, ID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.uow.WebhookSubscriptionRepository(tx).DeleteWebhookSubscriptionByID(ctx, ID, time.Now())
+	if err != nil {
+		s.uow.Rollback(tx)
+		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.recordSubscriptionEvent(ctx, tx, entities.AUDIT_ACTION_WEBHOOK_SUBSCRIPTION_DELETED, ID, before, nil)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.uow.Commit(tx)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return nil
+}
+
+func (s *webhookService) ListWebhookDeliveries(ctx context.Context, filter *entities.WebhookDeliveryFilter, pageSize int, pageToken string) (*entities.WebhookDeliveryPage, error) {
+	_, err := s.getSubscription(ctx, filter.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := pagination.DecodeCursor(pageToken)
+	if err != nil {
+		return nil, errorhandler.InvalidField("pageToken", err.Error())
+	}
+
+	pageSize = pagination.PageSize(pageSize)
+	deliveries, err := s.deliveryRepo.GetWebhookDeliveries(ctx, filter, cursor, pageSize+1)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	page := &entities.WebhookDeliveryPage{Deliveries: deliveries}
+	if len(deliveries) > pageSize {
+		page.Deliveries = deliveries[:pageSize]
+		last := page.Deliveries[pageSize-1]
+		page.NextPageToken = pagination.EncodeCursor(last.CreatedAt, last.ID)
+	}
+
+	return page, nil
+}
+
+// RedeliverWebhook queues the delivery again with a fresh set of attempts, it takes dead deliveries out of the dead-letter queue
+// and resends delivered ones a receiver lost
+func (s *webhookService) RedeliverWebhook(ctx context.Context, deliveryID string) (*entities.WebhookDelivery, error) {
+	delivery, err := s.deliveryRepo.GetWebhookDeliveryByID(ctx, deliveryID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errorhandler.NotFound(errorhandler.REASON_WEBHOOK_DELIVERY_NOT_FOUND, "webhook delivery not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	now := time.Now()
+	delivery.Status = entities.WEBHOOK_DELIVERY_STATUS_PENDING
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = now
+	delivery.UpdatedAt = now
+
+	err = s.deliveryRepo.UpdateWebhookDelivery(ctx, delivery)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return delivery, nil
+}
+
+// DeliverWebhooks sends the due deliveries and returns how many were delivered. The deliveries are leased in a short transaction
+// and sent outside of it, a failed attempt is retried with an exponential backoff and after WEBHOOK_MAX_ATTEMPTS attempts
+// the delivery is dead until it is redelivered
+func (s *webhookService) DeliverWebhooks(ctx context.Context) (int, error) {
+	deliveries, err := s.claimDueDeliveries(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	delivered := 0
+	for _, delivery := range deliveries {
+		s.attemptDelivery(ctx, delivery)
+
+		err = s.deliveryRepo.UpdateWebhookDelivery(ctx, delivery)
+		if err != nil {
+			return delivered, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+		}
+		if delivery.Status == entities.WEBHOOK_DELIVERY_STATUS_DELIVERED {
+			delivered++
+		}
+	}
+
+	return delivered, nil
+}
+
+func (s *webhookService) Publish(ctx context.Context, event *entities.OutboxEvent) error {
+	subscriptions, err := s.subscriptionRepo.GetActiveWebhookSubscriptions(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var deliveries []*entities.WebhookDelivery
+	for _, subscription := range subscriptions {
+		if !subscription.Subscribes(event.Type) {
+			continue
+		}
+
+		deliveries = append(deliveries, &entities.WebhookDelivery{
+			ID:             uuid.NewString(),
+			SubscriptionID: subscription.ID,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			Status:         entities.WEBHOOK_DELIVERY_STATUS_PENDING,
+			NextAttemptAt:  now,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		})
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	return s.deliveryRepo.CreateWebhookDeliveries(ctx, deliveries)
+}
@@ -0,0 +1,268 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/verizhang/billing-engine/config"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/publishers"
+	mocks "github.com/verizhang/billing-engine/src/repositories/mocks"
+	"github.com/verizhang/billing-engine/src/services"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"github.com/verizhang/billing-engine/src/utils/signature"
+	"gorm.io/gorm"
+)
+
+var webhookConfig = config.Config{
+	WebhookMaxAttempts: 3,
+	WebhookBackoffBase: time.Minute,
+	WebhookBackoffMax:  time.Hour,
+	WebhookBatchSize:   10,
+}
+
+func TestWebhookService_CreateWebhookSubscription(t *testing.T) {
+	t.Run("success generates a secret when none is given", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		subscriptionRepo := new(mocks.WebhookSubscriptionRepository)
+		mockTx := &gorm.DB{}
+
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		uow.On("WebhookSubscriptionRepository", mockTx).Return(subscriptionRepo)
+		subscriptionRepo.On("CreateWebhookSubscription", mock.Anything, mock.AnythingOfType("*entities.WebhookSubscription")).Return(nil)
+		auditRepo := expectAuditEvents(uow, mockTx)
+
+		service := services.NewWebhookService(webhookConfig, uow, nil, nil, nil, nil)
+		result, err := service.CreateWebhookSubscription(context.Background(), "https://partner.example/hooks", []string{entities.EVENT_PAYMENT_MADE}, "")
+
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(result.Secret, "whsec_"))
+		assert.True(t, result.IsActive)
+		auditRepo.AssertCalled(t, "GetLastAuditEventByEntity", mock.Anything, entities.AUDIT_ENTITY_WEBHOOK_SUBSCRIPTION, result.ID)
+		auditRepo.AssertCalled(t, "CreateAuditEvent", mock.Anything, mock.MatchedBy(func(event *entities.AuditEvent) bool {
+			return event.LoanID == nil && event.Action == entities.AUDIT_ACTION_WEBHOOK_SUBSCRIPTION_CREATED &&
+				event.Before == nil && event.After != nil && !strings.Contains(*event.After, result.Secret)
+		}))
+	})
+
+	t.Run("error when an event type is unknown", func(t *testing.T) {
+		service := services.NewWebhookService(webhookConfig, nil, nil, nil, nil, nil)
+		_, err := service.CreateWebhookSubscription(context.Background(), "https://partner.example/hooks", []string{"LoanExploded"}, "")
+
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+	})
+
+	t.Run("error when the url is not http", func(t *testing.T) {
+		service := services.NewWebhookService(webhookConfig, nil, nil, nil, nil, nil)
+		_, err := service.CreateWebhookSubscription(context.Background(), "ftp://partner.example", []string{entities.EVENT_PAYMENT_MADE}, "")
+
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+	})
+}
+
+func TestWebhookService_UpdateWebhookSubscription(t *testing.T) {
+	t.Run("success records the subscription before and after", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		subscriptionRepo := new(mocks.WebhookSubscriptionRepository)
+		txSubscriptionRepo := new(mocks.WebhookSubscriptionRepository)
+		mockTx := &gorm.DB{}
+		url := "https://partner.example/v2"
+		update := &entities.WebhookSubscriptionUpdate{URL: &url}
+
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		uow.On("WebhookSubscriptionRepository", mockTx).Return(txSubscriptionRepo)
+		subscriptionRepo.On("GetWebhookSubscriptionByID", mock.Anything, "subscription1").Return(&entities.WebhookSubscription{
+			ID: "subscription1", URL: "https://partner.example/v1", Secret: "secret", IsActive: true,
+		}, nil)
+		txSubscriptionRepo.On("UpdateWebhookSubscriptionByID", mock.Anything, "subscription1", update).Return(nil)
+		txSubscriptionRepo.On("GetWebhookSubscriptionByID", mock.Anything, "subscription1").Return(&entities.WebhookSubscription{
+			ID: "subscription1", URL: url, Secret: "secret", IsActive: true,
+		}, nil)
+		auditRepo := expectAuditEvents(uow, mockTx)
+
+		service := services.NewWebhookService(webhookConfig, uow, subscriptionRepo, nil, nil, nil)
+		result, err := service.UpdateWebhookSubscription(context.Background(), "subscription1", update)
+
+		assert.NoError(t, err)
+		assert.Equal(t, url, result.URL)
+		auditRepo.AssertCalled(t, "CreateAuditEvent", mock.Anything, mock.MatchedBy(func(event *entities.AuditEvent) bool {
+			return event.Action == entities.AUDIT_ACTION_WEBHOOK_SUBSCRIPTION_UPDATED && event.EntityID == "subscription1" &&
+				strings.Contains(*event.Before, "v1") && strings.Contains(*event.After, "v2") && !strings.Contains(*event.After, "secret")
+		}))
+	})
+}
+
+func TestWebhookService_DeleteWebhookSubscription(t *testing.T) {
+	t.Run("success records the deleted subscription", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		subscriptionRepo := new(mocks.WebhookSubscriptionRepository)
+		mockTx := &gorm.DB{}
+
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		uow.On("WebhookSubscriptionRepository", mockTx).Return(subscriptionRepo)
+		subscriptionRepo.On("GetWebhookSubscriptionByID", mock.Anything, "subscription1").Return(&entities.WebhookSubscription{ID: "subscription1", IsActive: true}, nil)
+		subscriptionRepo.On("DeleteWebhookSubscriptionByID", mock.Anything, "subscription1", mock.AnythingOfType("time.Time")).Return(nil)
+		auditRepo := expectAuditEvents(uow, mockTx)
+
+		service := services.NewWebhookService(webhookConfig, uow, subscriptionRepo, nil, nil, nil)
+		err := service.DeleteWebhookSubscription(context.Background(), "subscription1")
+
+		assert.NoError(t, err)
+		auditRepo.AssertCalled(t, "CreateAuditEvent", mock.Anything, mock.MatchedBy(func(event *entities.AuditEvent) bool {
+			return event.Action == entities.AUDIT_ACTION_WEBHOOK_SUBSCRIPTION_DELETED && event.Before != nil && event.After == nil
+		}))
+	})
+
+	t.Run("error when the subscription does not exist", func(t *testing.T) {
+		subscriptionRepo := new(mocks.WebhookSubscriptionRepository)
+		subscriptionRepo.On("GetWebhookSubscriptionByID", mock.Anything, "subscription1").Return(nil, gorm.ErrRecordNotFound)
+
+		service := services.NewWebhookService(webhookConfig, nil, subscriptionRepo, nil, nil, nil)
+		err := service.DeleteWebhookSubscription(context.Background(), "subscription1")
+
+		assert.Equal(t, errorhandler.NotFoundError, errors.Unwrap(err))
+	})
+}
+
+func TestWebhookService_Publish(t *testing.T) {
+	t.Run("success creates a delivery per subscribed subscription", func(t *testing.T) {
+		subscriptionRepo := new(mocks.WebhookSubscriptionRepository)
+		deliveryRepo := new(mocks.WebhookDeliveryRepository)
+		subscriptionRepo.On("GetActiveWebhookSubscriptions", mock.Anything).Return([]*entities.WebhookSubscription{
+			{ID: "subscription1", EventTypes: []string{entities.EVENT_PAYMENT_MADE}},
+			{ID: "subscription2", EventTypes: []string{entities.EVENT_LOAN_BECAME_DELINQUENT}},
+		}, nil)
+		deliveryRepo.On("CreateWebhookDeliveries", mock.Anything, mock.MatchedBy(func(deliveries []*entities.WebhookDelivery) bool {
+			return len(deliveries) == 1 && deliveries[0].SubscriptionID == "subscription1" && deliveries[0].EventID == "event1" &&
+				deliveries[0].Status == entities.WEBHOOK_DELIVERY_STATUS_PENDING
+		})).Return(nil)
+
+		service := services.NewWebhookService(webhookConfig, nil, subscriptionRepo, deliveryRepo, nil, nil)
+		err := service.Publish(context.Background(), &entities.OutboxEvent{ID: "event1", Type: entities.EVENT_PAYMENT_MADE})
+
+		assert.NoError(t, err)
+		deliveryRepo.AssertExpectations(t)
+	})
+}
+
+func TestWebhookService_DeliverWebhooks(t *testing.T) {
+	setup := func(url string, delivery *entities.WebhookDelivery) (*mocks.UnitOfWork, *mocks.WebhookDeliveryRepository, services.WebhookService) {
+		uow := new(mocks.UnitOfWork)
+		subscriptionRepo := new(mocks.WebhookSubscriptionRepository)
+		deliveryRepo := new(mocks.WebhookDeliveryRepository)
+		outboxRepo := new(mocks.OutboxEventRepository)
+		mockTx := &gorm.DB{}
+
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		uow.On("WebhookDeliveryRepository", mockTx).Return(deliveryRepo)
+		deliveryRepo.On("TryLockDispatcher", mock.Anything).Return(true, nil)
+		deliveryRepo.On("ClaimDueWebhookDeliveries", mock.Anything, mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time"), 10).Return([]*entities.WebhookDelivery{delivery}, nil)
+		deliveryRepo.On("UpdateWebhookDelivery", mock.Anything, delivery).Return(nil)
+		subscriptionRepo.On("GetWebhookSubscriptionByID", mock.Anything, "subscription1").Return(&entities.WebhookSubscription{
+			ID: "subscription1", URL: url, Secret: "secret", IsActive: true,
+		}, nil)
+		outboxRepo.On("GetOutboxEventByID", mock.Anything, "event1").Return(&entities.OutboxEvent{
+			ID: "event1", LoanID: "loan1", Sequence: 1, Type: entities.EVENT_PAYMENT_MADE, Payload: `{"loanId":"loan1"}`,
+		}, nil)
+
+		service := services.NewWebhookService(webhookConfig, uow, subscriptionRepo, deliveryRepo, outboxRepo, http.DefaultClient)
+		return uow, deliveryRepo, service
+	}
+
+	t.Run("success delivers a signed event", func(t *testing.T) {
+		var verified error
+		var deliveryID string
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			verified = signature.Verify("secret", r.Header.Get(signature.HEADER), body, time.Minute, time.Now())
+			deliveryID = r.Header.Get(publishers.HEADER_DELIVERY_ID)
+		}))
+		defer receiver.Close()
+
+		delivery := &entities.WebhookDelivery{ID: "delivery1", SubscriptionID: "subscription1", EventID: "event1", Status: entities.WEBHOOK_DELIVERY_STATUS_PENDING}
+		_, _, service := setup(receiver.URL, delivery)
+		result, err := service.DeliverWebhooks(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result)
+		assert.NoError(t, verified)
+		assert.Equal(t, "delivery1", deliveryID)
+		assert.Equal(t, entities.WEBHOOK_DELIVERY_STATUS_DELIVERED, delivery.Status)
+		assert.Equal(t, 1, delivery.Attempts)
+		assert.Equal(t, http.StatusOK, *delivery.LastStatusCode)
+		assert.NotNil(t, delivery.DeliveredAt)
+	})
+
+	t.Run("failed attempt is retried with a growing backoff", func(t *testing.T) {
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer receiver.Close()
+
+		delivery := &entities.WebhookDelivery{ID: "delivery1", SubscriptionID: "subscription1", EventID: "event1", Status: entities.WEBHOOK_DELIVERY_STATUS_PENDING, Attempts: 1}
+		_, _, service := setup(receiver.URL, delivery)
+		result, err := service.DeliverWebhooks(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, result)
+		assert.Equal(t, entities.WEBHOOK_DELIVERY_STATUS_PENDING, delivery.Status)
+		assert.Equal(t, 2, delivery.Attempts)
+		assert.Equal(t, http.StatusInternalServerError, *delivery.LastStatusCode)
+		assert.WithinDuration(t, time.Now().Add(2*time.Minute), delivery.NextAttemptAt, 5*time.Second)
+	})
+
+	t.Run("delivery is dead after the last attempt", func(t *testing.T) {
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer receiver.Close()
+
+		delivery := &entities.WebhookDelivery{ID: "delivery1", SubscriptionID: "subscription1", EventID: "event1", Status: entities.WEBHOOK_DELIVERY_STATUS_PENDING, Attempts: 2}
+		_, _, service := setup(receiver.URL, delivery)
+		_, err := service.DeliverWebhooks(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.WEBHOOK_DELIVERY_STATUS_DEAD, delivery.Status)
+		assert.Equal(t, 3, delivery.Attempts)
+		assert.Contains(t, *delivery.LastError, "502")
+	})
+}
+
+func TestWebhookService_RedeliverWebhook(t *testing.T) {
+	t.Run("success queues a dead delivery again", func(t *testing.T) {
+		deliveryRepo := new(mocks.WebhookDeliveryRepository)
+		delivery := &entities.WebhookDelivery{ID: "delivery1", Status: entities.WEBHOOK_DELIVERY_STATUS_DEAD, Attempts: 3}
+		deliveryRepo.On("GetWebhookDeliveryByID", mock.Anything, "delivery1").Return(delivery, nil)
+		deliveryRepo.On("UpdateWebhookDelivery", mock.Anything, delivery).Return(nil)
+
+		service := services.NewWebhookService(webhookConfig, nil, nil, deliveryRepo, nil, nil)
+		result, err := service.RedeliverWebhook(context.Background(), "delivery1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, entities.WEBHOOK_DELIVERY_STATUS_PENDING, result.Status)
+		assert.Equal(t, 0, result.Attempts)
+		assert.WithinDuration(t, time.Now(), result.NextAttemptAt, 5*time.Second)
+	})
+
+	t.Run("error when delivery not found", func(t *testing.T) {
+		deliveryRepo := new(mocks.WebhookDeliveryRepository)
+		deliveryRepo.On("GetWebhookDeliveryByID", mock.Anything, "delivery1").Return(nil, gorm.ErrRecordNotFound)
+
+		service := services.NewWebhookService(webhookConfig, nil, nil, deliveryRepo, nil, nil)
+		_, err := service.RedeliverWebhook(context.Background(), "delivery1")
+
+		assert.Equal(t, errorhandler.NotFoundError, errors.Unwrap(err))
+	})
+}
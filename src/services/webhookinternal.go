@@ -0,0 +1,161 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/publishers"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"gorm.io/gorm"
+	"net/url"
+	"time"
+)
+
+func (s *webhookService) getSubscription(ctx context.Context, ID string) (*entities.WebhookSubscription, error) {
+	subscription, err := s.subscriptionRepo.GetWebhookSubscriptionByID(ctx, ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errorhandler.NotFound(errorhandler.REASON_WEBHOOK_SUBSCRIPTION_NOT_FOUND, "webhook subscription not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return subscription, nil
+}
+
+// validateSubscription checks the url and the event types that are set
+func (s *webhookService) validateSubscription(rawURL *string, eventTypes []string) error {
+	if rawURL != nil {
+		parsed, err := url.Parse(*rawURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return errorhandler.InvalidField("url", "must be an absolute http or https url")
+		}
+	}
+
+	for _, eventType := range eventTypes {
+		if !entities.IsValidEventType(eventType) {
+			return errorhandler.InvalidField("eventTypes", fmt.Sprintf("unknown event type %s", eventType))
+		}
+	}
+
+	return nil
+}
+
+// recordSubscriptionEvent appends a change of the subscription to its audit chain, the secret never reaches the trail
+func (s *webhookService) recordSubscriptionEvent(ctx context.Context, tx *gorm.DB, action string, ID string, before any, after any) error {
+	return recordAuditEvent(ctx, s.uow, tx, &entities.AuditEvent{
+		Action:     action,
+		EntityType: entities.AUDIT_ENTITY_WEBHOOK_SUBSCRIPTION,
+		EntityID:   ID,
+	}, before, after)
+}
+
+// claimDueDeliveries leases the due deliveries under the dispatcher lock and commits at once, nothing is claimed while another dispatcher claims
+func (s *webhookService) claimDueDeliveries(ctx context.Context) ([]*entities.WebhookDelivery, error) {
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	deliveryRepo := s.uow.WebhookDeliveryRepository(tx)
+
+	locked, err := deliveryRepo.TryLockDispatcher(ctx)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+	if !locked {
+		s.uow.Rollback(tx)
+		return nil, nil
+	}
+
+	now := time.Now()
+	deliveries, err := deliveryRepo.ClaimDueWebhookDeliveries(ctx, now, now.Add(s.cfg.WebhookLease), s.cfg.WebhookBatchSize)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.uow.Commit(tx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return deliveries, nil
+}
+
+// attemptDelivery posts the event of the delivery to its subscription and records the outcome on the delivery.
+// The deliveries of a deleted subscription die at once, those of a paused subscription wait without using an attempt
+func (s *webhookService) attemptDelivery(ctx context.Context, delivery *entities.WebhookDelivery) {
+	now := time.Now()
+	delivery.UpdatedAt = now
+
+	subscription, err := s.subscriptionRepo.GetWebhookSubscriptionByID(ctx, delivery.SubscriptionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		lastError := "webhook subscription was deleted"
+		delivery.Status = entities.WEBHOOK_DELIVERY_STATUS_DEAD
+		delivery.LastError = &lastError
+		return
+	}
+	if err == nil && !subscription.IsActive {
+		delivery.NextAttemptAt = now.Add(s.cfg.WebhookBackoffBase)
+		return
+	}
+
+	delivery.Attempts++
+	statusCode := 0
+	if err == nil {
+		statusCode, err = s.postDelivery(ctx, subscription, delivery)
+	}
+	if statusCode != 0 {
+		delivery.LastStatusCode = &statusCode
+	}
+	if err == nil {
+		delivery.Status = entities.WEBHOOK_DELIVERY_STATUS_DELIVERED
+		delivery.DeliveredAt = &now
+		delivery.LastError = nil
+		return
+	}
+
+	lastError := err.Error()
+	delivery.LastError = &lastError
+	if delivery.Attempts >= s.cfg.WebhookMaxAttempts {
+		delivery.Status = entities.WEBHOOK_DELIVERY_STATUS_DEAD
+		return
+	}
+	delivery.NextAttemptAt = now.Add(retryBackoff(s.cfg.WebhookBackoffBase, s.cfg.WebhookBackoffMax, delivery.Attempts))
+}
+
+func (s *webhookService) postDelivery(ctx context.Context, subscription *entities.WebhookSubscription, delivery *entities.WebhookDelivery) (int, error) {
+	event, err := s.outboxRepo.GetOutboxEventByID(ctx, delivery.EventID)
+	if err != nil {
+		return 0, fmt.Errorf("load event: %w", err)
+	}
+
+	return publishers.PostEvent(ctx, s.client, subscription.URL, subscription.Secret, delivery.ID, event)
+}
+
+// retryBackoff doubles the delay after every failed attempt, starting at base and capped at max
+func retryBackoff(base time.Duration, max time.Duration, attempts int) time.Duration {
+	delay := base
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= max {
+			return max
+		}
+	}
+
+	return min(delay, max)
+}
+
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return "whsec_" + hex.EncodeToString(secret), nil
+}
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/verizhang/billing-engine/config"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/repositories"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"gorm.io/gorm"
+	"time"
+)
+
+type WriteOffService interface {
+	WriteOffLoan(ctx context.Context, loanID string) (*entities.LoanWriteOff, error)
+	ApplyWriteOffPolicy(ctx context.Context) ([]*entities.LoanWriteOff, error)
+	GetRecoveriesReport(ctx context.Context, from time.Time, to time.Time) (*entities.RecoveriesReport, error)
+}
+
+type writeOffService struct {
+	cfg          config.Config
+	uow          repositories.UnitOfWork
+	loanRepo     repositories.LoanRepository
+	paymentRepo  repositories.PaymentRepository
+	writeOffRepo repositories.LoanWriteOffRepository
+	recoveryRepo repositories.LoanRecoveryRepository
+}
+
+func NewWriteOffService(cfg config.Config, uow repositories.UnitOfWork, loanRepo repositories.LoanRepository, paymentRepo repositories.PaymentRepository, writeOffRepo repositories.LoanWriteOffRepository, recoveryRepo repositories.LoanRecoveryRepository) WriteOffService {
+	return &writeOffService{
+		cfg:          cfg,
+		uow:          uow,
+		loanRepo:     loanRepo,
+		paymentRepo:  paymentRepo,
+		writeOffRepo: writeOffRepo,
+		recoveryRepo: recoveryRepo,
+	}
+}
+
+func (s *writeOffService) WriteOffLoan(ctx context.Context, loanID string) (*entities.LoanWriteOff, error) {
+	loan, err := s.loanRepo.GetLoanByID(ctx, loanID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errorhandler.NotFound(errorhandler.REASON_LOAN_NOT_FOUND, "loan not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	if loan.Status != entities.LOAN_STATUS_ACTIVE {
+		return nil, errorhandler.BadRequest(errorhandler.REASON_LOAN_NOT_ACTIVE, "only an active loan can be written off")
+	}
+
+	return s.writeOff(ctx, loan, entities.WRITE_OFF_REASON_MANUAL)
+}
+
+func (s *writeOffService) ApplyWriteOffPolicy(ctx context.Context) ([]*entities.LoanWriteOff, error) {
+	dueBefore := time.Now().AddDate(0, 0, -s.cfg.WriteOffDaysPastDue)
+	loans, err := s.loanRepo.GetPastDueLoans(ctx, dueBefore)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	var writeOffs []*entities.LoanWriteOff
+	for _, loan := range loans {
+		writeOff, err := s.writeOff(ctx, loan, entities.WRITE_OFF_REASON_POLICY)
+		if err != nil {
+			return writeOffs, err
+		}
+		writeOffs = append(writeOffs, writeOff)
+	}
+
+	return writeOffs, nil
+}
+
+func (s *writeOffService) GetRecoveriesReport(ctx context.Context, from time.Time, to time.Time) (*entities.RecoveriesReport, error) {
+	if !from.Before(to) {
+		return nil, errorhandler.InvalidField("from", "must be before to")
+	}
+
+	writeOffs, err := s.writeOffRepo.GetLoanWriteOffsByDate(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	recoveries, err := s.recoveryRepo.GetLoanRecoveriesByDate(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	report := &entities.RecoveriesReport{
+		WriteOffs:  writeOffs,
+		Recoveries: recoveries,
+	}
+	for _, writeOff := range writeOffs {
+		report.WrittenOffPrincipal = report.WrittenOffPrincipal + writeOff.Principal
+		report.WrittenOffInterest = report.WrittenOffInterest + writeOff.Interest
+		report.WrittenOffFee = report.WrittenOffFee + writeOff.Fee
+	}
+	for _, recovery := range recoveries {
+		report.Recovered = report.Recovered + recovery.Amount
+	}
+
+	return report, nil
+}
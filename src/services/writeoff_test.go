@@ -0,0 +1,168 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/verizhang/billing-engine/config"
+	"github.com/verizhang/billing-engine/src/entities"
+	mocks "github.com/verizhang/billing-engine/src/repositories/mocks"
+	"github.com/verizhang/billing-engine/src/services"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"gorm.io/gorm"
+)
+
+func TestWriteOffService_WriteOffLoan(t *testing.T) {
+	createService := func(uow *mocks.UnitOfWork, loanRepo *mocks.LoanRepository, paymentRepo *mocks.PaymentRepository) services.WriteOffService {
+		return services.NewWriteOffService(config.Config{WriteOffDaysPastDue: 180}, uow, loanRepo, paymentRepo, nil, nil)
+	}
+
+	t.Run("success write off unpaid installments", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		writeOffRepo := new(mocks.LoanWriteOffRepository)
+		mockTx := &gorm.DB{}
+
+		now := time.Now()
+		paidEndAt := now.AddDate(0, 0, -207)
+		overdueEndAt := now.AddDate(0, 0, -200)
+		nextEndAt := now.AddDate(0, 0, -193)
+		loan := &entities.Loan{ID: "loan1", Status: entities.LOAN_STATUS_ACTIVE}
+		payments := []*entities.Payment{
+			{ID: "payment1", Principal: 100, Interest: 10, EndAt: &paidEndAt, PaidAt: &paidEndAt},
+			{ID: "payment2", Principal: 100, Interest: 10, Fee: 5, EndAt: &overdueEndAt},
+			{ID: "payment3", Principal: 100, Interest: 10, EndAt: &nextEndAt},
+		}
+
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(loan, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return(payments, nil)
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		expectAuditEvents(uow, mockTx)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+		uow.On("LoanWriteOffRepository", mockTx).Return(writeOffRepo)
+		loanRepo.On("UpdateStatusLoanByID", mock.Anything, "loan1", entities.LOAN_STATUS_WRITTEN_OFF).Return(nil)
+		writeOffRepo.On("CreateLoanWriteOff", mock.Anything, mock.AnythingOfType("*entities.LoanWriteOff")).Return(nil)
+
+		service := createService(uow, loanRepo, paymentRepo)
+		result, err := service.WriteOffLoan(context.Background(), "loan1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(200), result.Principal)
+		assert.Equal(t, float64(20), result.Interest)
+		assert.Equal(t, float64(5), result.Fee)
+		assert.Equal(t, 200, result.DaysPastDue)
+		assert.Equal(t, entities.WRITE_OFF_REASON_MANUAL, result.Reason)
+		uow.AssertExpectations(t)
+		loanRepo.AssertExpectations(t)
+		writeOffRepo.AssertExpectations(t)
+	})
+
+	t.Run("error when loan is not active", func(t *testing.T) {
+		loanRepo := new(mocks.LoanRepository)
+		loan := &entities.Loan{ID: "loan1", Status: entities.LOAN_STATUS_PAID_OFF}
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(loan, nil)
+
+		service := createService(nil, loanRepo, nil)
+		_, err := service.WriteOffLoan(context.Background(), "loan1")
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+	})
+
+	t.Run("error when write off fails - should rollback", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		writeOffRepo := new(mocks.LoanWriteOffRepository)
+		mockTx := &gorm.DB{}
+
+		loan := &entities.Loan{ID: "loan1", Status: entities.LOAN_STATUS_ACTIVE}
+		loanRepo.On("GetLoanByID", mock.Anything, "loan1").Return(loan, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return([]*entities.Payment{}, nil)
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Rollback", mockTx).Return(nil)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+		uow.On("LoanWriteOffRepository", mockTx).Return(writeOffRepo)
+		loanRepo.On("UpdateStatusLoanByID", mock.Anything, "loan1", entities.LOAN_STATUS_WRITTEN_OFF).Return(nil)
+		writeOffRepo.On("CreateLoanWriteOff", mock.Anything, mock.Anything).Return(errors.New("create error"))
+
+		service := createService(uow, loanRepo, paymentRepo)
+		_, err := service.WriteOffLoan(context.Background(), "loan1")
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.InternalServerError, errors.Unwrap(err))
+		uow.AssertCalled(t, "Rollback", mockTx)
+	})
+}
+
+func TestWriteOffService_ApplyWriteOffPolicy(t *testing.T) {
+	t.Run("success write off past due loans", func(t *testing.T) {
+		uow := new(mocks.UnitOfWork)
+		loanRepo := new(mocks.LoanRepository)
+		paymentRepo := new(mocks.PaymentRepository)
+		writeOffRepo := new(mocks.LoanWriteOffRepository)
+		mockTx := &gorm.DB{}
+
+		loans := []*entities.Loan{{ID: "loan1", Status: entities.LOAN_STATUS_ACTIVE}}
+		loanRepo.On("GetPastDueLoans", mock.Anything, mock.MatchedBy(func(dueBefore time.Time) bool {
+			return dueBefore.Before(time.Now().AddDate(0, 0, -179))
+		})).Return(loans, nil)
+		paymentRepo.On("GetPaymentByLoanID", mock.Anything, "loan1").Return([]*entities.Payment{}, nil)
+		uow.On("Begin", mock.Anything).Return(mockTx, nil)
+		uow.On("Commit", mockTx).Return(nil)
+		expectAuditEvents(uow, mockTx)
+		uow.On("LoanRepository", mockTx).Return(loanRepo)
+		uow.On("LoanWriteOffRepository", mockTx).Return(writeOffRepo)
+		loanRepo.On("UpdateStatusLoanByID", mock.Anything, "loan1", entities.LOAN_STATUS_WRITTEN_OFF).Return(nil)
+		writeOffRepo.On("CreateLoanWriteOff", mock.Anything, mock.Anything).Return(nil)
+
+		service := services.NewWriteOffService(config.Config{WriteOffDaysPastDue: 180}, uow, loanRepo, paymentRepo, nil, nil)
+		result, err := service.ApplyWriteOffPolicy(context.Background())
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, entities.WRITE_OFF_REASON_POLICY, result[0].Reason)
+	})
+}
+
+func TestWriteOffService_GetRecoveriesReport(t *testing.T) {
+	t.Run("success sum write offs and recoveries", func(t *testing.T) {
+		writeOffRepo := new(mocks.LoanWriteOffRepository)
+		recoveryRepo := new(mocks.LoanRecoveryRepository)
+
+		to := time.Now()
+		from := to.AddDate(0, -1, 0)
+		writeOffRepo.On("GetLoanWriteOffsByDate", mock.Anything, from, to).Return([]*entities.LoanWriteOff{
+			{ID: "writeOff1", Principal: 1000, Interest: 100, Fee: 10},
+			{ID: "writeOff2", Principal: 500, Interest: 50},
+		}, nil)
+		recoveryRepo.On("GetLoanRecoveriesByDate", mock.Anything, from, to).Return([]*entities.LoanRecovery{
+			{ID: "recovery1", Amount: 110},
+			{ID: "recovery2", Amount: 110},
+		}, nil)
+
+		service := services.NewWriteOffService(config.Config{}, nil, nil, nil, writeOffRepo, recoveryRepo)
+		result, err := service.GetRecoveriesReport(context.Background(), from, to)
+
+		assert.NoError(t, err)
+		assert.Equal(t, float64(1500), result.WrittenOffPrincipal)
+		assert.Equal(t, float64(150), result.WrittenOffInterest)
+		assert.Equal(t, float64(10), result.WrittenOffFee)
+		assert.Equal(t, float64(220), result.Recovered)
+	})
+
+	t.Run("error when range is invalid", func(t *testing.T) {
+		now := time.Now()
+		service := services.NewWriteOffService(config.Config{}, nil, nil, nil, nil, nil)
+		_, err := service.GetRecoveriesReport(context.Background(), now, now)
+
+		assert.Error(t, err)
+		assert.Equal(t, errorhandler.BadRequestError, errors.Unwrap(err))
+	})
+}
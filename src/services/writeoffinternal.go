@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/verizhang/billing-engine/src/entities"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"time"
+)
+
+func (s *writeOffService) writeOff(ctx context.Context, loan *entities.Loan, reason string) (*entities.LoanWriteOff, error) {
+	payments, err := s.paymentRepo.GetPaymentByLoanID(ctx, loan.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	writeOffID, err := uuid.NewUUID()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	now := time.Now()
+	writeOff := s.calculateWriteOff(payments, now)
+	writeOff.ID = writeOffID.String()
+	writeOff.LoanID = loan.ID
+	writeOff.Reason = reason
+	writeOff.WrittenOffAt = &now
+	writeOff.CreatedAt = &now
+
+	tx, err := s.uow.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	loanRepo := s.uow.LoanRepository(tx)
+	writeOffRepo := s.uow.LoanWriteOffRepository(tx)
+
+	err = loanRepo.UpdateStatusLoanByID(ctx, loan.ID, entities.LOAN_STATUS_WRITTEN_OFF)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = writeOffRepo.CreateLoanWriteOff(ctx, writeOff)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = recordAuditEvent(ctx, s.uow, tx, &entities.AuditEvent{
+		LoanID:     &loan.ID,
+		Action:     entities.AUDIT_ACTION_LOAN_WRITTEN_OFF,
+		EntityType: entities.AUDIT_ENTITY_LOAN_WRITE_OFF,
+		EntityID:   writeOff.ID,
+	}, nil, writeOff)
+	if err != nil {
+		s.uow.Rollback(tx)
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	err = s.uow.Commit(tx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errorhandler.InternalServerError, err.Error())
+	}
+
+	return writeOff, nil
+}
+
+// calculateWriteOff sums the unpaid installments into the written off principal, interest and fees,
+// the days past due are counted from the end of the earliest unpaid installment.
+func (s *writeOffService) calculateWriteOff(payments []*entities.Payment, now time.Time) *entities.LoanWriteOff {
+	writeOff := &entities.LoanWriteOff{}
+	isFirstUnpaid := true
+	for _, payment := range payments {
+		if payment.PaidAt != nil {
+			continue
+		}
+
+		if isFirstUnpaid && payment.EndAt.Before(now) {
+			writeOff.DaysPastDue = int(now.Sub(*payment.EndAt).Hours() / 24)
+		}
+		isFirstUnpaid = false
+
+		writeOff.Principal = writeOff.Principal + payment.Principal
+		writeOff.Interest = writeOff.Interest + payment.Interest
+		writeOff.Fee = writeOff.Fee + payment.Fee
+	}
+
+	return writeOff
+}
@@ -0,0 +1,74 @@
+package auth
+
+import "context"
+
+// ACTOR_SYSTEM is recorded for writes made without a principal, such as background jobs
+const ACTOR_SYSTEM = "system"
+
+type Role string
+
+const (
+	ROLE_BORROWER Role = "borrower"
+	ROLE_OPERATOR Role = "operator"
+	ROLE_ADMIN    Role = "admin"
+)
+
+// roleRanks orders the roles, a role is granted everything the roles below it are
+var roleRanks = map[Role]int{
+	ROLE_BORROWER: 1,
+	ROLE_OPERATOR: 2,
+	ROLE_ADMIN:    3,
+}
+
+func (r Role) IsValid() bool {
+	_, ok := roleRanks[r]
+	return ok
+}
+
+// Allows reports whether the role is granted the required role
+func (r Role) Allows(required Role) bool {
+	return roleRanks[r] >= roleRanks[required]
+}
+
+// Principal is the authenticated caller, the subject is the user id of a borrower
+type Principal struct {
+	Subject string
+	Role    Role
+}
+
+// IsStaff reports whether the principal acts on behalf of borrowers rather than as one
+func (p *Principal) IsStaff() bool {
+	return p.Role.Allows(ROLE_OPERATOR)
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok
+}
+
+// CanAccessUser reports whether the caller may act on the data of the user,
+// calls without a principal come from inside the process and are trusted
+func CanAccessUser(ctx context.Context, userID string) bool {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return true
+	}
+
+	return principal.IsStaff() || principal.Subject == userID
+}
+
+// Actor is who a write is recorded against in the audit columns
+func Actor(ctx context.Context) string {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return ACTOR_SYSTEM
+	}
+
+	return principal.Subject
+}
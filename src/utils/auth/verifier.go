@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"math/big"
+	"os"
+)
+
+var ErrNoKeys = errors.New("neither an HS256 secret nor a JWKS file is configured")
+
+type Claims struct {
+	jwt.RegisteredClaims
+	Role Role `json:"role"`
+}
+
+// Verifier validates HS256 tokens against a shared secret and RS256 tokens against the keys of a local JWKS file
+type Verifier struct {
+	secret   []byte
+	keys     map[string]*rsa.PublicKey
+	issuer   string
+	audience string
+}
+
+func NewVerifier(secret string, jwksFile string, issuer string, audience string) (*Verifier, error) {
+	verifier := &Verifier{
+		secret:   []byte(secret),
+		keys:     map[string]*rsa.PublicKey{},
+		issuer:   issuer,
+		audience: audience,
+	}
+
+	if jwksFile != "" {
+		data, err := os.ReadFile(jwksFile)
+		if err != nil {
+			return nil, err
+		}
+		verifier.keys, err = ParseJWKS(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if secret == "" && len(verifier.keys) == 0 {
+		return nil, ErrNoKeys
+	}
+
+	return verifier, nil
+}
+
+// Verify returns the principal of a valid token, tokens without a role claim are borrowers
+func (v *Verifier) Verify(token string) (*Principal, error) {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg(), jwt.SigningMethodRS256.Alg()}),
+		jwt.WithExpirationRequired(),
+	}
+	if v.issuer != "" {
+		options = append(options, jwt.WithIssuer(v.issuer))
+	}
+	if v.audience != "" {
+		options = append(options, jwt.WithAudience(v.audience))
+	}
+
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(token, claims, v.key, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Subject == "" {
+		return nil, errors.New("token has no subject")
+	}
+	if claims.Role == "" {
+		claims.Role = ROLE_BORROWER
+	}
+	if !claims.Role.IsValid() {
+		return nil, fmt.Errorf("unknown role %q", claims.Role)
+	}
+
+	return &Principal{Subject: claims.Subject, Role: claims.Role}, nil
+}
+
+func (v *Verifier) key(token *jwt.Token) (any, error) {
+	switch token.Method.Alg() {
+	case jwt.SigningMethodHS256.Alg():
+		if len(v.secret) == 0 {
+			return nil, errors.New("HS256 tokens are not accepted")
+		}
+		return v.secret, nil
+	default:
+		kid, _ := token.Header["kid"].(string)
+		key, ok := v.keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown key id %q", kid)
+		}
+		return key, nil
+	}
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// ParseJWKS reads the RSA signing keys of a JWKS document by key id
+func ParseJWKS(data []byte) (map[string]*rsa.PublicKey, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, err
+	}
+
+	keys := map[string]*rsa.PublicKey{}
+	for _, key := range set.Keys {
+		if key.Kty != "RSA" || (key.Use != "" && key.Use != "sig") {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(key.N)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: invalid modulus: %w", key.Kid, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(key.E)
+		if err != nil {
+			return nil, fmt.Errorf("key %s: invalid exponent: %w", key.Kid, err)
+		}
+
+		keys[key.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,126 @@
+package auth_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/verizhang/billing-engine/src/utils/auth"
+)
+
+func writeJWKS(t *testing.T, kid string, key *rsa.PublicKey) string {
+	data, err := json.Marshal(map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": kid,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	})
+	assert.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	assert.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func claims(subject string, role auth.Role, expiresAt time.Time) *auth.Claims {
+	return &auth.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject,
+			Issuer:    "billing-auth",
+			Audience:  jwt.ClaimStrings{"billing-engine"},
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		Role: role,
+	}
+}
+
+func TestVerifier(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	jwksFile := writeJWKS(t, "key-1", &privateKey.PublicKey)
+
+	verifier, err := auth.NewVerifier("secret", jwksFile, "billing-auth", "billing-engine")
+	assert.NoError(t, err)
+
+	hs256 := func(c *auth.Claims, secret string) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, c).SignedString([]byte(secret))
+		assert.NoError(t, err)
+		return token
+	}
+	rs256 := func(c *auth.Claims, kid string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, c)
+		token.Header["kid"] = kid
+		signed, err := token.SignedString(privateKey)
+		assert.NoError(t, err)
+		return signed
+	}
+	expiresAt := time.Now().Add(time.Hour)
+
+	t.Run("accepts HS256 token and defaults to borrower", func(t *testing.T) {
+		principal, err := verifier.Verify(hs256(claims("user1", "", expiresAt), "secret"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, &auth.Principal{Subject: "user1", Role: auth.ROLE_BORROWER}, principal)
+	})
+
+	t.Run("accepts RS256 token signed by a JWKS key", func(t *testing.T) {
+		principal, err := verifier.Verify(rs256(claims("ops1", auth.ROLE_OPERATOR, expiresAt), "key-1"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, &auth.Principal{Subject: "ops1", Role: auth.ROLE_OPERATOR}, principal)
+	})
+
+	t.Run("rejects invalid tokens", func(t *testing.T) {
+		unknownIssuer := claims("user1", "", expiresAt)
+		unknownIssuer.Issuer = "someone-else"
+		noExpiry := claims("user1", "", expiresAt)
+		noExpiry.ExpiresAt = nil
+
+		for name, token := range map[string]string{
+			"wrong secret":    hs256(claims("user1", "", expiresAt), "other"),
+			"expired":         hs256(claims("user1", "", time.Now().Add(-time.Minute)), "secret"),
+			"no expiry":       hs256(noExpiry, "secret"),
+			"wrong issuer":    hs256(unknownIssuer, "secret"),
+			"no subject":      hs256(claims("", "", expiresAt), "secret"),
+			"unknown role":    hs256(claims("user1", "root", expiresAt), "secret"),
+			"unknown key id":  rs256(claims("user1", "", expiresAt), "key-2"),
+			"not a jwt token": "token",
+		} {
+			_, err := verifier.Verify(token)
+			assert.Error(t, err, name)
+		}
+	})
+
+	t.Run("rejects HS256 when only a JWKS is configured", func(t *testing.T) {
+		verifier, err := auth.NewVerifier("", jwksFile, "", "")
+		assert.NoError(t, err)
+
+		_, err = verifier.Verify(hs256(claims("user1", "", expiresAt), ""))
+		assert.Error(t, err)
+	})
+
+	t.Run("requires a key", func(t *testing.T) {
+		_, err := auth.NewVerifier("", "", "", "")
+
+		assert.ErrorIs(t, err, auth.ErrNoKeys)
+	})
+}
+
+func TestRole(t *testing.T) {
+	assert.True(t, auth.ROLE_ADMIN.Allows(auth.ROLE_OPERATOR))
+	assert.True(t, auth.ROLE_OPERATOR.Allows(auth.ROLE_BORROWER))
+	assert.False(t, auth.ROLE_BORROWER.Allows(auth.ROLE_OPERATOR))
+	assert.False(t, auth.ROLE_OPERATOR.Allows(auth.ROLE_ADMIN))
+}
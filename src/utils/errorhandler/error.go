@@ -2,24 +2,118 @@ package errorhandler
 
 import (
 	"errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"strings"
 )
 
+const ERROR_DOMAIN = "billing-engine"
+
 var (
-	NotFoundError       = errors.New("Not Found")
-	InternalServerError = errors.New("Internal server error")
-	BadRequestError     = errors.New("Bad request error")
+	NotFoundError         = errors.New("Not Found")
+	InternalServerError   = errors.New("Internal server error")
+	BadRequestError       = errors.New("Bad request error")
+	UnauthenticatedError  = errors.New("Unauthenticated")
+	PermissionDeniedError = errors.New("Permission denied")
 )
 
+// Error is a NotFoundError, BadRequestError, UnauthenticatedError or PermissionDeniedError carrying a machine-readable reason and,
+// for invalid requests, the offending fields
+type Error struct {
+	kind       error
+	Reason     string
+	Message    string
+	Violations []*FieldViolation
+}
+
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+func (e *Error) Error() string {
+	return e.kind.Error() + ": " + e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.kind
+}
+
+func BadRequest(reason string, message string) error {
+	return &Error{kind: BadRequestError, Reason: reason, Message: message}
+}
+
+func NotFound(reason string, message string) error {
+	return &Error{kind: NotFoundError, Reason: reason, Message: message}
+}
+
+func Unauthenticated(reason string, message string) error {
+	return &Error{kind: UnauthenticatedError, Reason: reason, Message: message}
+}
+
+func PermissionDenied(reason string, message string) error {
+	return &Error{kind: PermissionDeniedError, Reason: reason, Message: message}
+}
+
+// InvalidField reports a single invalid request field, named as in the proto message
+func InvalidField(field string, description string) error {
+	return InvalidFields([]*FieldViolation{{Field: field, Description: description}})
+}
+
+// InvalidFields reports every invalid field of a request at once
+func InvalidFields(violations []*FieldViolation) error {
+	var messages []string
+	for _, violation := range violations {
+		messages = append(messages, strings.TrimSpace(violation.Field+" "+violation.Description))
+	}
+
+	return &Error{
+		kind:       BadRequestError,
+		Reason:     REASON_INVALID_ARGUMENT,
+		Message:    strings.Join(messages, "; "),
+		Violations: violations,
+	}
+}
+
 func TranslateTogRPCError(err error) error {
+	code, reason := codes.Internal, REASON_INTERNAL
 	if errors.Is(err, NotFoundError) {
-		return status.Error(codes.NotFound, err.Error())
+		code, reason = codes.NotFound, REASON_NOT_FOUND
 	}
-
 	if errors.Is(err, BadRequestError) {
-		return status.Error(codes.InvalidArgument, err.Error())
+		code, reason = codes.InvalidArgument, REASON_BAD_REQUEST
+	}
+	if errors.Is(err, UnauthenticatedError) {
+		code, reason = codes.Unauthenticated, REASON_UNAUTHENTICATED
+	}
+	if errors.Is(err, PermissionDeniedError) {
+		code, reason = codes.PermissionDenied, REASON_PERMISSION_DENIED
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	var e *Error
+	if errors.As(err, &e) {
+		reason = e.Reason
+		for _, violation := range e.Violations {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       violation.Field,
+				Description: violation.Description,
+			})
+		}
+	}
+
+	st := status.New(code, err.Error())
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: reason, Domain: ERROR_DOMAIN}}
+	if len(violations) > 0 {
+		details = append(details, &errdetails.BadRequest{FieldViolations: violations})
+	}
+
+	withDetails, detailsErr := st.WithDetails(details...)
+	if detailsErr != nil {
+		return st.Err()
 	}
 
-	return status.Error(codes.Internal, err.Error())
+	return withDetails.Err()
 }
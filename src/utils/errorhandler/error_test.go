@@ -0,0 +1,83 @@
+package errorhandler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/verizhang/billing-engine/src/utils/errorhandler"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTranslateTogRPCError(t *testing.T) {
+	t.Run("attaches error info reason", func(t *testing.T) {
+		err := errorhandler.TranslateTogRPCError(errorhandler.BadRequest(errorhandler.REASON_NO_ACTIVE_LOAN, "Active loan not found"))
+
+		st := status.Convert(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, "Bad request error: Active loan not found", st.Message())
+		assert.Len(t, st.Details(), 1)
+		info := st.Details()[0].(*errdetails.ErrorInfo)
+		assert.Equal(t, errorhandler.REASON_NO_ACTIVE_LOAN, info.Reason)
+		assert.Equal(t, errorhandler.ERROR_DOMAIN, info.Domain)
+	})
+
+	t.Run("attaches field violations", func(t *testing.T) {
+		err := errorhandler.TranslateTogRPCError(errorhandler.InvalidField("tenor", "must not be negative"))
+
+		st := status.Convert(err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Len(t, st.Details(), 2)
+		badRequest := st.Details()[1].(*errdetails.BadRequest)
+		assert.Equal(t, "tenor", badRequest.FieldViolations[0].Field)
+		assert.Equal(t, "must not be negative", badRequest.FieldViolations[0].Description)
+	})
+
+	t.Run("maps authentication errors", func(t *testing.T) {
+		err := errorhandler.TranslateTogRPCError(errorhandler.Unauthenticated(errorhandler.REASON_TOKEN_MISSING, "bearer token is required"))
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		err = errorhandler.TranslateTogRPCError(errorhandler.PermissionDenied(errorhandler.REASON_USER_MISMATCH, "userId does not match the authenticated user"))
+		st := status.Convert(err)
+		assert.Equal(t, codes.PermissionDenied, st.Code())
+		assert.Equal(t, errorhandler.REASON_USER_MISMATCH, st.Details()[0].(*errdetails.ErrorInfo).Reason)
+	})
+
+	t.Run("falls back to a generic reason for wrapped errors", func(t *testing.T) {
+		err := errorhandler.TranslateTogRPCError(fmt.Errorf("%w: connection refused", errorhandler.InternalServerError))
+
+		st := status.Convert(err)
+		assert.Equal(t, codes.Internal, st.Code())
+		assert.Equal(t, errorhandler.REASON_INTERNAL, st.Details()[0].(*errdetails.ErrorInfo).Reason)
+	})
+
+	t.Run("gateway renders details in the json body", func(t *testing.T) {
+		err := errorhandler.TranslateTogRPCError(errorhandler.NotFound(errorhandler.REASON_LOAN_NOT_FOUND, "loan not found"))
+
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, "/loan/loan1", nil)
+		runtime.HTTPError(context.Background(), runtime.NewServeMux(), &runtime.JSONPb{}, recorder, request, err)
+
+		var body struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+			Details []struct {
+				Type   string `json:"@type"`
+				Reason string `json:"reason"`
+				Domain string `json:"domain"`
+			} `json:"details"`
+		}
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+		assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &body))
+		assert.Equal(t, int(codes.NotFound), body.Code)
+		assert.Equal(t, "type.googleapis.com/google.rpc.ErrorInfo", body.Details[0].Type)
+		assert.Equal(t, errorhandler.REASON_LOAN_NOT_FOUND, body.Details[0].Reason)
+	})
+}
@@ -0,0 +1,36 @@
+package errorhandler
+
+// Reasons are sent as google.rpc.ErrorInfo.reason, clients match on them instead of the message
+const (
+	REASON_INTERNAL          = "INTERNAL"
+	REASON_NOT_FOUND         = "NOT_FOUND"
+	REASON_BAD_REQUEST       = "BAD_REQUEST"
+	REASON_INVALID_ARGUMENT  = "INVALID_ARGUMENT"
+	REASON_UNAUTHENTICATED   = "UNAUTHENTICATED"
+	REASON_PERMISSION_DENIED = "PERMISSION_DENIED"
+
+	REASON_LOAN_NOT_FOUND                 = "LOAN_NOT_FOUND"
+	REASON_LOAN_NOT_ACTIVE                = "LOAN_NOT_ACTIVE"
+	REASON_LOAN_DELINQUENT                = "LOAN_DELINQUENT"
+	REASON_NO_ACTIVE_LOAN                 = "NO_ACTIVE_LOAN"
+	REASON_MULTIPLE_ACTIVE_LOANS          = "MULTIPLE_ACTIVE_LOANS"
+	REASON_EXPOSURE_LIMIT_EXCEEDED        = "EXPOSURE_LIMIT_EXCEEDED"
+	REASON_ALL_INSTALLMENTS_PAID          = "ALL_INSTALLMENTS_PAID"
+	REASON_NO_INSTALLMENT_DUE             = "NO_INSTALLMENT_DUE"
+	REASON_DEFERRAL_NOT_AVAILABLE         = "DEFERRAL_NOT_AVAILABLE"
+	REASON_DEFERRAL_LIMIT_REACHED         = "DEFERRAL_LIMIT_REACHED"
+	REASON_TOKEN_MISSING                  = "TOKEN_MISSING"
+	REASON_TOKEN_INVALID                  = "TOKEN_INVALID"
+	REASON_USER_MISMATCH                  = "USER_MISMATCH"
+	REASON_ROLE_REQUIRED                  = "ROLE_REQUIRED"
+	REASON_WEBHOOK_SUBSCRIPTION_NOT_FOUND = "WEBHOOK_SUBSCRIPTION_NOT_FOUND"
+	REASON_PAYMENT_UNMATCHED              = "PAYMENT_UNMATCHED"
+	REASON_PAYMENT_AMOUNT_MISMATCH        = "PAYMENT_AMOUNT_MISMATCH"
+	REASON_WEBHOOK_DELIVERY_NOT_FOUND     = "WEBHOOK_DELIVERY_NOT_FOUND"
+	REASON_PAYMENT_AMBIGUOUS              = "PAYMENT_AMBIGUOUS"
+	REASON_STATEMENT_LINE_NOT_FOUND       = "STATEMENT_LINE_NOT_FOUND"
+	REASON_STATEMENT_LINE_NOT_EXCEPTION   = "STATEMENT_LINE_NOT_EXCEPTION"
+	REASON_LOAN_CHANGED                   = "LOAN_CHANGED"
+	REASON_STATEMENT_LINE_INDISTINCT      = "STATEMENT_LINE_INDISTINCT"
+	REASON_CURRENCY_MISMATCH              = "CURRENCY_MISMATCH"
+)
@@ -0,0 +1,105 @@
+package finmath
+
+import (
+	"errors"
+	"math"
+)
+
+var NoSolutionError = errors.New("irr does not converge for the cash flows")
+
+const (
+	maxIterations = 100
+	tolerance     = 1e-12
+)
+
+// CashFlow is an amount moving at Period unit periods after the start. Money lent is negative,
+// money repaid is positive.
+type CashFlow struct {
+	Period float64
+	Amount float64
+}
+
+type Disclosure struct {
+	PeriodRate          float64
+	APR                 float64
+	EffectiveAnnualRate float64
+}
+
+// Disclose returns the rates of a loan whose upfront fee is deducted from the principal paid out,
+// following the actuarial method: APR is the period rate times the periods per year.
+func Disclose(principal float64, upfrontFee float64, installments []CashFlow, periodsPerYear float64) (*Disclosure, error) {
+	flows := append([]CashFlow{{Period: 0, Amount: -(principal - upfrontFee)}}, installments...)
+
+	rate, err := IRR(flows)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Disclosure{
+		PeriodRate:          rate,
+		APR:                 rate * periodsPerYear,
+		EffectiveAnnualRate: math.Pow(1+rate, periodsPerYear) - 1,
+	}, nil
+}
+
+// IRR returns the rate per unit period at which the net present value of the cash flows is zero.
+// It uses Newton's method and falls back to bisection when Newton leaves the search range.
+func IRR(flows []CashFlow) (float64, error) {
+	lo, hi := -0.99, 10.0
+	if npv(flows, lo)*npv(flows, hi) > 0 {
+		return 0, NoSolutionError
+	}
+
+	rate := 0.01
+	for i := 0; i < maxIterations; i++ {
+		value, derivative := npv(flows, rate), dnpv(flows, rate)
+		if math.Abs(value) < tolerance {
+			return rate, nil
+		}
+
+		next := rate - value/derivative
+		if derivative == 0 || math.IsNaN(next) || next <= lo || next >= hi {
+			return bisect(flows, lo, hi)
+		}
+		if math.Abs(next-rate) < tolerance {
+			return next, nil
+		}
+		rate = next
+	}
+
+	return bisect(flows, lo, hi)
+}
+
+func bisect(flows []CashFlow, lo float64, hi float64) (float64, error) {
+	for i := 0; i < 4*maxIterations; i++ {
+		mid := (lo + hi) / 2
+		if npv(flows, lo)*npv(flows, mid) <= 0 {
+			hi = mid
+		} else {
+			lo = mid
+		}
+		if hi-lo < tolerance {
+			return (lo + hi) / 2, nil
+		}
+	}
+
+	return 0, NoSolutionError
+}
+
+func npv(flows []CashFlow, rate float64) float64 {
+	value := float64(0)
+	for _, flow := range flows {
+		value = value + flow.Amount/math.Pow(1+rate, flow.Period)
+	}
+
+	return value
+}
+
+func dnpv(flows []CashFlow, rate float64) float64 {
+	value := float64(0)
+	for _, flow := range flows {
+		value = value - flow.Period*flow.Amount/math.Pow(1+rate, flow.Period+1)
+	}
+
+	return value
+}
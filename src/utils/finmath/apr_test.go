@@ -0,0 +1,71 @@
+package finmath_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verizhang/billing-engine/src/utils/finmath"
+)
+
+func installments(count int, amount float64) []finmath.CashFlow {
+	var flows []finmath.CashFlow
+	for i := 1; i <= count; i++ {
+		flows = append(flows, finmath.CashFlow{Period: float64(i), Amount: amount})
+	}
+
+	return flows
+}
+
+func TestDisclose(t *testing.T) {
+	cases := []struct {
+		name           string
+		principal      float64
+		upfrontFee     float64
+		installments   []finmath.CashFlow
+		periodsPerYear float64
+		apr            float64
+		ear            float64
+	}{
+		{
+			// Regulation Z appendix J: 5,000 repaid in 36 monthly payments of 166.07 discloses 12.00%
+			name: "monthly consumer loan", principal: 5000, installments: installments(36, 166.07), periodsPerYear: 12,
+			apr: 0.119994, ear: 0.126818,
+		},
+		{
+			name: "30 year mortgage", principal: 200000, installments: installments(360, 1199.10), periodsPerYear: 12,
+			apr: 0.060000, ear: 0.061678,
+		},
+		{
+			name: "30 year mortgage with points", principal: 200000, upfrontFee: 2000, installments: installments(360, 1199.10), periodsPerYear: 12,
+			apr: 0.060940, ear: 0.062671,
+		},
+		{
+			name: "single payment", principal: 1000, installments: []finmath.CashFlow{{Period: 12, Amount: 1100}}, periodsPerYear: 12,
+			apr: 0.095690, ear: 0.100000,
+		},
+		{
+			name: "standard weekly loan", principal: 5000000, installments: installments(50, 110000), periodsPerYear: 52,
+			apr: 0.197793, ear: 0.218253,
+		},
+		{
+			name: "standard weekly loan with upfront fee", principal: 5000000, upfrontFee: 100000, installments: installments(50, 110000), periodsPerYear: 52,
+			apr: 0.240635, ear: 0.271351,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := finmath.Disclose(tc.principal, tc.upfrontFee, tc.installments, tc.periodsPerYear)
+
+			assert.NoError(t, err)
+			assert.InDelta(t, tc.apr, result.APR, 1e-6)
+			assert.InDelta(t, tc.ear, result.EffectiveAnnualRate, 1e-6)
+		})
+	}
+
+	t.Run("error when repayments never cover the principal", func(t *testing.T) {
+		_, err := finmath.Disclose(1000, 0, []finmath.CashFlow{{Period: 1, Amount: -10}}, 12)
+
+		assert.ErrorIs(t, err, finmath.NoSolutionError)
+	})
+}
@@ -0,0 +1,59 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	DEFAULT_PAGE_SIZE = 20
+	MAX_PAGE_SIZE     = 100
+)
+
+var InvalidPageTokenError = errors.New("invalid page token")
+
+// Cursor points at the last row of a page ordered by (time, id) descending
+type Cursor struct {
+	Time time.Time
+	ID   string
+}
+
+func EncodeCursor(t time.Time, ID string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(t.UTC().Format(time.RFC3339Nano) + "|" + ID))
+}
+
+// DecodeCursor returns nil for an empty token, which means the first page
+func DecodeCursor(token string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, InvalidPageTokenError
+	}
+
+	parts := strings.SplitN(string(raw), "|", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, InvalidPageTokenError
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return nil, InvalidPageTokenError
+	}
+
+	return &Cursor{Time: t, ID: parts[1]}, nil
+}
+
+func PageSize(size int) int {
+	if size <= 0 {
+		return DEFAULT_PAGE_SIZE
+	}
+	if size > MAX_PAGE_SIZE {
+		return MAX_PAGE_SIZE
+	}
+	return size
+}
@@ -0,0 +1,41 @@
+package pagination_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verizhang/billing-engine/src/utils/pagination"
+)
+
+func TestCursor(t *testing.T) {
+	t.Run("encode and decode round trip", func(t *testing.T) {
+		now := time.Date(2025, 5, 1, 10, 30, 0, 123456789, time.UTC)
+		token := pagination.EncodeCursor(now, "loan1")
+
+		cursor, err := pagination.DecodeCursor(token)
+
+		assert.NoError(t, err)
+		assert.True(t, now.Equal(cursor.Time))
+		assert.Equal(t, "loan1", cursor.ID)
+	})
+
+	t.Run("empty token is the first page", func(t *testing.T) {
+		cursor, err := pagination.DecodeCursor("")
+
+		assert.NoError(t, err)
+		assert.Nil(t, cursor)
+	})
+
+	t.Run("error on malformed token", func(t *testing.T) {
+		_, err := pagination.DecodeCursor("not-a-token")
+
+		assert.ErrorIs(t, err, pagination.InvalidPageTokenError)
+	})
+
+	t.Run("page size defaults and caps", func(t *testing.T) {
+		assert.Equal(t, pagination.DEFAULT_PAGE_SIZE, pagination.PageSize(0))
+		assert.Equal(t, pagination.MAX_PAGE_SIZE, pagination.PageSize(1000))
+		assert.Equal(t, 5, pagination.PageSize(5))
+	})
+}
@@ -0,0 +1,136 @@
+// Package paymentref generates the numeric payment references, or virtual account numbers, that identify the loan of a bank transfer.
+// A reference is a fixed prefix, random digits and a check digit catching mistyped references
+package paymentref
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+const (
+	// CHECK_DIGIT_LUHN appends the single Luhn digit, as on card numbers
+	CHECK_DIGIT_LUHN = "luhn"
+	// CHECK_DIGIT_MOD97 appends the two ISO 7064 MOD 97-10 digits, as on IBANs
+	CHECK_DIGIT_MOD97 = "mod97"
+)
+
+var FormatError = errors.New("invalid payment reference format")
+
+// Format is the shape of the references, Length counts the prefix and the check digits
+type Format struct {
+	Prefix     string
+	Length     int
+	CheckDigit string
+}
+
+// Validate checks the format leaves room for at least 6 random digits
+func (f Format) Validate() error {
+	if !isDigits(f.Prefix) && f.Prefix != "" {
+		return fmt.Errorf("%w: prefix %q is not numeric", FormatError, f.Prefix)
+	}
+	checkDigits, err := f.checkDigits()
+	if err != nil {
+		return err
+	}
+	if f.Length-len(f.Prefix)-checkDigits < 6 {
+		return fmt.Errorf("%w: length %d leaves fewer than 6 random digits", FormatError, f.Length)
+	}
+
+	return nil
+}
+
+// Generate returns a new random reference of the format
+func (f Format) Generate() (string, error) {
+	if err := f.Validate(); err != nil {
+		return "", err
+	}
+
+	checkDigits, _ := f.checkDigits()
+	var body strings.Builder
+	body.WriteString(f.Prefix)
+	for body.Len() < f.Length-checkDigits {
+		digit, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+		body.WriteString(digit.String())
+	}
+
+	if f.CheckDigit == CHECK_DIGIT_MOD97 {
+		return body.String() + mod97CheckDigits(body.String()), nil
+	}
+	return body.String() + luhnCheckDigit(body.String()), nil
+}
+
+// Valid tells whether reference has the prefix, the length and the check digits of the format
+func (f Format) Valid(reference string) bool {
+	if len(reference) != f.Length || !isDigits(reference) || !strings.HasPrefix(reference, f.Prefix) {
+		return false
+	}
+
+	switch f.CheckDigit {
+	case CHECK_DIGIT_LUHN:
+		return luhnCheckDigit(reference[:len(reference)-1]) == reference[len(reference)-1:]
+	case CHECK_DIGIT_MOD97:
+		return mod97(reference) == 1
+	}
+	return false
+}
+
+func (f Format) checkDigits() (int, error) {
+	switch f.CheckDigit {
+	case CHECK_DIGIT_LUHN:
+		return 1, nil
+	case CHECK_DIGIT_MOD97:
+		return 2, nil
+	}
+	return 0, fmt.Errorf("%w: unknown check digit %q", FormatError, f.CheckDigit)
+}
+
+// luhnCheckDigit doubles every second digit from the right of the completed number
+func luhnCheckDigit(body string) string {
+	sum := 0
+	for i := len(body) - 1; i >= 0; i-- {
+		digit := int(body[i] - '0')
+		if (len(body)-1-i)%2 == 0 {
+			digit = digit * 2
+			if digit > 9 {
+				digit = digit - 9
+			}
+		}
+		sum = sum + digit
+	}
+
+	return strconv.Itoa((10 - sum%10) % 10)
+}
+
+// mod97CheckDigits makes the completed number leave 1 modulo 97
+func mod97CheckDigits(body string) string {
+	return fmt.Sprintf("%02d", 98-mod97(body+"00"))
+}
+
+func mod97(digits string) int {
+	remainder := 0
+	for _, digit := range digits {
+		remainder = (remainder*10 + int(digit-'0')) % 97
+	}
+
+	return remainder
+}
+
+func isDigits(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, char := range value {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,51 @@
+package paymentref_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verizhang/billing-engine/src/utils/paymentref"
+)
+
+func TestFormat(t *testing.T) {
+	t.Run("generates valid references of every check digit", func(t *testing.T) {
+		for _, checkDigit := range []string{paymentref.CHECK_DIGIT_LUHN, paymentref.CHECK_DIGIT_MOD97} {
+			format := paymentref.Format{Prefix: "8808", Length: 16, CheckDigit: checkDigit}
+			reference, err := format.Generate()
+
+			assert.NoError(t, err)
+			assert.Len(t, reference, 16)
+			assert.True(t, strings.HasPrefix(reference, "8808"))
+			assert.True(t, format.Valid(reference), reference)
+		}
+	})
+
+	t.Run("accepts known check digits", func(t *testing.T) {
+		assert.True(t, paymentref.Format{Length: 16, CheckDigit: paymentref.CHECK_DIGIT_LUHN}.Valid("4111111111111111"))
+		// the IBAN GB82 WEST 1234 5698 7654 32, rearranged and converted to digits
+		assert.True(t, paymentref.Format{Length: 28, CheckDigit: paymentref.CHECK_DIGIT_MOD97}.Valid("3214282912345698765432161182"))
+	})
+
+	t.Run("rejects a mistyped reference", func(t *testing.T) {
+		format := paymentref.Format{Prefix: "8808", Length: 16, CheckDigit: paymentref.CHECK_DIGIT_LUHN}
+		reference, _ := format.Generate()
+		last := (reference[15]-'0'+1)%10 + '0'
+
+		assert.False(t, format.Valid(reference[:15]+string(rune(last))))
+		assert.False(t, format.Valid(reference[:15]))
+		assert.False(t, format.Valid("9999"+reference[4:]))
+	})
+
+	t.Run("error when format is invalid", func(t *testing.T) {
+		for _, format := range []paymentref.Format{
+			{Prefix: "88A", Length: 16, CheckDigit: paymentref.CHECK_DIGIT_LUHN},
+			{Prefix: "8808", Length: 8, CheckDigit: paymentref.CHECK_DIGIT_MOD97},
+			{Prefix: "8808", Length: 16, CheckDigit: "crc"},
+		} {
+			_, err := format.Generate()
+			assert.True(t, errors.Is(err, paymentref.FormatError), err)
+		}
+	})
+}
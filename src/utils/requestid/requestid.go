@@ -0,0 +1,19 @@
+// Package requestid carries the id correlating a call across logs, audit events and downstream systems
+package requestid
+
+import "context"
+
+// HEADER is the metadata key of the request id, forwarded by the gateway from the X-Request-Id header
+const HEADER = "x-request-id"
+
+type requestIDKey struct{}
+
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// FromContext returns the request id of the call, empty outside of a call
+func FromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
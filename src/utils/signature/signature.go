@@ -0,0 +1,73 @@
+// Package signature signs and verifies webhook bodies with HMAC-SHA256 over the timestamp and the body,
+// carried in a header of the form t=<unix seconds>,v1=<hex digest>
+package signature
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HEADER is the header carrying the signature of a webhook body
+const HEADER = "X-Billing-Signature"
+
+var (
+	MalformedError = errors.New("malformed signature header")
+	MismatchError  = errors.New("signature does not match")
+	ExpiredError   = errors.New("signature timestamp is outside the tolerance")
+)
+
+// Sign returns the header value signing body at timestamp
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	unix := strconv.FormatInt(timestamp.Unix(), 10)
+	return "t=" + unix + ",v1=" + digest(secret, unix, body)
+}
+
+// Verify checks header against body, the timestamp may be at most tolerance away from now to stop replays
+func Verify(secret string, header string, body []byte, tolerance time.Duration, now time.Time) error {
+	var unix string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return MalformedError
+		}
+		switch key {
+		case "t":
+			unix = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+
+	seconds, err := strconv.ParseInt(unix, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return MalformedError
+	}
+
+	age := now.Sub(time.Unix(seconds, 0))
+	if age > tolerance || age < -tolerance {
+		return ExpiredError
+	}
+
+	expected := digest(secret, unix, body)
+	for _, signature := range signatures {
+		if hmac.Equal([]byte(signature), []byte(expected)) {
+			return nil
+		}
+	}
+
+	return MismatchError
+}
+
+func digest(secret string, unix string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unix))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
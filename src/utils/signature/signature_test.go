@@ -0,0 +1,33 @@
+package signature_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/verizhang/billing-engine/src/utils/signature"
+)
+
+func TestSignature(t *testing.T) {
+	now := time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC)
+	body := []byte(`{"id":"event1"}`)
+	header := signature.Sign("secret", now, body)
+
+	t.Run("verifies a signed body", func(t *testing.T) {
+		assert.NoError(t, signature.Verify("secret", header, body, 5*time.Minute, now.Add(time.Minute)))
+	})
+
+	t.Run("rejects a changed body or another secret", func(t *testing.T) {
+		assert.ErrorIs(t, signature.Verify("secret", header, []byte(`{"id":"event2"}`), 5*time.Minute, now), signature.MismatchError)
+		assert.ErrorIs(t, signature.Verify("other", header, body, 5*time.Minute, now), signature.MismatchError)
+	})
+
+	t.Run("rejects an old signature", func(t *testing.T) {
+		assert.ErrorIs(t, signature.Verify("secret", header, body, 5*time.Minute, now.Add(10*time.Minute)), signature.ExpiredError)
+	})
+
+	t.Run("rejects a malformed header", func(t *testing.T) {
+		assert.ErrorIs(t, signature.Verify("secret", "v1=abc", body, 5*time.Minute, now), signature.MalformedError)
+		assert.ErrorIs(t, signature.Verify("secret", "garbage", body, 5*time.Minute, now), signature.MalformedError)
+	})
+}